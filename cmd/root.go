@@ -3,6 +3,7 @@ package cmd
 import (
 	"flag"
 	"fmt"
+	"os"
 	"runtime/debug"
 
 	"github.com/derailed/k9s/internal/client"
@@ -39,9 +40,9 @@ var (
 
 func init() {
 	const falseFlag = "false"
-	rootCmd.AddCommand(versionCmd(), infoCmd())
 	initK9sFlags()
 	initK8sFlags()
+	rootCmd.AddCommand(versionCmd(), infoCmd(), snapshotCmd())
 
 	// Klogs (of course) want to print stuff to the screen ;(
 	klog.InitFlags(nil)
@@ -79,6 +80,15 @@ func run(cmd *cobra.Command, args []string) {
 	}()
 
 	zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
+	if *k9sFlags.Snapshot != "" {
+		srv, err := serveSnapshot(*k9sFlags.Snapshot)
+		if err != nil {
+			log.Error().Err(err).Msgf("Unable to load snapshot %q", *k9sFlags.Snapshot)
+			fmt.Println(color.Colorize(fmt.Sprintf("Snapshot failed: %v", err), color.Red))
+			os.Exit(1)
+		}
+		defer srv.Stop()
+	}
 	cfg := loadConfiguration()
 	app := view.NewApp(cfg)
 	{
@@ -109,6 +119,15 @@ func loadConfiguration() *config.Config {
 		k9sCfg.K9s.OverrideHeadless(*k9sFlags.Headless)
 	}
 
+	if k9sFlags.ReadOnly != nil {
+		k9sCfg.K9s.OverrideReadOnly(*k9sFlags.ReadOnly)
+	}
+
+	// Snapshots are served by a read-only API server.
+	if *k9sFlags.Snapshot != "" {
+		k9sCfg.K9s.OverrideReadOnly(true)
+	}
+
 	if k9sFlags.Command != nil {
 		k9sCfg.K9s.OverrideCommand(*k9sFlags.Command)
 	}
@@ -173,6 +192,12 @@ func initK9sFlags() {
 		false,
 		"Turn K9s header off",
 	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.ReadOnly,
+		"readonly",
+		false,
+		"Disable cluster modifying actions",
+	)
 	rootCmd.Flags().BoolVarP(
 		k9sFlags.AllNamespaces,
 		"all-namespaces", "A",
@@ -185,6 +210,12 @@ func initK9sFlags() {
 		config.DefaultCommand,
 		"Specify the default command to view when the application launches",
 	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Snapshot,
+		"snapshot",
+		"",
+		"Browse a cluster snapshot archive instead of a live cluster",
+	)
 }
 
 func initK8sFlags() {
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/color"
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+func snapshotCmd() *cobra.Command {
+	command := cobra.Command{
+		Use:   "snapshot",
+		Short: "Manage cluster snapshots",
+		Long:  "Capture a cluster state to browse it offline using k9s --snapshot",
+	}
+	command.AddCommand(snapshotCreateCmd())
+
+	return &command
+}
+
+func snapshotCreateCmd() *cobra.Command {
+	var (
+		out     string
+		secrets bool
+	)
+
+	command := cobra.Command{
+		Use:   "create",
+		Short: "Capture all listable resources to a tarball",
+		Long:  "Capture all listable resources of the current context to a tarball",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := createSnapshot(out, secrets); err != nil {
+				fmt.Println(color.Colorize(fmt.Sprintf("Snapshot failed: %v", err), color.Red))
+				os.Exit(1)
+			}
+		},
+	}
+
+	command.Flags().StringVarP(&out, "output", "o", "", "Path of the snapshot archive. Defaults to CONTEXT-TIMESTAMP.tgz")
+	command.Flags().BoolVar(&secrets, "include-secrets", false, "Capture secrets values. Values are redacted by default")
+	command.Flags().StringVar(k8sFlags.KubeConfig, "kubeconfig", "", "Path to the kubeconfig file to use for CLI requests")
+	command.Flags().StringVar(k8sFlags.Context, "context", "", "The name of the kubeconfig context to use")
+	command.Flags().StringVar(k8sFlags.Timeout, "request-timeout", "", "The length of time to wait before giving up on a single server request")
+
+	return &command
+}
+
+func createSnapshot(out string, secrets bool) error {
+	conn := client.InitConnectionOrDie(client.NewConfig(k8sFlags))
+	f := watch.NewFactory(conn)
+	f.Start("")
+	defer f.Terminate()
+
+	a, err := snapshot.Capture(f, secrets)
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		r := strings.NewReplacer("/", "-", ":", "-")
+		out = fmt.Sprintf("%s-%d.tgz", r.Replace(a.Manifest.Context), a.Manifest.CreatedAt.Unix())
+	}
+	if err := a.Save(out); err != nil {
+		return err
+	}
+	fmt.Printf("Captured %d resources (%d kinds) to %s\n", a.Count(), len(a.Resources), out)
+
+	return nil
+}
+
+type snapshotServer struct {
+	*snapshot.Server
+
+	kubeConfig string
+}
+
+// ServeSnapshot serves an archive and points the kubeconfig flags at it.
+func serveSnapshot(path string) (*snapshotServer, error) {
+	a, err := snapshot.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	ss, err := snapshot.NewServer(a)
+	if err != nil {
+		return nil, err
+	}
+	srv := snapshotServer{Server: ss}
+	if err := srv.Start(); err != nil {
+		return nil, err
+	}
+
+	f, err := ioutil.TempFile("", "k9s-snapshot-*.yml")
+	if err != nil {
+		srv.Server.Stop()
+		return nil, err
+	}
+	f.Close()
+	srv.kubeConfig = f.Name()
+	if err := srv.WriteKubeConfig(srv.kubeConfig); err != nil {
+		srv.Stop()
+		return nil, err
+	}
+	*k8sFlags.KubeConfig = srv.kubeConfig
+	*k8sFlags.Context, *k8sFlags.ClusterName, *k8sFlags.AuthInfoName = "", "", ""
+	log.Info().Msgf("Browsing snapshot %q captured %s", a.Manifest.Context, a.Manifest.CreatedAt.Format(time.RFC3339))
+
+	return &srv, nil
+}
+
+// Stop terminates the server and cleans up its kubeconfig.
+func (s *snapshotServer) Stop() {
+	s.Server.Stop()
+	if err := os.Remove(s.kubeConfig); err != nil {
+		log.Error().Err(err).Msg("Snapshot kubeconfig cleanup")
+	}
+}
@@ -44,7 +44,9 @@ require (
 	github.com/kylelemons/godebug v1.1.0 // indirect
 	github.com/mattn/go-runewidth v0.0.5
 	github.com/petergtz/pegomock v2.6.0+incompatible
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/rakyll/hey v0.1.2
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/rs/zerolog v1.17.2
 	github.com/sahilm/fuzzy v0.1.0
 	github.com/spf13/cobra v0.0.5
@@ -60,6 +60,7 @@ type APIClient struct {
 	cachedDiscovery *disk.CachedDiscoveryClient
 	config          *Config
 	useMetricServer bool
+	mxProvider      MetricsProvider
 	mx              sync.Mutex
 }
 
@@ -72,6 +73,21 @@ func InitConnectionOrDie(config *Config) *APIClient {
 	return &conn
 }
 
+// InitConnection initializes a connection for a given config. Unlike
+// InitConnectionOrDie it fails when the api server can't be reached.
+func InitConnection(config *Config) (*APIClient, error) {
+	if _, err := config.RESTConfig(); err != nil {
+		return nil, err
+	}
+	conn := APIClient{config: config}
+	if _, err := conn.ServerVersion(); err != nil {
+		return nil, err
+	}
+	conn.useMetricServer = conn.supportsMxServer()
+
+	return &conn, nil
+}
+
 func makeSAR(ns, gvr string) *authorizationv1.SelfSubjectAccessReview {
 	if ns == "-" {
 		ns = ""
@@ -181,7 +197,24 @@ func (a *APIClient) Config() *Config {
 
 // HasMetrics returns true if the cluster supports metrics.
 func (a *APIClient) HasMetrics() bool {
-	return a.useMetricServer
+	return a.useMetricServer || a.MetricsProvider() != nil
+}
+
+// MetricsProvider returns a custom metrics provider or nil if none is set.
+func (a *APIClient) MetricsProvider() MetricsProvider {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	return a.mxProvider
+}
+
+// SetMetricsProvider sources cluster metrics from a custom provider.
+// A nil provider reverts back to the cluster metrics-server.
+func (a *APIClient) SetMetricsProvider(p MetricsProvider) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.mxProvider = p
 }
 
 // DialOrDie returns a handle to api server or die.
@@ -275,6 +308,7 @@ func (a *APIClient) reset() {
 	defer a.mx.Unlock()
 
 	a.client, a.dClient, a.nsClient, a.mxsClient = nil, nil, nil, nil
+	a.mxProvider = nil
 }
 
 func (a *APIClient) supportsMxServer() bool {
@@ -36,6 +36,18 @@ func (c *Config) Flags() *genericclioptions.ConfigFlags {
 	return c.flags
 }
 
+// ForContext returns a new configuration targeting the given context.
+// Only kubeconfig level flags are carried over as cluster specific flags
+// would otherwise override the context settings.
+func (c *Config) ForContext(name string) *Config {
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.KubeConfig, flags.CacheDir, flags.Timeout = c.flags.KubeConfig, c.flags.CacheDir, c.flags.Timeout
+	flags.Impersonate, flags.ImpersonateGroup = c.flags.Impersonate, c.flags.ImpersonateGroup
+	flags.Context = &name
+
+	return NewConfig(flags)
+}
+
 // SwitchContext changes the kubeconfig context to a new cluster.
 func (c *Config) SwitchContext(name string) error {
 	currentCtx, err := c.CurrentContextName()
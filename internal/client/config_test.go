@@ -128,6 +128,25 @@ func TestConfigSwitchContext(t *testing.T) {
 	assert.Equal(t, "blee", ctx)
 }
 
+func TestConfigForContext(t *testing.T) {
+	cluster, kubeConfig := "duh", "./assets/config"
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig:  &kubeConfig,
+		ClusterName: &cluster,
+	}
+
+	cfg := client.NewConfig(&flags).ForContext("blee")
+	ctx, err := cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", ctx)
+	assert.Equal(t, kubeConfig, *cfg.Flags().KubeConfig)
+	assert.Equal(t, "", *cfg.Flags().ClusterName)
+
+	c, err := client.NewConfig(&flags).CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", c)
+}
+
 func TestConfigClusterNameFromContext(t *testing.T) {
 	cluster, kubeConfig := "duh", "./assets/config"
 	flags := genericclioptions.ConfigFlags{
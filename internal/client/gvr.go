@@ -156,6 +156,8 @@ func mapVerb(v string) ([]string, error) {
 		return []string{"get", "list"}, nil
 	case "delete":
 		return []string{"delete"}, nil
+	case "create":
+		return []string{"create"}, nil
 	case "edit":
 		return []string{"patch", "update"}, nil
 	default:
@@ -36,6 +36,8 @@ func TestGVRCan(t *testing.T) {
 		"no_delete": {[]string{"get", "list", "watch"}, "delete", false},
 		"edit":      {[]string{"path", "update", "watch"}, "edit", true},
 		"no_edit":   {[]string{"get", "list", "watch"}, "edit", false},
+		"create":    {[]string{"create", "get"}, "create", true},
+		"no_create": {[]string{"get", "list", "watch"}, "create", false},
 	}
 
 	for k := range uu {
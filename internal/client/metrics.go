@@ -9,9 +9,25 @@ import (
 )
 
 type (
+	// MetricsProvider supplies nodes and pods usage metrics.
+	MetricsProvider interface {
+		MetricsFetcher
+
+		// FetchPodMetrics returns a given pod metrics.
+		FetchPodMetrics(ns, n string) (*mv1beta1.PodMetrics, error)
+	}
+
+	// MetricsProviderHolder represents a connection with a custom metrics provider.
+	MetricsProviderHolder interface {
+		// MetricsProvider returns the connection metrics provider if any.
+		MetricsProvider() MetricsProvider
+	}
+
 	// MetricsServer serves cluster metrics for nodes and pods.
 	MetricsServer struct {
 		Connection
+
+		provider MetricsProvider
 	}
 
 	currentMetrics struct {
@@ -45,8 +61,20 @@ type (
 )
 
 // NewMetricsServer return a metric server instance.
+// If the connection is configured with a custom metrics provider, all
+// metrics are sourced from it instead of the cluster metrics-server.
 func NewMetricsServer(c Connection) *MetricsServer {
-	return &MetricsServer{Connection: c}
+	m := MetricsServer{Connection: c}
+	if h, ok := c.(MetricsProviderHolder); ok {
+		m.provider = h.MetricsProvider()
+	}
+
+	return &m
+}
+
+// Provider returns the custom metrics provider or nil if using metrics-server.
+func (m *MetricsServer) Provider() MetricsProvider {
+	return m.provider
 }
 
 // NodesMetrics retrieves metrics for a given set of nodes.
@@ -101,6 +129,9 @@ func (m *MetricsServer) ClusterLoad(nos *v1.NodeList, nmx *mv1beta1.NodeMetricsL
 
 // FetchNodesMetrics return all metrics for pods in a given namespace.
 func (m *MetricsServer) FetchNodesMetrics() (*mv1beta1.NodeMetricsList, error) {
+	if m.provider != nil {
+		return m.provider.FetchNodesMetrics()
+	}
 	auth, err := m.CanI("", "metrics.k8s.io/v1beta1/nodes", []string{"list"})
 	if !auth || err != nil {
 		return nil, err
@@ -116,6 +147,9 @@ func (m *MetricsServer) FetchNodesMetrics() (*mv1beta1.NodeMetricsList, error) {
 
 // FetchPodsMetrics return all metrics for pods in a given namespace.
 func (m *MetricsServer) FetchPodsMetrics(ns string) (*mv1beta1.PodMetricsList, error) {
+	if m.provider != nil {
+		return m.provider.FetchPodsMetrics(ns)
+	}
 	auth, err := m.CanI(ns, "metrics.k8s.io/v1beta1/pods", []string{"list"})
 	if !auth || err != nil {
 		return &mv1beta1.PodMetricsList{}, err
@@ -131,6 +165,9 @@ func (m *MetricsServer) FetchPodsMetrics(ns string) (*mv1beta1.PodMetricsList, e
 
 // FetchPodMetrics return all metrics for pods in a given namespace.
 func (m *MetricsServer) FetchPodMetrics(ns, sel string) (*mv1beta1.PodMetrics, error) {
+	if m.provider != nil {
+		return m.provider.FetchPodMetrics(ns, sel)
+	}
 	auth, err := m.CanI(ns, "metrics.k8s.io/v1beta1/pods", []string{"get"})
 	if !auth || err != nil {
 		return nil, err
@@ -0,0 +1,327 @@
+package client
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	// PodSeries represents a pod metrics series.
+	PodSeries = "pod"
+
+	// NodeSeries represents a node metrics series.
+	NodeSeries = "node"
+
+	// HPASeries represents a HPA replicas series.
+	HPASeries = "hpa"
+)
+
+type (
+	// MetricsFetcher fetches current pod and node metrics.
+	MetricsFetcher interface {
+		// FetchNodesMetrics returns all nodes metrics.
+		FetchNodesMetrics() (*mv1beta1.NodeMetricsList, error)
+
+		// FetchPodsMetrics returns all pods metrics in a given namespace.
+		FetchPodsMetrics(ns string) (*mv1beta1.PodMetricsList, error)
+	}
+
+	// Sample represents a metrics reading at a point in time.
+	Sample struct {
+		Time     time.Time
+		CPU      int64
+		MEM      float64
+		Replicas int32
+	}
+
+	// Stats summarizes a collection of readings.
+	Stats struct {
+		Min, Avg, Max, P95 float64
+	}
+
+	// SeriesStats summarizes a metrics series.
+	SeriesStats struct {
+		Count    int
+		CPU, MEM Stats
+		Replicas Stats
+	}
+
+	// MetricsHistory tracks a bounded time series of pod and node metrics
+	// and HPA replicas.
+	MetricsHistory struct {
+		retention  time.Duration
+		maxSamples int
+		maxSeries  int
+		series     map[string]map[string]*series
+		slots      []slot
+		head, size int
+		live       int
+		mx         sync.RWMutex
+	}
+
+	// Slot queues a series in creation order so the oldest can be evicted.
+	slot struct {
+		kind, fqn string
+		se        *series
+	}
+
+	series struct {
+		samples []Sample
+		next    int
+		last    time.Time
+	}
+)
+
+// NewMetricsHistory returns a new metrics store.
+func NewMetricsHistory(retention time.Duration, maxSamples, maxSeries int) *MetricsHistory {
+	h := MetricsHistory{
+		retention:  retention,
+		maxSamples: maxSamples,
+		maxSeries:  maxSeries,
+		series: map[string]map[string]*series{
+			PodSeries:  make(map[string]*series),
+			NodeSeries: make(map[string]*series),
+			HPASeries:  make(map[string]*series),
+		},
+	}
+	if maxSeries > 0 {
+		h.slots = make([]slot, maxSeries)
+	}
+
+	return &h
+}
+
+// Retention returns the store retention period.
+func (h *MetricsHistory) Retention() time.Duration {
+	return h.retention
+}
+
+// Sample records current nodes and pods metrics.
+func (h *MetricsHistory) Sample(f MetricsFetcher, ns string) error {
+	now := time.Now()
+	nmx, err := f.FetchNodesMetrics()
+	if err != nil {
+		return err
+	}
+	if nmx != nil {
+		for _, mx := range nmx.Items {
+			h.Record(NodeSeries, mx.Name, Sample{
+				Time: now,
+				CPU:  mx.Usage.Cpu().MilliValue(),
+				MEM:  toMB(mx.Usage.Memory().Value()),
+			})
+		}
+	}
+
+	pmx, err := f.FetchPodsMetrics(ns)
+	if err != nil {
+		return err
+	}
+	if pmx != nil {
+		for _, mx := range pmx.Items {
+			s := Sample{Time: now}
+			for _, c := range mx.Containers {
+				s.CPU += c.Usage.Cpu().MilliValue()
+				s.MEM += toMB(c.Usage.Memory().Value())
+			}
+			h.Record(PodSeries, mx.Namespace+"/"+mx.Name, s)
+		}
+	}
+	h.Prune(now)
+
+	return nil
+}
+
+// Record adds a new sample to a given series.
+func (h *MetricsHistory) Record(kind, fqn string, s Sample) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	ss, ok := h.series[kind]
+	if !ok {
+		ss = make(map[string]*series)
+		h.series[kind] = ss
+	}
+	se, ok := ss[fqn]
+	if !ok {
+		se = &series{samples: make([]Sample, 0, h.maxSamples)}
+		ss[fqn] = se
+		h.track(kind, fqn, se)
+	}
+	se.add(s, h.maxSamples)
+}
+
+// Samples returns a series samples in chronological order.
+func (h *MetricsHistory) Samples(kind, fqn string) []Sample {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	se, ok := h.series[kind][fqn]
+	if !ok {
+		return nil
+	}
+
+	return se.ordered(time.Now().Add(-h.retention))
+}
+
+// Stats returns a series summary.
+func (h *MetricsHistory) Stats(kind, fqn string) (SeriesStats, bool) {
+	ss := h.Samples(kind, fqn)
+	if len(ss) == 0 {
+		return SeriesStats{}, false
+	}
+
+	cpu, mem, rr := make([]float64, len(ss)), make([]float64, len(ss)), make([]float64, len(ss))
+	for i, s := range ss {
+		cpu[i], mem[i], rr[i] = float64(s.CPU), s.MEM, float64(s.Replicas)
+	}
+
+	return SeriesStats{
+		Count:    len(ss),
+		CPU:      computeStats(cpu),
+		MEM:      computeStats(mem),
+		Replicas: computeStats(rr),
+	}, true
+}
+
+// Keys returns all tracked series names for a given kind.
+func (h *MetricsHistory) Keys(kind string) []string {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	kk := make([]string, 0, len(h.series[kind]))
+	for k := range h.series[kind] {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
+
+// Prune drops series that have not been updated within the retention period.
+func (h *MetricsHistory) Prune(now time.Time) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	cutoff := now.Add(-h.retention)
+	for _, ss := range h.series {
+		for k, se := range ss {
+			if se.last.Before(cutoff) {
+				delete(ss, k)
+				h.live--
+			}
+		}
+	}
+}
+
+// Clear removes all tracked series.
+func (h *MetricsHistory) Clear() {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	for kind := range h.series {
+		h.series[kind] = make(map[string]*series)
+	}
+	for i := range h.slots {
+		h.slots[i] = slot{}
+	}
+	h.head, h.size, h.live = 0, 0, 0
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// Track queues a new series, evicting the oldest live series only when the
+// store is at capacity.
+func (h *MetricsHistory) track(kind, fqn string, se *series) {
+	h.live++
+	if len(h.slots) == 0 {
+		return
+	}
+	if h.live > len(h.slots) {
+		h.evictOldest()
+	}
+	// Pruned series leave stale slots behind, reclaim them once the queue is full.
+	if h.size == len(h.slots) {
+		h.compact()
+	}
+	h.slots[(h.head+h.size)%len(h.slots)] = slot{kind: kind, fqn: fqn, se: se}
+	h.size++
+}
+
+// EvictOldest drops the oldest live series, discarding stale slots on the way.
+func (h *MetricsHistory) evictOldest() {
+	for h.size > 0 {
+		sl := h.slots[h.head]
+		h.slots[h.head] = slot{}
+		h.head, h.size = (h.head+1)%len(h.slots), h.size-1
+		if h.isLive(sl) {
+			delete(h.series[sl.kind], sl.fqn)
+			h.live--
+			return
+		}
+	}
+}
+
+// Compact drops stale slots keeping live series in creation order.
+func (h *MetricsHistory) compact() {
+	ss := make([]slot, len(h.slots))
+	var n int
+	for i := 0; i < h.size; i++ {
+		if sl := h.slots[(h.head+i)%len(h.slots)]; h.isLive(sl) {
+			ss[n] = sl
+			n++
+		}
+	}
+	h.slots, h.head, h.size = ss, 0, n
+}
+
+func (h *MetricsHistory) isLive(sl slot) bool {
+	return sl.se != nil && h.series[sl.kind][sl.fqn] == sl.se
+}
+
+func (s *series) add(sa Sample, max int) {
+	s.last = sa.Time
+	if len(s.samples) < max {
+		s.samples = append(s.samples, sa)
+		return
+	}
+	s.samples[s.next] = sa
+	s.next = (s.next + 1) % max
+}
+
+func (s *series) ordered(cutoff time.Time) []Sample {
+	ss := make([]Sample, 0, len(s.samples))
+	for i := 0; i < len(s.samples); i++ {
+		sa := s.samples[(s.next+i)%len(s.samples)]
+		if sa.Time.Before(cutoff) {
+			continue
+		}
+		ss = append(ss, sa)
+	}
+
+	return ss
+}
+
+func computeStats(vv []float64) Stats {
+	sort.Float64s(vv)
+	var sum float64
+	for _, v := range vv {
+		sum += v
+	}
+	rank := int(math.Ceil(0.95*float64(len(vv)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+
+	return Stats{
+		Min: vv[0],
+		Avg: sum / float64(len(vv)),
+		Max: vv[len(vv)-1],
+		P95: vv[rank],
+	}
+}
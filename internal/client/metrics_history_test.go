@@ -0,0 +1,115 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestMetricsHistorySample(t *testing.T) {
+	h := NewMetricsHistory(time.Minute, 10, 100)
+	f := fakeFetcher{
+		nodes: &v1beta1.NodeMetricsList{Items: []v1beta1.NodeMetrics{*makeMxNode("n1", "1", "1Gi")}},
+		pods:  &v1beta1.PodMetricsList{Items: []v1beta1.PodMetrics{*makeMxPod("p1", "10m", "1Mi")}},
+	}
+
+	assert.Nil(t, h.Sample(f, ""))
+	assert.Nil(t, h.Sample(f, ""))
+	assert.Equal(t, []string{"n1"}, h.Keys(NodeSeries))
+	assert.Equal(t, []string{"default/p1"}, h.Keys(PodSeries))
+
+	st, ok := h.Stats(PodSeries, "default/p1")
+	assert.True(t, ok)
+	assert.Equal(t, 2, st.Count)
+	assert.Equal(t, Stats{Min: 30, Avg: 30, Max: 30, P95: 30}, st.CPU)
+	assert.Equal(t, Stats{Min: 3, Avg: 3, Max: 3, P95: 3}, st.MEM)
+}
+
+func TestMetricsHistoryBounded(t *testing.T) {
+	h := NewMetricsHistory(time.Hour, 5, 2)
+	now := time.Now()
+	for i := 0; i < 20; i++ {
+		h.Record(PodSeries, "default/p1", Sample{Time: now.Add(time.Duration(i) * time.Second), CPU: int64(i)})
+	}
+
+	ss := h.Samples(PodSeries, "default/p1")
+	assert.Equal(t, 5, len(ss))
+	assert.Equal(t, int64(15), ss[0].CPU)
+	assert.Equal(t, int64(19), ss[4].CPU)
+
+	h.Record(PodSeries, "default/p2", Sample{Time: now.Add(time.Minute)})
+	h.Record(NodeSeries, "n1", Sample{Time: now.Add(time.Minute)})
+	assert.Equal(t, []string{"default/p2"}, h.Keys(PodSeries))
+	assert.Equal(t, []string{"n1"}, h.Keys(NodeSeries))
+}
+
+func TestMetricsHistoryPrune(t *testing.T) {
+	h := NewMetricsHistory(time.Minute, 5, 10)
+	now := time.Now()
+	h.Record(PodSeries, "default/p1", Sample{Time: now.Add(-2 * time.Minute)})
+	h.Record(PodSeries, "default/p2", Sample{Time: now})
+
+	h.Prune(now)
+	assert.Equal(t, []string{"default/p2"}, h.Keys(PodSeries))
+
+	h.Clear()
+	assert.Equal(t, 0, len(h.Keys(PodSeries)))
+}
+
+func TestComputeStats(t *testing.T) {
+	vv := make([]float64, 0, 100)
+	for i := 100; i > 0; i-- {
+		vv = append(vv, float64(i))
+	}
+
+	assert.Equal(t, Stats{Min: 1, Avg: 50.5, Max: 100, P95: 95}, computeStats(vv))
+}
+
+// Helpers...
+
+type fakeFetcher struct {
+	nodes *v1beta1.NodeMetricsList
+	pods  *v1beta1.PodMetricsList
+}
+
+func (f fakeFetcher) FetchNodesMetrics() (*v1beta1.NodeMetricsList, error) {
+	return f.nodes, nil
+}
+
+func (f fakeFetcher) FetchPodsMetrics(string) (*v1beta1.PodMetricsList, error) {
+	return f.pods, nil
+}
+
+func TestMetricsHistoryEvictPruned(t *testing.T) {
+	h := NewMetricsHistory(time.Minute, 5, 2)
+	now := time.Now()
+	h.Record(PodSeries, "default/p1", Sample{Time: now.Add(-2 * time.Minute)})
+	h.Prune(now)
+	h.Record(PodSeries, "default/p1", Sample{Time: now})
+
+	// The stale p1 slot must not evict the live p1 series.
+	h.Record(PodSeries, "default/p2", Sample{Time: now})
+	assert.Equal(t, []string{"default/p1", "default/p2"}, h.Keys(PodSeries))
+
+	h.Record(PodSeries, "default/p3", Sample{Time: now})
+	assert.Equal(t, []string{"default/p2", "default/p3"}, h.Keys(PodSeries))
+}
+
+func TestMetricsHistoryPruneFrees(t *testing.T) {
+	h := NewMetricsHistory(time.Minute, 5, 3)
+	now := time.Now()
+	h.Record(PodSeries, "default/p1", Sample{Time: now})
+	h.Record(PodSeries, "default/p2", Sample{Time: now})
+	h.Record(PodSeries, "default/p3", Sample{Time: now.Add(-2 * time.Minute)})
+	h.Prune(now)
+
+	// Pruning made room, no live series must be dropped.
+	h.Record(PodSeries, "default/p4", Sample{Time: now})
+	assert.Equal(t, []string{"default/p1", "default/p2", "default/p4"}, h.Keys(PodSeries))
+
+	h.Record(NodeSeries, "n1", Sample{Time: now})
+	assert.Equal(t, []string{"default/p2", "default/p4"}, h.Keys(PodSeries))
+	assert.Equal(t, []string{"n1"}, h.Keys(NodeSeries))
+}
@@ -0,0 +1,333 @@
+package client
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	// NodeCPUQuery tracks nodes cpu usage in cores.
+	NodeCPUQuery = "nodeCPU"
+
+	// NodeMEMQuery tracks nodes memory usage in bytes.
+	NodeMEMQuery = "nodeMEM"
+
+	// PodCPUQuery tracks containers cpu usage in cores.
+	PodCPUQuery = "podCPU"
+
+	// PodMEMQuery tracks containers memory usage in bytes.
+	PodMEMQuery = "podMEM"
+
+	// PodRestartsQuery tracks pods restarts per hour.
+	PodRestartsQuery = "podRestarts"
+
+	// PodNetRXQuery tracks pods network bytes received per second.
+	PodNetRXQuery = "podNetRX"
+
+	// PodNetTXQuery tracks pods network bytes sent per second.
+	PodNetTXQuery = "podNetTX"
+
+	promQueryPath  = "/api/v1/query"
+	defaultTimeout = 10 * time.Second
+)
+
+// DefaultPromQueries represents the stock PromQL templates.
+// Templates may reference {{.Namespace}} to scope pod queries.
+var DefaultPromQueries = map[string]string{
+	NodeCPUQuery:     `sum by (node) (rate(container_cpu_usage_seconds_total{id="/"}[2m]))`,
+	NodeMEMQuery:     `sum by (node) (container_memory_working_set_bytes{id="/"})`,
+	PodCPUQuery:      `sum by (namespace, pod, container) (rate(container_cpu_usage_seconds_total{container!="",container!="POD"{{with .Namespace}},namespace="{{.}}"{{end}}}[2m]))`,
+	PodMEMQuery:      `sum by (namespace, pod, container) (container_memory_working_set_bytes{container!="",container!="POD"{{with .Namespace}},namespace="{{.}}"{{end}}})`,
+	PodRestartsQuery: `sum by (namespace, pod) (increase(kube_pod_container_status_restarts_total{ {{- with .Namespace}}namespace="{{.}}"{{end -}} }[1h]))`,
+	PodNetRXQuery:    `sum by (namespace, pod) (rate(container_network_receive_bytes_total{ {{- with .Namespace}}namespace="{{.}}"{{end -}} }[2m]))`,
+	PodNetTXQuery:    `sum by (namespace, pod) (rate(container_network_transmit_bytes_total{ {{- with .Namespace}}namespace="{{.}}"{{end -}} }[2m]))`,
+}
+
+type (
+	// PrometheusOpts tracks Prometheus connection options.
+	PrometheusOpts struct {
+		URL         string
+		Username    string
+		Password    string
+		BearerToken string
+		Insecure    bool
+		Timeout     time.Duration
+		Queries     map[string]string
+	}
+
+	// Prometheus serves nodes and pods metrics off a Prometheus server.
+	Prometheus struct {
+		opts    PrometheusOpts
+		client  *http.Client
+		queries map[string]*template.Template
+	}
+
+	// PodExtras tracks additional pod metrics.
+	PodExtras struct {
+		RestartRate float64
+		NetRX       float64
+		NetTX       float64
+	}
+
+	// PodsExtras tracks additional metrics per pods.
+	PodsExtras map[string]PodExtras
+
+	// ExtrasFetcher fetches additional pod metrics.
+	ExtrasFetcher interface {
+		// FetchPodsExtras returns additional metrics for pods in a given namespace.
+		FetchPodsExtras(ns string) (PodsExtras, error)
+	}
+
+	promResponse struct {
+		Status    string   `json:"status"`
+		ErrorType string   `json:"errorType"`
+		Error     string   `json:"error"`
+		Data      promData `json:"data"`
+	}
+
+	promData struct {
+		ResultType string       `json:"resultType"`
+		Result     []promSample `json:"result"`
+	}
+
+	promSample struct {
+		Metric map[string]string `json:"metric"`
+		Value  []interface{}     `json:"value"`
+	}
+)
+
+// NewPrometheus returns a new Prometheus metrics provider.
+func NewPrometheus(opts PrometheusOpts) (*Prometheus, error) {
+	if _, err := url.ParseRequestURI(opts.URL); err != nil {
+		return nil, fmt.Errorf("invalid prometheus url %q: %v", opts.URL, err)
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultTimeout
+	}
+
+	p := Prometheus{
+		opts:    opts,
+		client:  &http.Client{Timeout: opts.Timeout},
+		queries: make(map[string]*template.Template, len(DefaultPromQueries)),
+	}
+	if opts.Insecure {
+		p.client.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+	for k, q := range DefaultPromQueries {
+		if custom, ok := opts.Queries[k]; ok && custom != "" {
+			q = custom
+		}
+		tpl, err := template.New(k).Parse(q)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s query: %v", k, err)
+		}
+		p.queries[k] = tpl
+	}
+
+	return &p, nil
+}
+
+// FetchNodesMetrics returns all nodes metrics.
+func (p *Prometheus) FetchNodesMetrics() (*mv1beta1.NodeMetricsList, error) {
+	cpu, err := p.query(NodeCPUQuery, "")
+	if err != nil {
+		return nil, err
+	}
+	mem, err := p.query(NodeMEMQuery, "")
+	if err != nil {
+		return nil, err
+	}
+
+	mx := make(map[string]*mv1beta1.NodeMetrics)
+	nodeFor := func(s promSample) *mv1beta1.NodeMetrics {
+		n := s.Metric["node"]
+		if m, ok := mx[n]; ok {
+			return m
+		}
+		m := mv1beta1.NodeMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: n},
+			Usage:      make(v1.ResourceList),
+		}
+		mx[n] = &m
+		return &m
+	}
+	for _, s := range cpu {
+		nodeFor(s).Usage[v1.ResourceCPU] = cpuQty(s.value())
+	}
+	for _, s := range mem {
+		nodeFor(s).Usage[v1.ResourceMemory] = memQty(s.value())
+	}
+
+	var list mv1beta1.NodeMetricsList
+	for _, m := range mx {
+		list.Items = append(list.Items, *m)
+	}
+
+	return &list, nil
+}
+
+// FetchPodsMetrics returns all pods metrics in a given namespace.
+func (p *Prometheus) FetchPodsMetrics(ns string) (*mv1beta1.PodMetricsList, error) {
+	cpu, err := p.query(PodCPUQuery, ns)
+	if err != nil {
+		return nil, err
+	}
+	mem, err := p.query(PodMEMQuery, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make(map[string]*mv1beta1.PodMetrics)
+	containerFor := func(s promSample) v1.ResourceList {
+		fqn := FQN(s.Metric["namespace"], s.Metric["pod"])
+		pmx, ok := pods[fqn]
+		if !ok {
+			pmx = &mv1beta1.PodMetrics{
+				ObjectMeta: metav1.ObjectMeta{Namespace: s.Metric["namespace"], Name: s.Metric["pod"]},
+			}
+			pods[fqn] = pmx
+		}
+		co := s.Metric["container"]
+		for i := range pmx.Containers {
+			if pmx.Containers[i].Name == co {
+				return pmx.Containers[i].Usage
+			}
+		}
+		pmx.Containers = append(pmx.Containers, mv1beta1.ContainerMetrics{Name: co, Usage: make(v1.ResourceList)})
+
+		return pmx.Containers[len(pmx.Containers)-1].Usage
+	}
+	for _, s := range cpu {
+		containerFor(s)[v1.ResourceCPU] = cpuQty(s.value())
+	}
+	for _, s := range mem {
+		containerFor(s)[v1.ResourceMemory] = memQty(s.value())
+	}
+
+	var list mv1beta1.PodMetricsList
+	for _, m := range pods {
+		list.Items = append(list.Items, *m)
+	}
+
+	return &list, nil
+}
+
+// FetchPodMetrics returns a given pod metrics.
+func (p *Prometheus) FetchPodMetrics(ns, n string) (*mv1beta1.PodMetrics, error) {
+	list, err := p.FetchPodsMetrics(ns)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		if list.Items[i].Name == n {
+			return &list.Items[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no metrics found for pod %s", FQN(ns, n))
+}
+
+// FetchPodsExtras returns restarts rate and network io for pods in a given namespace.
+func (p *Prometheus) FetchPodsExtras(ns string) (PodsExtras, error) {
+	extras := make(PodsExtras)
+	for _, q := range []string{PodRestartsQuery, PodNetRXQuery, PodNetTXQuery} {
+		ss, err := p.query(q, ns)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range ss {
+			fqn := FQN(s.Metric["namespace"], s.Metric["pod"])
+			e := extras[fqn]
+			switch q {
+			case PodRestartsQuery:
+				e.RestartRate = s.value()
+			case PodNetRXQuery:
+				e.NetRX = s.value()
+			case PodNetTXQuery:
+				e.NetTX = s.value()
+			}
+			extras[fqn] = e
+		}
+	}
+
+	return extras, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (p *Prometheus) query(name, ns string) ([]promSample, error) {
+	var q strings.Builder
+	if err := p.queries[name].Execute(&q, struct{ Namespace string }{Namespace: ns}); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(p.opts.URL, "/")+promQueryPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = url.Values{"query": []string{q.String()}}.Encode()
+	switch {
+	case p.opts.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+p.opts.BearerToken)
+	case p.opts.Username != "":
+		req.SetBasicAuth(p.opts.Username, p.opts.Password)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var res promResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("prometheus %s query failed (%s): %v", name, resp.Status, err)
+	}
+	if res.Status != "success" {
+		return nil, fmt.Errorf("prometheus %s query failed: %s %s", name, res.ErrorType, res.Error)
+	}
+	if res.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("prometheus %s query must return a vector but got %q", name, res.Data.ResultType)
+	}
+
+	return res.Data.Result, nil
+}
+
+func (s promSample) value() float64 {
+	if len(s.Value) != 2 {
+		return 0
+	}
+	raw, ok := s.Value[1].(string)
+	if !ok {
+		return 0
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
+
+func cpuQty(cores float64) resource.Quantity {
+	return *resource.NewMilliQuantity(int64(cores*1000), resource.DecimalSI)
+}
+
+func memQty(bytes float64) resource.Quantity {
+	return *resource.NewQuantity(int64(bytes), resource.BinarySI)
+}
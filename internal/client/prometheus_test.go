@@ -0,0 +1,130 @@
+package client_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestPrometheusNodesMetrics(t *testing.T) {
+	srv := promStandIn(t, map[string]string{
+		"container_cpu_usage_seconds_total":  `{"metric":{"node":"n1"},"value":[1,"1.5"]}`,
+		"container_memory_working_set_bytes": `{"metric":{"node":"n1"},"value":[1,"1073741824"]}`,
+	})
+	defer srv.Close()
+
+	p, err := client.NewPrometheus(client.PrometheusOpts{URL: srv.URL, BearerToken: "blee"})
+	assert.Nil(t, err)
+
+	mx, err := p.FetchNodesMetrics()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(mx.Items))
+	assert.Equal(t, "n1", mx.Items[0].Name)
+	assert.Equal(t, int64(1500), mx.Items[0].Usage.Cpu().MilliValue())
+	assert.Equal(t, int64(1073741824), mx.Items[0].Usage.Memory().Value())
+}
+
+func TestPrometheusPodsMetrics(t *testing.T) {
+	srv := promStandIn(t, map[string]string{
+		"container_cpu_usage_seconds_total": `{"metric":{"namespace":"default","pod":"p1","container":"c1"},"value":[1,"0.1"]},` +
+			`{"metric":{"namespace":"default","pod":"p1","container":"c2"},"value":[1,"0.2"]}`,
+		"container_memory_working_set_bytes": `{"metric":{"namespace":"default","pod":"p1","container":"c1"},"value":[1,"1048576"]}`,
+	})
+	defer srv.Close()
+
+	p, err := client.NewPrometheus(client.PrometheusOpts{URL: srv.URL})
+	assert.Nil(t, err)
+
+	pmx, err := p.FetchPodMetrics("default", "p1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(pmx.Containers))
+
+	mmx := make(client.PodsMetrics)
+	client.NewMetricsServer(nil).PodsMetrics(&mv1beta1.PodMetricsList{Items: []mv1beta1.PodMetrics{*pmx}}, mmx)
+	assert.Equal(t, client.PodMetrics{CurrentCPU: 300, CurrentMEM: 1}, mmx["default/p1"])
+
+	_, err = p.FetchPodMetrics("default", "p2")
+	assert.NotNil(t, err)
+}
+
+func TestPrometheusPodsExtras(t *testing.T) {
+	srv := promStandIn(t, map[string]string{
+		"kube_pod_container_status_restarts_total": `{"metric":{"namespace":"default","pod":"p1"},"value":[1,"3"]}`,
+		"container_network_receive_bytes_total":    `{"metric":{"namespace":"default","pod":"p1"},"value":[1,"2048"]}`,
+		"container_network_transmit_bytes_total":   `{"metric":{"namespace":"default","pod":"p1"},"value":[1,"1024"]}`,
+	})
+	defer srv.Close()
+
+	p, err := client.NewPrometheus(client.PrometheusOpts{URL: srv.URL})
+	assert.Nil(t, err)
+
+	ee, err := p.FetchPodsExtras("default")
+	assert.Nil(t, err)
+	assert.Equal(t, client.PodExtras{RestartRate: 3, NetRX: 2048, NetTX: 1024}, ee["default/p1"])
+}
+
+func TestPrometheusQueryTemplates(t *testing.T) {
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.URL.Query().Get("query"))
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[]}}`)
+	}))
+	defer srv.Close()
+
+	p, err := client.NewPrometheus(client.PrometheusOpts{
+		URL:     srv.URL,
+		Queries: map[string]string{client.PodRestartsQuery: `restarts{ns="{{.Namespace}}"}`},
+	})
+	assert.Nil(t, err)
+
+	_, err = p.FetchPodsExtras("fred")
+	assert.Nil(t, err)
+	assert.Equal(t, `restarts{ns="fred"}`, seen[0])
+	assert.Contains(t, seen[1], `namespace="fred"`)
+
+	seen = nil
+	_, err = p.FetchPodsExtras("")
+	assert.Nil(t, err)
+	assert.NotContains(t, seen[1], "namespace=")
+}
+
+func TestPrometheusErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status":"error","errorType":"bad_data","error":"parse error"}`)
+	}))
+	defer srv.Close()
+
+	_, err := client.NewPrometheus(client.PrometheusOpts{URL: "blee"})
+	assert.NotNil(t, err)
+	_, err = client.NewPrometheus(client.PrometheusOpts{URL: srv.URL, Queries: map[string]string{client.NodeCPUQuery: "{{"}})
+	assert.NotNil(t, err)
+
+	p, err := client.NewPrometheus(client.PrometheusOpts{URL: srv.URL})
+	assert.Nil(t, err)
+	_, err = p.FetchNodesMetrics()
+	assert.Equal(t, "prometheus nodeCPU query failed: bad_data parse error", err.Error())
+}
+
+// Helpers...
+
+// PromStandIn serves canned vector results keyed by metric name.
+func promStandIn(t *testing.T, results map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/query", r.URL.Path)
+		q := r.URL.Query().Get("query")
+		var res string
+		for k, v := range results {
+			if strings.Contains(q, k+"{") {
+				res = v
+			}
+		}
+		fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[%s]}}`, res)
+	}))
+}
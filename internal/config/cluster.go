@@ -4,8 +4,9 @@ import "github.com/derailed/k9s/internal/client"
 
 // Cluster tracks K9s cluster configuration.
 type Cluster struct {
-	Namespace *Namespace `yaml:"namespace"`
-	View      *View      `yaml:"view"`
+	Namespace  *Namespace  `yaml:"namespace"`
+	View       *View       `yaml:"view"`
+	Prometheus *Prometheus `yaml:"prometheus,omitempty"`
 }
 
 // NewCluster creates a new cluster configuration.
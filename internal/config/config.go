@@ -23,6 +23,8 @@ var (
 	K9sLogs = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-%s.log", MustK9sUser()))
 	// K9sDumpDir represents a directory where K9s screen dumps will be persisted.
 	K9sDumpDir = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-screens-%s", MustK9sUser()))
+	// K9sTemplatesDir represents a directory where resource creation templates are stored.
+	K9sTemplatesDir = filepath.Join(K9sHome, "templates")
 )
 
 type (
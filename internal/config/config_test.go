@@ -260,10 +260,39 @@ func TestSetup(t *testing.T) {
 var expectedConfig = `k9s:
   refreshRate: 100
   headless: false
+  readOnly: false
   logBufferSize: 500
   logRequestSize: 100
+  screenDumpFormat: csv
   currentContext: blee
   currentCluster: blee
+  metrics:
+    retention: 15
+    maxSamples: 450
+    maxSeries: 2000
+  thresholds:
+    cpu:
+      warn: 70
+      critical: 90
+    memory:
+      warn: 70
+      critical: 90
+  informers:
+    idleTimeout: 300
+    maxGVRs: 50
+    listPageSize: 500
+    listThreshold: 0
+  lint:
+    rules: {}
+  nodeShell:
+    disabled: false
+    image: busybox:1.31
+    namespace: default
+    timeoutSeconds: 60
+    tolerations:
+    - operator: Exists
+  imageScan:
+    timeoutSeconds: 120
   clusters:
     blee:
       namespace:
@@ -299,10 +328,39 @@ var expectedConfig = `k9s:
 var resetConfig = `k9s:
   refreshRate: 2
   headless: false
+  readOnly: false
   logBufferSize: 200
   logRequestSize: 200
+  screenDumpFormat: csv
   currentContext: blee
   currentCluster: blee
+  metrics:
+    retention: 15
+    maxSamples: 450
+    maxSeries: 2000
+  thresholds:
+    cpu:
+      warn: 70
+      critical: 90
+    memory:
+      warn: 70
+      critical: 90
+  informers:
+    idleTimeout: 300
+    maxGVRs: 50
+    listPageSize: 500
+    listThreshold: 0
+  lint:
+    rules: {}
+  nodeShell:
+    disabled: false
+    image: busybox:1.31
+    namespace: default
+    timeoutSeconds: 60
+    tolerations:
+    - operator: Exists
+  imageScan:
+    timeoutSeconds: 120
   clusters:
     blee:
       namespace:
@@ -16,8 +16,10 @@ type Flags struct {
 	RefreshRate   *int
 	LogLevel      *string
 	Headless      *bool
+	ReadOnly      *bool
 	Command       *string
 	AllNamespaces *bool
+	Snapshot      *string
 }
 
 // NewFlags returns new configuration flags.
@@ -26,8 +28,10 @@ func NewFlags() *Flags {
 		RefreshRate:   intPtr(DefaultRefreshRate),
 		LogLevel:      strPtr(DefaultLogLevel),
 		Headless:      boolPtr(false),
+		ReadOnly:      boolPtr(false),
 		Command:       strPtr(DefaultCommand),
 		AllNamespaces: boolPtr(false),
+		Snapshot:      strPtr(""),
 	}
 }
 
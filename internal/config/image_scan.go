@@ -0,0 +1,31 @@
+package config
+
+const defaultScanTimeout = 120
+
+// ImageScan tracks the external image vulnerability scanner configuration.
+type ImageScan struct {
+	// Command runs the scanner. It must print its findings as JSON on stdout.
+	Command string `yaml:"command,omitempty"`
+	// Args are passed to the command. The {image} token is replaced by the
+	// image reference, otherwise the reference is appended.
+	Args []string `yaml:"args,omitempty"`
+	// TimeoutSeconds bounds a single image scan.
+	TimeoutSeconds int `yaml:"timeoutSeconds"`
+}
+
+// NewImageScan creates a new image scanner configuration.
+func NewImageScan() *ImageScan {
+	return &ImageScan{TimeoutSeconds: defaultScanTimeout}
+}
+
+// IsEnabled checks if an image scanner is configured.
+func (i *ImageScan) IsEnabled() bool {
+	return i != nil && i.Command != ""
+}
+
+// Validate an image scanner configuration.
+func (i *ImageScan) Validate() {
+	if i.TimeoutSeconds <= 0 {
+		i.TimeoutSeconds = defaultScanTimeout
+	}
+}
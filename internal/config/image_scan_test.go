@@ -0,0 +1,22 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageScanIsEnabled(t *testing.T) {
+	var i *config.ImageScan
+	assert.False(t, i.IsEnabled())
+	assert.False(t, config.NewImageScan().IsEnabled())
+	assert.True(t, (&config.ImageScan{Command: "trivy"}).IsEnabled())
+}
+
+func TestImageScanValidate(t *testing.T) {
+	var i config.ImageScan
+	i.Validate()
+
+	assert.Equal(t, 120, i.TimeoutSeconds)
+}
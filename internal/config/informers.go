@@ -0,0 +1,51 @@
+package config
+
+import "time"
+
+const (
+	defaultInformersIdleTimeout = 300
+	defaultInformersMaxGVRs     = 50
+	defaultInformersPageSize    = 500
+)
+
+// Informers tracks resource watchers configuration.
+type Informers struct {
+	// IdleTimeout represents the seconds an unused informer lingers before shutting down.
+	IdleTimeout int `yaml:"idleTimeout"`
+	// MaxGVRs caps the number of resources watched concurrently.
+	MaxGVRs int `yaml:"maxGVRs"`
+	// ListPageSize represents the page size used when listing instead of watching.
+	ListPageSize int64 `yaml:"listPageSize"`
+	// ListThreshold lists instead of watching collections larger than this. 0 to disable.
+	ListThreshold int64 `yaml:"listThreshold"`
+}
+
+// NewInformers creates a new informers configuration.
+func NewInformers() *Informers {
+	return &Informers{
+		IdleTimeout:  defaultInformersIdleTimeout,
+		MaxGVRs:      defaultInformersMaxGVRs,
+		ListPageSize: defaultInformersPageSize,
+	}
+}
+
+// IdleDuration returns the informers idle timeout.
+func (i *Informers) IdleDuration() time.Duration {
+	return time.Duration(i.IdleTimeout) * time.Second
+}
+
+// Validate an informers configuration.
+func (i *Informers) Validate() {
+	if i.IdleTimeout <= 0 {
+		i.IdleTimeout = defaultInformersIdleTimeout
+	}
+	if i.MaxGVRs <= 0 {
+		i.MaxGVRs = defaultInformersMaxGVRs
+	}
+	if i.ListPageSize <= 0 {
+		i.ListPageSize = defaultInformersPageSize
+	}
+	if i.ListThreshold < 0 {
+		i.ListThreshold = 0
+	}
+}
@@ -0,0 +1,42 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInformersValidate(t *testing.T) {
+	uu := map[string]struct {
+		i, e config.Informers
+	}{
+		"empty": {
+			i: config.Informers{},
+			e: config.Informers{IdleTimeout: 300, MaxGVRs: 50, ListPageSize: 500},
+		},
+		"custom": {
+			i: config.Informers{IdleTimeout: 60, MaxGVRs: 10, ListPageSize: 100, ListThreshold: 5000},
+			e: config.Informers{IdleTimeout: 60, MaxGVRs: 10, ListPageSize: 100, ListThreshold: 5000},
+		},
+		"negative": {
+			i: config.Informers{IdleTimeout: -1, ListThreshold: -10},
+			e: config.Informers{IdleTimeout: 300, MaxGVRs: 50, ListPageSize: 500},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.i.Validate()
+			assert.Equal(t, u.e, u.i)
+		})
+	}
+}
+
+func TestInformersIdleDuration(t *testing.T) {
+	i := config.NewInformers()
+
+	assert.Equal(t, 5*time.Minute, i.IdleDuration())
+}
@@ -6,29 +6,46 @@ const (
 	defaultRefreshRate    = 2
 	defaultLogRequestSize = 200
 	defaultLogBufferSize  = 1000
+	defaultDumpFormat     = "csv"
 )
 
 // K9s tracks K9s configuration options.
 type K9s struct {
 	RefreshRate       int                 `yaml:"refreshRate"`
 	Headless          bool                `yaml:"headless"`
+	ReadOnly          bool                `yaml:"readOnly"`
 	LogBufferSize     int                 `yaml:"logBufferSize"`
 	LogRequestSize    int                 `yaml:"logRequestSize"`
+	ScreenDumpFormat  string              `yaml:"screenDumpFormat"`
 	CurrentContext    string              `yaml:"currentContext"`
 	CurrentCluster    string              `yaml:"currentCluster"`
+	Metrics           *Metrics            `yaml:"metrics"`
+	Thresholds        *Thresholds         `yaml:"thresholds"`
+	Informers         *Informers          `yaml:"informers"`
+	Lint              *Lint               `yaml:"lint"`
+	NodeShell         *NodeShell          `yaml:"nodeShell"`
+	ImageScan         *ImageScan          `yaml:"imageScan"`
 	Clusters          map[string]*Cluster `yaml:"clusters,omitempty"`
 	manualRefreshRate int
 	manualHeadless    *bool
+	manualReadOnly    *bool
 	manualCommand     *string
 }
 
 // NewK9s create a new K9s configuration.
 func NewK9s() *K9s {
 	return &K9s{
-		RefreshRate:    defaultRefreshRate,
-		LogBufferSize:  defaultLogBufferSize,
-		LogRequestSize: defaultLogRequestSize,
-		Clusters:       make(map[string]*Cluster),
+		RefreshRate:      defaultRefreshRate,
+		LogBufferSize:    defaultLogBufferSize,
+		LogRequestSize:   defaultLogRequestSize,
+		ScreenDumpFormat: defaultDumpFormat,
+		Metrics:          NewMetrics(),
+		Thresholds:       NewThresholds(),
+		Informers:        NewInformers(),
+		Lint:             NewLint(),
+		NodeShell:        NewNodeShell(),
+		ImageScan:        NewImageScan(),
+		Clusters:         make(map[string]*Cluster),
 	}
 }
 
@@ -42,6 +59,11 @@ func (k *K9s) OverrideHeadless(b bool) {
 	k.manualHeadless = &b
 }
 
+// OverrideReadOnly set the read-only mode manually.
+func (k *K9s) OverrideReadOnly(b bool) {
+	k.manualReadOnly = &b
+}
+
 // OverrideCommand set the command manually.
 func (k *K9s) OverrideCommand(cmd string) {
 	k.manualCommand = &cmd
@@ -57,6 +79,16 @@ func (k *K9s) GetHeadless() bool {
 	return h
 }
 
+// IsReadOnly checks if cluster mutations are disabled.
+func (k *K9s) IsReadOnly() bool {
+	ro := k.ReadOnly
+	if k.manualReadOnly != nil && *k.manualReadOnly {
+		ro = *k.manualReadOnly
+	}
+
+	return ro
+}
+
 // GetRefreshRate returns the current refresh rate.
 func (k *K9s) GetRefreshRate() int {
 	rate := k.RefreshRate
@@ -93,6 +125,40 @@ func (k *K9s) validateDefaults() {
 	if k.LogRequestSize <= 0 {
 		k.LogRequestSize = defaultLogRequestSize
 	}
+
+	if k.ScreenDumpFormat == "" {
+		k.ScreenDumpFormat = defaultDumpFormat
+	}
+
+	if k.Metrics == nil {
+		k.Metrics = NewMetrics()
+	}
+	k.Metrics.Validate()
+
+	if k.Thresholds == nil {
+		k.Thresholds = NewThresholds()
+	}
+	k.Thresholds.Validate()
+
+	if k.Informers == nil {
+		k.Informers = NewInformers()
+	}
+	k.Informers.Validate()
+
+	if k.Lint == nil {
+		k.Lint = NewLint()
+	}
+	k.Lint.Validate()
+
+	if k.NodeShell == nil {
+		k.NodeShell = NewNodeShell()
+	}
+	k.NodeShell.Validate()
+
+	if k.ImageScan == nil {
+		k.ImageScan = NewImageScan()
+	}
+	k.ImageScan.Validate()
 }
 
 func (k *K9s) checkClusters(ks KubeSettings) {
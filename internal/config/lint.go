@@ -0,0 +1,29 @@
+package config
+
+// Lint tracks the cluster linter configuration.
+type Lint struct {
+	// Rules toggles lint rules by name. Rules not listed are enabled.
+	Rules map[string]bool `yaml:"rules"`
+}
+
+// NewLint creates a new linter configuration.
+func NewLint() *Lint {
+	return &Lint{Rules: make(map[string]bool)}
+}
+
+// IsEnabled checks if a lint rule is turned on.
+func (l *Lint) IsEnabled(rule string) bool {
+	if l == nil {
+		return true
+	}
+	on, ok := l.Rules[rule]
+
+	return !ok || on
+}
+
+// Validate a linter configuration.
+func (l *Lint) Validate() {
+	if l.Rules == nil {
+		l.Rules = make(map[string]bool)
+	}
+}
@@ -0,0 +1,50 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintIsEnabled(t *testing.T) {
+	uu := map[string]struct {
+		l    *config.Lint
+		rule string
+		e    bool
+	}{
+		"nil": {
+			rule: "pod-probes",
+			e:    true,
+		},
+		"unlisted": {
+			l:    &config.Lint{Rules: map[string]bool{"image-latest": false}},
+			rule: "pod-probes",
+			e:    true,
+		},
+		"off": {
+			l:    &config.Lint{Rules: map[string]bool{"image-latest": false}},
+			rule: "image-latest",
+			e:    false,
+		},
+		"on": {
+			l:    &config.Lint{Rules: map[string]bool{"image-latest": true}},
+			rule: "image-latest",
+			e:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.l.IsEnabled(u.rule))
+		})
+	}
+}
+
+func TestLintValidate(t *testing.T) {
+	var l config.Lint
+	l.Validate()
+
+	assert.NotNil(t, l.Rules)
+}
@@ -0,0 +1,44 @@
+package config
+
+import "time"
+
+const (
+	defaultMetricsRetention  = 15
+	defaultMetricsMaxSamples = 450
+	defaultMetricsMaxSeries  = 2000
+)
+
+// Metrics tracks metrics history configuration.
+type Metrics struct {
+	// Retention represents the history retention in minutes.
+	Retention  int `yaml:"retention"`
+	MaxSamples int `yaml:"maxSamples"`
+	MaxSeries  int `yaml:"maxSeries"`
+}
+
+// NewMetrics creates a new metrics configuration.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		Retention:  defaultMetricsRetention,
+		MaxSamples: defaultMetricsMaxSamples,
+		MaxSeries:  defaultMetricsMaxSeries,
+	}
+}
+
+// RetentionDuration returns the history retention period.
+func (m *Metrics) RetentionDuration() time.Duration {
+	return time.Duration(m.Retention) * time.Minute
+}
+
+// Validate a metrics configuration.
+func (m *Metrics) Validate() {
+	if m.Retention <= 0 {
+		m.Retention = defaultMetricsRetention
+	}
+	if m.MaxSamples <= 0 {
+		m.MaxSamples = defaultMetricsMaxSamples
+	}
+	if m.MaxSeries <= 0 {
+		m.MaxSeries = defaultMetricsMaxSeries
+	}
+}
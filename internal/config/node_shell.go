@@ -0,0 +1,58 @@
+package config
+
+const (
+	defaultShellImage     = "busybox:1.31"
+	defaultShellNamespace = "default"
+	defaultShellTimeout   = 60
+)
+
+// NodeShell tracks the node shell debug pod configuration.
+type NodeShell struct {
+	// Disabled turns the node shell action off.
+	Disabled bool `yaml:"disabled"`
+	// Image must provide nsenter and sh.
+	Image     string `yaml:"image"`
+	Namespace string `yaml:"namespace"`
+	// TimeoutSeconds bounds how long to wait for the shell pod to start.
+	TimeoutSeconds int          `yaml:"timeoutSeconds"`
+	Tolerations    []Toleration `yaml:"tolerations"`
+}
+
+// Toleration represents a shell pod toleration.
+type Toleration struct {
+	Key      string `yaml:"key,omitempty"`
+	Operator string `yaml:"operator,omitempty"`
+	Value    string `yaml:"value,omitempty"`
+	Effect   string `yaml:"effect,omitempty"`
+}
+
+// NewNodeShell creates a new node shell configuration.
+func NewNodeShell() *NodeShell {
+	return &NodeShell{
+		Image:          defaultShellImage,
+		Namespace:      defaultShellNamespace,
+		TimeoutSeconds: defaultShellTimeout,
+		Tolerations:    defaultTolerations(),
+	}
+}
+
+// Validate a node shell configuration.
+func (n *NodeShell) Validate() {
+	if n.Image == "" {
+		n.Image = defaultShellImage
+	}
+	if n.Namespace == "" {
+		n.Namespace = defaultShellNamespace
+	}
+	if n.TimeoutSeconds <= 0 {
+		n.TimeoutSeconds = defaultShellTimeout
+	}
+	if n.Tolerations == nil {
+		n.Tolerations = defaultTolerations()
+	}
+}
+
+// DefaultTolerations lets the shell pod land on tainted nodes.
+func defaultTolerations() []Toleration {
+	return []Toleration{{Operator: "Exists"}}
+}
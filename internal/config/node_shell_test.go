@@ -0,0 +1,25 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeShellValidate(t *testing.T) {
+	n := config.NodeShell{Image: "alpine:3.11"}
+	n.Validate()
+
+	assert.Equal(t, "alpine:3.11", n.Image)
+	assert.Equal(t, "default", n.Namespace)
+	assert.Equal(t, 60, n.TimeoutSeconds)
+	assert.Equal(t, []config.Toleration{{Operator: "Exists"}}, n.Tolerations)
+}
+
+func TestNodeShellValidateKeepsTolerations(t *testing.T) {
+	n := config.NodeShell{Tolerations: []config.Toleration{}}
+	n.Validate()
+
+	assert.Equal(t, []config.Toleration{}, n.Tolerations)
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// Prometheus tracks a cluster Prometheus metrics provider configuration.
+type Prometheus struct {
+	URL         string `yaml:"url"`
+	Username    string `yaml:"username,omitempty"`
+	Password    string `yaml:"password,omitempty"`
+	BearerToken string `yaml:"bearerToken,omitempty"`
+	Insecure    bool   `yaml:"insecure,omitempty"`
+	// Timeout represents the query timeout in seconds.
+	Timeout int `yaml:"timeout,omitempty"`
+	// Queries overrides the stock PromQL templates by name.
+	Queries map[string]string `yaml:"queries,omitempty"`
+}
+
+// IsEnabled returns true if a Prometheus server is configured.
+func (p *Prometheus) IsEnabled() bool {
+	return p != nil && p.URL != ""
+}
+
+// Opts returns the client connection options.
+func (p *Prometheus) Opts() client.PrometheusOpts {
+	return client.PrometheusOpts{
+		URL:         p.URL,
+		Username:    p.Username,
+		Password:    p.Password,
+		BearerToken: p.BearerToken,
+		Insecure:    p.Insecure,
+		Timeout:     time.Duration(p.Timeout) * time.Second,
+		Queries:     p.Queries,
+	}
+}
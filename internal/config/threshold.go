@@ -0,0 +1,60 @@
+package config
+
+const (
+	defaultWarnThreshold     = 70
+	defaultCriticalThreshold = 90
+)
+
+// Threshold tracks a resource utilization severity levels in percent.
+type Threshold struct {
+	Warn     int `yaml:"warn"`
+	Critical int `yaml:"critical"`
+}
+
+// NewThreshold returns a new threshold.
+func NewThreshold() *Threshold {
+	return &Threshold{
+		Warn:     defaultWarnThreshold,
+		Critical: defaultCriticalThreshold,
+	}
+}
+
+// Validate a threshold configuration.
+func (t *Threshold) Validate() {
+	if t.Warn <= 0 {
+		t.Warn = defaultWarnThreshold
+	}
+	if t.Critical <= 0 {
+		t.Critical = defaultCriticalThreshold
+	}
+	if t.Warn > t.Critical {
+		t.Warn = t.Critical
+	}
+}
+
+// Thresholds tracks cpu and memory utilization thresholds.
+type Thresholds struct {
+	CPU    *Threshold `yaml:"cpu"`
+	Memory *Threshold `yaml:"memory"`
+}
+
+// NewThresholds returns a new thresholds configuration.
+func NewThresholds() *Thresholds {
+	return &Thresholds{
+		CPU:    NewThreshold(),
+		Memory: NewThreshold(),
+	}
+}
+
+// Validate a thresholds configuration.
+func (t *Thresholds) Validate() {
+	if t.CPU == nil {
+		t.CPU = NewThreshold()
+	}
+	t.CPU.Validate()
+
+	if t.Memory == nil {
+		t.Memory = NewThreshold()
+	}
+	t.Memory.Validate()
+}
@@ -0,0 +1,35 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThresholdsValidate(t *testing.T) {
+	uu := map[string]struct {
+		t, e config.Thresholds
+	}{
+		"empty": {
+			t: config.Thresholds{},
+			e: config.Thresholds{CPU: &config.Threshold{Warn: 70, Critical: 90}, Memory: &config.Threshold{Warn: 70, Critical: 90}},
+		},
+		"custom": {
+			t: config.Thresholds{CPU: &config.Threshold{Warn: 50, Critical: 80}},
+			e: config.Thresholds{CPU: &config.Threshold{Warn: 50, Critical: 80}, Memory: &config.Threshold{Warn: 70, Critical: 90}},
+		},
+		"inverted": {
+			t: config.Thresholds{CPU: &config.Threshold{Warn: 95, Critical: 80}, Memory: &config.Threshold{Critical: 50}},
+			e: config.Thresholds{CPU: &config.Threshold{Warn: 80, Critical: 80}, Memory: &config.Threshold{Warn: 50, Critical: 50}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.t.Validate()
+			assert.Equal(t, u.e, u.t)
+		})
+	}
+}
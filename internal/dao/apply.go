@@ -0,0 +1,323 @@
+package dao
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+const (
+	fieldManager = "k9s"
+
+	// ApplyCreate indicates a resource will be created.
+	ApplyCreate = "create"
+	// ApplyUpdate indicates a resource will be updated.
+	ApplyUpdate = "update"
+)
+
+// Manifest represents a resource loaded from a local manifest.
+type Manifest struct {
+	Path   string
+	Object *unstructured.Unstructured
+}
+
+// ID returns the manifest resource identifier.
+func (m Manifest) ID() string {
+	return strings.ToLower(m.Object.GetKind()) + "/" + client.FQN(m.Object.GetNamespace(), m.Object.GetName())
+}
+
+// IsManifest checks if a file looks like a k8s manifest.
+func IsManifest(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+// LoadManifests loads all resources from a manifest file or a directory
+// of manifests. Multi documents files are supported.
+func LoadManifests(path string) ([]Manifest, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if fi.IsDir() {
+		files = files[:0]
+		err := filepath.Walk(path, func(p string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !fi.IsDir() && IsManifest(p) {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(files)
+	}
+
+	var mm []Manifest
+	for _, f := range files {
+		raw, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		oo, err := decodeManifest(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", f, err)
+		}
+		for _, o := range oo {
+			if o.GetKind() == "" || o.GetName() == "" {
+				return nil, fmt.Errorf("%s: resources must have a kind and a name", f)
+			}
+			mm = append(mm, Manifest{Path: f, Object: o})
+		}
+	}
+	if len(mm) == 0 {
+		return nil, fmt.Errorf("no resources found in %s", path)
+	}
+
+	return mm, nil
+}
+
+// Applier applies local manifests via server side apply.
+type Applier struct {
+	conn   client.Connection
+	mapper meta.RESTMapper
+	ns     string
+}
+
+// NewApplier returns a new manifests applier.
+func NewApplier(c client.Connection) (*Applier, error) {
+	m, err := (&RestMapper{Connection: c}).ToRESTMapper()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Applier{conn: c, mapper: m}, nil
+}
+
+// SetNamespace sets the namespace used for namespaced resources without one.
+// Defaults to the current namespace.
+func (a *Applier) SetNamespace(ns string) {
+	a.ns = ns
+}
+
+// Plan checks if a manifest resource will be created or updated.
+func (a *Applier) Plan(m Manifest) (string, error) {
+	dial, o, err := manifestDial(a.conn, a.mapper, m.Object, a.ns)
+	if err != nil {
+		return "", err
+	}
+	_, err = dial.Get(o.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return ApplyCreate, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return ApplyUpdate, nil
+}
+
+// Apply applies a manifest resource and returns the outcome. Fields owned by
+// other managers are only taken over when force is set, otherwise a conflict
+// error is returned.
+func (a *Applier) Apply(m Manifest, dryRun, force bool) (string, error) {
+	action, err := a.Plan(m)
+	if err != nil {
+		return "", err
+	}
+	dial, o, err := manifestDial(a.conn, a.mapper, m.Object, a.ns)
+	if err != nil {
+		return "", err
+	}
+	if _, err := serverApply(dial, o, dryRun, force); err != nil {
+		return "", err
+	}
+
+	res := "configured"
+	if action == ApplyCreate {
+		res = "created"
+	}
+	if dryRun {
+		res += " (dry run)"
+	}
+
+	return res, nil
+}
+
+// Create creates a manifest resource. It fails if the resource already exists.
+func (a *Applier) Create(m Manifest) (*unstructured.Unstructured, error) {
+	dial, o, err := manifestDial(a.conn, a.mapper, m.Object, a.ns)
+	if err != nil {
+		return nil, err
+	}
+
+	return dial.Create(o, metav1.CreateOptions{FieldManager: fieldManager})
+}
+
+// Merge updates a manifest resource with a three-way merge between the
+// previously applied manifest, the desired manifest and the live resource.
+// Fields dropped from the desired manifest are removed while fields set by
+// the cluster are kept. Missing resources are created.
+func (a *Applier) Merge(original *unstructured.Unstructured, m Manifest) error {
+	dial, o, err := manifestDial(a.conn, a.mapper, m.Object, a.ns)
+	if err != nil {
+		return err
+	}
+	live, err := dial.Get(o.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = dial.Create(o, metav1.CreateOptions{FieldManager: fieldManager})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	var orig []byte
+	if original != nil {
+		if orig, err = original.MarshalJSON(); err != nil {
+			return err
+		}
+	}
+	modified, err := o.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	current, err := live.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	patch, pt, err := threeWayPatch(o.GroupVersionKind(), orig, modified, current)
+	if err != nil {
+		return err
+	}
+	if string(patch) == "{}" {
+		return nil
+	}
+	_, err = dial.Patch(o.GetName(), pt, patch, metav1.PatchOptions{FieldManager: fieldManager})
+
+	return err
+}
+
+// Delete deletes a manifest resource. Missing resources are ignored.
+func (a *Applier) Delete(m Manifest) error {
+	dial, o, err := manifestDial(a.conn, a.mapper, m.Object, a.ns)
+	if err != nil {
+		return err
+	}
+	p := metav1.DeletePropagationBackground
+	err = dial.Delete(o.GetName(), &metav1.DeleteOptions{PropagationPolicy: &p})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ManifestDial returns a dynamic client for a manifest resource along with a
+// copy of the resource to send. Namespaced resources without a namespace use
+// the given or current namespace. The manifest resource is left untouched.
+func manifestDial(c client.Connection, m meta.RESTMapper, mo *unstructured.Unstructured, ns string) (dynamic.ResourceInterface, *unstructured.Unstructured, error) {
+	o := mo.DeepCopy()
+	gvk := o.GroupVersionKind()
+	mapping, err := m.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+	dial := c.DynDialOrDie().Resource(mapping.Resource)
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return dial, o, nil
+	}
+	if o.GetNamespace() == "" {
+		if ns == "" {
+			var err error
+			if ns, err = c.CurrentNamespaceName(); err != nil || ns == "" {
+				ns = "default"
+			}
+		}
+		o.SetNamespace(ns)
+	}
+
+	return dial.Namespace(o.GetNamespace()), o, nil
+}
+
+// ThreeWayPatch computes a strategic merge patch for built-in resources and
+// a json merge patch for the others such as custom resources.
+func threeWayPatch(gvk schema.GroupVersionKind, original, modified, current []byte) ([]byte, types.PatchType, error) {
+	o, err := scheme.Scheme.New(gvk)
+	if runtime.IsNotRegisteredError(err) {
+		patch, err := jsonmergepatch.CreateThreeWayJSONMergePatch(original, modified, current)
+		return patch, types.MergePatchType, err
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	pm, err := strategicpatch.NewPatchMetaFromStruct(o)
+	if err != nil {
+		return nil, "", err
+	}
+	patch, err := strategicpatch.CreateThreeWayMergePatch(original, modified, current, pm, true)
+
+	return patch, types.StrategicMergePatchType, err
+}
+
+// ServerApply applies a resource using server side apply, falling back to
+// create/update on servers that do not support it. Field ownership conflicts
+// are only overridden when force is set.
+func serverApply(dial dynamic.ResourceInterface, o *unstructured.Unstructured, dryRun, force bool) (*unstructured.Unstructured, error) {
+	var dr []string
+	if dryRun {
+		dr = []string{metav1.DryRunAll}
+	}
+	raw, err := o.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	res, err := dial.Patch(o.GetName(), types.ApplyPatchType, raw, metav1.PatchOptions{
+		DryRun:       dr,
+		FieldManager: fieldManager,
+		Force:        &force,
+	})
+	if err == nil || !errors.IsUnsupportedMediaType(err) {
+		return res, err
+	}
+	log.Warn().Err(err).Msgf("Server side apply not supported for %s. Falling back", o.GetName())
+
+	live, err := dial.Get(o.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return dial.Create(o, metav1.CreateOptions{DryRun: dr, FieldManager: fieldManager})
+	}
+	if err != nil {
+		return nil, err
+	}
+	o.SetResourceVersion(live.GetResourceVersion())
+
+	return dial.Update(o, metav1.UpdateOptions{DryRun: dr, FieldManager: fieldManager})
+}
@@ -0,0 +1,130 @@
+package dao
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestLoadManifests(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-apply")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"cm.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm1\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm2\n  namespace: fred\n",
+		"sa.json": `{"apiVersion": "v1", "kind": "ServiceAccount", "metadata": {"name": "sa1"}}`,
+		"README":  "blee",
+	}
+	for n, c := range files {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, n), []byte(c), 0600))
+	}
+
+	uu := map[string]struct {
+		path string
+		ids  []string
+		err  bool
+	}{
+		"file": {
+			path: filepath.Join(dir, "cm.yaml"),
+			ids:  []string{"configmap/cm1", "configmap/fred/cm2"},
+		},
+		"dir": {
+			path: dir,
+			ids:  []string{"configmap/cm1", "configmap/fred/cm2", "serviceaccount/sa1"},
+		},
+		"empty": {
+			path: filepath.Join(dir, "README"),
+			err:  true,
+		},
+		"missing": {
+			path: filepath.Join(dir, "zorg.yaml"),
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			mm, err := LoadManifests(u.path)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			ids := make([]string, 0, len(mm))
+			for _, m := range mm {
+				ids = append(ids, m.ID())
+			}
+			assert.Equal(t, u.ids, ids)
+		})
+	}
+}
+
+func TestIsManifest(t *testing.T) {
+	assert.True(t, IsManifest("fred.yaml"))
+	assert.True(t, IsManifest("fred.YML"))
+	assert.True(t, IsManifest("fred.json"))
+	assert.False(t, IsManifest("fred.txt"))
+}
+
+func TestThreeWayPatch(t *testing.T) {
+	uu := map[string]struct {
+		gvk                         schema.GroupVersionKind
+		original, modified, current string
+		pt                          types.PatchType
+		e                           string
+	}{
+		"dropField": {
+			gvk:      schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+			original: `{"data":{"a":"1","b":"2"}}`,
+			modified: `{"data":{"a":"1"}}`,
+			current:  `{"data":{"a":"1","b":"2"}}`,
+			pt:       types.StrategicMergePatchType,
+			e:        `{"data":{"b":null}}`,
+		},
+		"keepLive": {
+			gvk:      schema.GroupVersionKind{Version: "v1", Kind: "Service"},
+			original: `{"spec":{"type":"NodePort"}}`,
+			modified: `{"spec":{"type":"ClusterIP"}}`,
+			current:  `{"spec":{"clusterIP":"10.0.0.1","type":"NodePort"}}`,
+			pt:       types.StrategicMergePatchType,
+			e:        `{"spec":{"type":"ClusterIP"}}`,
+		},
+		"noOriginal": {
+			gvk:      schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+			modified: `{"data":{"a":"1"}}`,
+			current:  `{"data":{"a":"1","b":"2"}}`,
+			pt:       types.StrategicMergePatchType,
+			e:        `{}`,
+		},
+		"custom": {
+			gvk:      schema.GroupVersionKind{Group: "fred.io", Version: "v1", Kind: "Blee"},
+			original: `{"spec":{"a":1,"b":2}}`,
+			modified: `{"spec":{"a":3}}`,
+			current:  `{"spec":{"a":1,"b":2}}`,
+			pt:       types.MergePatchType,
+			e:        `{"spec":{"a":3,"b":null}}`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var orig []byte
+			if u.original != "" {
+				orig = []byte(u.original)
+			}
+			patch, pt, err := threeWayPatch(u.gvk, orig, []byte(u.modified), []byte(u.current))
+
+			assert.Nil(t, err)
+			assert.Equal(t, u.pt, pt)
+			assert.JSONEq(t, u.e, string(patch))
+		})
+	}
+}
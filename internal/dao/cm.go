@@ -0,0 +1,206 @@
+package dao
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ConfigMap represents a configmap resource.
+type ConfigMap struct {
+	Generic
+}
+
+var _ Accessor = (*ConfigMap)(nil)
+
+// Value returns a configmap key value and whether it is binary.
+func (c *ConfigMap) Value(path, key string) ([]byte, bool, error) {
+	cm, err := c.load(path)
+	if err != nil {
+		return nil, false, err
+	}
+	if v, ok := cm.Data[key]; ok {
+		return []byte(v), false, nil
+	}
+	if v, ok := cm.BinaryData[key]; ok {
+		return v, true, nil
+	}
+
+	return nil, false, fmt.Errorf("no key %q found in configmap %s", key, path)
+}
+
+// Export writes out the given configmap keys as local files in dir.
+func (c *ConfigMap) Export(path string, keys []string, dir string) ([]string, error) {
+	cm, err := c.load(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	ff := make([]string, 0, len(keys))
+	for _, k := range keys {
+		raw, ok := cm.BinaryData[k]
+		if v, found := cm.Data[k]; found {
+			raw, ok = []byte(v), true
+		}
+		if !ok {
+			return ff, fmt.Errorf("no key %q found in configmap %s", k, path)
+		}
+		f := filepath.Join(dir, k)
+		if err := ioutil.WriteFile(f, raw, 0600); err != nil {
+			return ff, err
+		}
+		ff = append(ff, f)
+	}
+
+	return ff, nil
+}
+
+// ImportKey returns the configmap key a local file is imported as.
+func ImportKey(key, file string) string {
+	if key != "" {
+		return key
+	}
+
+	return filepath.Base(file)
+}
+
+// Import adds a local file content as a configmap key. Existing keys are
+// only replaced when overwrite is set.
+func (c *ConfigMap) Import(path, key, file string, overwrite bool) error {
+	ns, n := client.Namespaced(path)
+	auth, err := c.Client().CanI(ns, "v1/configmaps", []string{"get", "update"})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update configmap %s", path)
+	}
+
+	raw, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	key = ImportKey(key, file)
+
+	dial := c.Client().DialOrDie().CoreV1().ConfigMaps(ns)
+	cm, err := dial.Get(n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !overwrite && hasKey(cm, key) {
+		return fmt.Errorf("key %q already exists in configmap %s", key, path)
+	}
+	if utf8.Valid(raw) {
+		if cm.Data == nil {
+			cm.Data = make(map[string]string, 1)
+		}
+		cm.Data[key] = string(raw)
+		delete(cm.BinaryData, key)
+	} else {
+		if cm.BinaryData == nil {
+			cm.BinaryData = make(map[string][]byte, 1)
+		}
+		cm.BinaryData[key] = raw
+		delete(cm.Data, key)
+	}
+	_, err = dial.Update(cm)
+
+	return err
+}
+
+// UsedBy returns all pods referencing the configmap and how.
+func (c *ConfigMap) UsedBy(path string) (map[string][]string, error) {
+	ns, n := client.Namespaced(path)
+	oo, err := c.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make(map[string][]string)
+	for _, o := range oo {
+		var po v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+		if err != nil {
+			return nil, err
+		}
+		if rr := cmRefs(po.Spec, n); len(rr) > 0 {
+			refs[po.Name] = rr
+		}
+	}
+
+	return refs, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (c *ConfigMap) load(path string) (*v1.ConfigMap, error) {
+	o, err := c.Get(c.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var cm v1.ConfigMap
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &cm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cm, nil
+}
+
+func hasKey(cm *v1.ConfigMap, key string) bool {
+	if _, ok := cm.Data[key]; ok {
+		return true
+	}
+	_, ok := cm.BinaryData[key]
+
+	return ok
+}
+
+func cmRefs(spec v1.PodSpec, n string) []string {
+	var rr []string
+	for _, v := range spec.Volumes {
+		if v.ConfigMap != nil && v.ConfigMap.Name == n {
+			rr = append(rr, "volume:"+v.Name)
+		}
+		if v.Projected == nil {
+			continue
+		}
+		for _, s := range v.Projected.Sources {
+			if s.ConfigMap != nil && s.ConfigMap.Name == n {
+				rr = append(rr, "projected:"+v.Name)
+			}
+		}
+	}
+
+	cc := append(append([]v1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, co := range cc {
+		for _, e := range co.EnvFrom {
+			if e.ConfigMapRef != nil && e.ConfigMapRef.Name == n {
+				rr = append(rr, "envFrom:"+co.Name)
+			}
+		}
+		for _, e := range co.Env {
+			if e.ValueFrom != nil && e.ValueFrom.ConfigMapKeyRef != nil && e.ValueFrom.ConfigMapKeyRef.Name == n {
+				rr = append(rr, "env:"+co.Name+"/"+e.Name)
+			}
+		}
+	}
+	sort.Strings(rr)
+
+	return rr
+}
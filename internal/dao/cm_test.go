@@ -0,0 +1,73 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestCMRefs(t *testing.T) {
+	uu := map[string]struct {
+		spec v1.PodSpec
+		e    []string
+	}{
+		"none": {
+			spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+		},
+		"volume": {
+			spec: v1.PodSpec{
+				Volumes: []v1.Volume{
+					{Name: "v1", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+						LocalObjectReference: v1.LocalObjectReference{Name: "cm1"},
+					}}},
+					{Name: "v2", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+						LocalObjectReference: v1.LocalObjectReference{Name: "cm2"},
+					}}},
+				},
+			},
+			e: []string{"volume:v1"},
+		},
+		"env": {
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{
+					{Name: "i1", EnvFrom: []v1.EnvFromSource{
+						{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+					}},
+				},
+				Containers: []v1.Container{
+					{Name: "c1", Env: []v1.EnvVar{
+						{Name: "FRED", ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "cm1"},
+							Key:                  "k1",
+						}}},
+					}},
+				},
+			},
+			e: []string{"env:c1/FRED", "envFrom:i1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, cmRefs(u.spec, "cm1"))
+		})
+	}
+}
+
+func TestCMHasKey(t *testing.T) {
+	cm := v1.ConfigMap{
+		Data:       map[string]string{"a": "1"},
+		BinaryData: map[string][]byte{"b": {0x00}},
+	}
+
+	assert.True(t, hasKey(&cm, "a"))
+	assert.True(t, hasKey(&cm, "b"))
+	assert.False(t, hasKey(&cm, "c"))
+}
+
+func TestImportKey(t *testing.T) {
+	assert.Equal(t, "k1", ImportKey("k1", "/tmp/fred.json"))
+	assert.Equal(t, "fred.json", ImportKey("", "/tmp/fred.json"))
+}
@@ -1,13 +1,24 @@
 package dao
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
 	"github.com/derailed/k9s/internal/client"
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
-const maxJobNameSize = 42
+const (
+	maxJobNameSize = 42
+	cjGVR          = "batch/v1beta1/cronjobs"
+	jobGVR         = "batch/v1/jobs"
+)
 
 // CronJob represents a cronjob K8s resource.
 type CronJob struct {
@@ -20,7 +31,7 @@ var _ Runnable = (*CronJob)(nil)
 // Run a CronJob.
 func (c *CronJob) Run(path string) error {
 	ns, n := client.Namespaced(path)
-	auth, err := c.Client().CanI(ns, "batch/v1beta1/cronjobs", []string{"get", "create"})
+	auth, err := c.Client().CanI(ns, cjGVR, []string{"get", "create"})
 	if !auth || err != nil {
 		return err
 	}
@@ -47,3 +58,100 @@ func (c *CronJob) Run(path string) error {
 
 	return err
 }
+
+// ToggleSuspend suspends or resumes a CronJob. It returns the new suspend
+// state.
+func (c *CronJob) ToggleSuspend(path string) (bool, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := c.Client().CanI(ns, cjGVR, []string{"get", "patch"})
+	if err != nil {
+		return false, err
+	}
+	if !auth {
+		return false, fmt.Errorf("user is not authorized to patch cronjob %s", path)
+	}
+
+	cj, err := c.Client().DialOrDie().BatchV1beta1().CronJobs(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	suspend := cj.Spec.Suspend == nil || !*cj.Spec.Suspend
+	raw, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]bool{"suspend": suspend},
+	})
+	if err != nil {
+		return false, err
+	}
+	_, err = c.Client().DialOrDie().BatchV1beta1().CronJobs(ns).Patch(n, types.MergePatchType, raw)
+
+	return suspend, err
+}
+
+// LastRun returns the path of the most recent job spawned by a CronJob.
+func (c *CronJob) LastRun(path string) (string, error) {
+	o, err := c.Get(cjGVR, path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	runs, err := CronJobRuns(c.Factory, u.GetNamespace())
+	if err != nil {
+		return "", err
+	}
+	jj := runs[u.GetUID()]
+	if len(jj) == 0 {
+		return "", fmt.Errorf("no job runs found for cronjob %s", path)
+	}
+
+	return client.FQN(jj[0].Namespace, jj[0].Name), nil
+}
+
+// CronJobRuns returns the jobs spawned by cronjobs in a namespace keyed by
+// cronjob uid. The most recent jobs come first.
+func CronJobRuns(f Factory, ns string) (map[types.UID][]*batchv1.Job, error) {
+	oo, err := f.List(jobGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make(map[types.UID][]*batchv1.Job)
+	for _, o := range oo {
+		var job batchv1.Job
+		if err := fromUnstructured(o, &job); err != nil {
+			return nil, err
+		}
+		for _, ref := range job.OwnerReferences {
+			if ref.Kind == "CronJob" {
+				runs[ref.UID] = append(runs[ref.UID], &job)
+			}
+		}
+	}
+	for _, jj := range runs {
+		sort.Slice(jj, func(i, j int) bool {
+			return jj[j].CreationTimestamp.Before(&jj[i].CreationTimestamp)
+		})
+	}
+
+	return runs, nil
+}
+
+// LastSuccess returns the completion time of the most recent successful job
+// or nil if none succeeded.
+func LastSuccess(jj []*batchv1.Job) *metav1.Time {
+	var last *metav1.Time
+	for _, j := range jj {
+		t := j.Status.CompletionTime
+		if t == nil || !jobHasCondition(j, batchv1.JobComplete) {
+			continue
+		}
+		if last == nil || last.Before(t) {
+			last = t
+		}
+	}
+
+	return last
+}
@@ -0,0 +1,173 @@
+package dao
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/pmezard/go-difflib/difflib"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	syaml "sigs.k8s.io/yaml"
+)
+
+const (
+	diffContext = 3
+	lastApplied = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// DiffSide represents one side of a resource diff.
+type DiffSide struct {
+	Name   string
+	Object map[string]interface{}
+}
+
+// Diff returns a unified diff of two resources YAML. Noisy fields such as
+// managedFields, resourceVersion or status are stripped unless full is set.
+func Diff(from, to DiffSide, full bool) (string, error) {
+	a, err := diffYAML(from.Object, full)
+	if err != nil {
+		return "", err
+	}
+	b, err := diffYAML(to.Object, full)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(a),
+		B:        difflib.SplitLines(b),
+		FromFile: from.Name,
+		ToFile:   to.Name,
+		Context:  diffContext,
+	})
+}
+
+// StripNoise returns a copy of a resource minus server managed fields.
+func StripNoise(o map[string]interface{}) map[string]interface{} {
+	c := runtimeCopy(o)
+	delete(c, "status")
+	m, ok := c["metadata"].(map[string]interface{})
+	if !ok {
+		return c
+	}
+	for _, k := range []string{"managedFields", "resourceVersion", "uid", "generation", "selfLink", "creationTimestamp"} {
+		delete(m, k)
+	}
+	if aa, ok := m["annotations"].(map[string]interface{}); ok {
+		delete(aa, lastApplied)
+		if len(aa) == 0 {
+			delete(m, "annotations")
+		}
+	}
+
+	return c
+}
+
+// DryRunDiff diffs local manifests against live resources using a server
+// side dry-run of the manifests.
+func DryRunDiff(c client.Connection, path string, full bool) (string, error) {
+	mm, err := LoadManifests(path)
+	if err != nil {
+		return "", err
+	}
+
+	m, err := (&RestMapper{Connection: c}).ToRESTMapper()
+	if err != nil {
+		return "", err
+	}
+	var buff bytes.Buffer
+	for _, mf := range mm {
+		o := mf.Object.DeepCopy()
+		live, merged, err := dryRun(c, m, o)
+		if err != nil {
+			return "", fmt.Errorf("%s %s: %v", o.GetKind(), o.GetName(), err)
+		}
+		name := client.FQN(o.GetNamespace(), o.GetName())
+		d, err := Diff(
+			DiffSide{Name: "live/" + name, Object: live},
+			DiffSide{Name: "local/" + name, Object: merged},
+			full,
+		)
+		if err != nil {
+			return "", err
+		}
+		buff.WriteString(d)
+	}
+
+	return buff.String(), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func diffYAML(o map[string]interface{}, full bool) (string, error) {
+	if o == nil {
+		return "", nil
+	}
+	if !full {
+		o = StripNoise(o)
+	}
+	raw, err := syaml.Marshal(o)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+func runtimeCopy(o map[string]interface{}) map[string]interface{} {
+	return (&unstructured.Unstructured{Object: o}).DeepCopy().Object
+}
+
+func decodeManifest(raw []byte) ([]*unstructured.Unstructured, error) {
+	var oo []*unstructured.Unstructured
+	d := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(raw), len(raw))
+	for {
+		var o map[string]interface{}
+		if err := d.Decode(&o); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if len(o) == 0 {
+			continue
+		}
+		oo = append(oo, &unstructured.Unstructured{Object: o})
+	}
+
+	return oo, nil
+}
+
+// DryRun returns the live and merged version of a manifest resource.
+func dryRun(c client.Connection, m meta.RESTMapper, o *unstructured.Unstructured) (map[string]interface{}, map[string]interface{}, error) {
+	dial, o, err := manifestDial(c, m, o, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	live, err := dial.Get(o.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		created, err := dial.Create(o, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, created.Object, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Dry runs never persist ownership, force so conflicts don't hide the merge.
+	merged, err := serverApply(dial, o, true, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return live.Object, merged.Object, nil
+}
@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	from := DiffSide{Name: "default/p1", Object: makeDiffObj("p1", "nginx:1.0")}
+	to := DiffSide{Name: "default/p2", Object: makeDiffObj("p2", "nginx:1.1")}
+
+	uu := map[string]struct {
+		full  bool
+		e, ne []string
+	}{
+		"stripped": {
+			e:  []string{"--- default/p1", "+++ default/p2", "-  name: p1", "+  name: p2", "-  - image: nginx:1.0"},
+			ne: []string{"resourceVersion", "managedFields", "phase"},
+		},
+		"full": {
+			full: true,
+			e:    []string{"-  resourceVersion: p1", "+  resourceVersion: p2", "managedFields"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := Diff(from, to, u.full)
+			assert.Nil(t, err)
+			for _, s := range u.e {
+				assert.Contains(t, d, s)
+			}
+			for _, s := range u.ne {
+				assert.False(t, strings.Contains(d, s), s)
+			}
+		})
+	}
+}
+
+func TestDiffSame(t *testing.T) {
+	o := makeDiffObj("p1", "nginx")
+	d, err := Diff(DiffSide{Name: "a", Object: o}, DiffSide{Name: "b", Object: o}, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", d)
+}
+
+func TestStripNoise(t *testing.T) {
+	o := makeDiffObj("p1", "nginx")
+	s := StripNoise(o)
+
+	assert.Nil(t, s["status"])
+	m := s["metadata"].(map[string]interface{})
+	assert.Nil(t, m["managedFields"])
+	assert.Nil(t, m["annotations"])
+	assert.Equal(t, "p1", m["name"])
+	assert.NotNil(t, o["status"])
+}
+
+func TestDecodeManifest(t *testing.T) {
+	raw := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+---
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm2
+`
+	oo, err := decodeManifest([]byte(raw))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(oo))
+	assert.Equal(t, "cm2", oo[1].GetName())
+}
+
+// Helpers...
+
+func makeDiffObj(n, img string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":            n,
+			"namespace":       "default",
+			"resourceVersion": n,
+			"managedFields":   []interface{}{map[string]interface{}{"manager": "kubectl"}},
+			"annotations":     map[string]interface{}{lastApplied: "{}"},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{map[string]interface{}{"name": "c1", "image": img}},
+		},
+		"status": map[string]interface{}{"phase": "Running"},
+	}
+}
@@ -0,0 +1,603 @@
+package dao
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	helmReleaseKey  = "release"
+	helmReleaseType = "helm.sh/release.v1"
+	helmOwnerLabel  = "owner"
+	helmOwner       = "helm"
+	helmPolicyKey   = "helm.sh/resource-policy"
+	helmPolicyKeep  = "keep"
+
+	// HelmDeployed tracks a deployed release.
+	HelmDeployed = "deployed"
+	// HelmSuperseded tracks a release replaced by a newer revision.
+	HelmSuperseded = "superseded"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b, 0x08}
+
+// HelmRelease represents a Helm release revision as stored by Helm v3.
+type HelmRelease struct {
+	Name        string
+	Namespace   string
+	Version     int
+	Status      string
+	Chart       string
+	AppVersion  string
+	Description string
+	Updated     time.Time
+	Notes       string
+	Manifest    string
+	Values      map[string]interface{}
+	Config      map[string]interface{}
+
+	// Storage tracks the secret or configmap holding the release.
+	Storage client.GVR
+	raw     map[string]interface{}
+}
+
+// FQN returns the release fully qualified name.
+func (r *HelmRelease) FQN() string {
+	return client.FQN(r.Namespace, r.Name)
+}
+
+// ComputedValues returns the chart values merged with the user supplied values.
+func (r *HelmRelease) ComputedValues() map[string]interface{} {
+	return coalesceValues(runtimeCopy(r.Values), r.Config)
+}
+
+// ValuesYAML returns the release values as YAML. Chart defaults are
+// included when all is set.
+func (r *HelmRelease) ValuesYAML(all bool) (string, error) {
+	vals := r.Config
+	if all {
+		vals = r.ComputedValues()
+	}
+	if len(vals) == 0 {
+		return "", nil
+	}
+	raw, err := yaml.Marshal(vals)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// Helm represents Helm releases stored in cluster secrets or configmaps.
+type Helm struct {
+	Generic
+}
+
+var _ Accessor = (*Helm)(nil)
+var _ Nuker = (*Helm)(nil)
+
+// Releases returns the latest revision of all releases in a namespace.
+func (h *Helm) Releases(ns string) ([]*HelmRelease, error) {
+	rr, err := h.revisions(ns, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[string]*HelmRelease)
+	for _, r := range rr {
+		if l, ok := latest[r.FQN()]; !ok || r.Version > l.Version {
+			latest[r.FQN()] = r
+		}
+	}
+	res := make([]*HelmRelease, 0, len(latest))
+	for _, r := range latest {
+		res = append(res, r)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].FQN() < res[j].FQN()
+	})
+
+	return res, nil
+}
+
+// History returns all revisions of a release, latest first.
+func (h *Helm) History(path string) ([]*HelmRelease, error) {
+	ns, n := client.Namespaced(path)
+	rr, err := h.revisions(ns, labels.SelectorFromSet(labels.Set{"name": n}))
+	if err != nil {
+		return nil, err
+	}
+	if len(rr) == 0 {
+		return nil, fmt.Errorf("no helm release found for %s", path)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Version > rr[j].Version
+	})
+
+	return rr, nil
+}
+
+// Revision returns a given release revision. The latest revision is
+// returned when rev is zero.
+func (h *Helm) Revision(path string, rev int) (*HelmRelease, error) {
+	rr, err := h.History(path)
+	if err != nil {
+		return nil, err
+	}
+	if rev == 0 {
+		return rr[0], nil
+	}
+	for _, r := range rr {
+		if r.Version == rev {
+			return r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no revision %d found for release %s", rev, path)
+}
+
+// Delete uninstalls a release. Helm hooks are not run.
+func (h *Helm) Delete(path string, cascade, force bool) error {
+	kept, err := h.Uninstall(path)
+	if len(kept) > 0 {
+		log.Info().Msgf("Uninstall %s kept resources %s", path, strings.Join(kept, ","))
+	}
+
+	return err
+}
+
+// Uninstall deletes a release resources and its history. Resources annotated
+// with the keep resource policy are left alone and returned.
+func (h *Helm) Uninstall(path string) ([]string, error) {
+	rr, err := h.History(path)
+	if err != nil {
+		return nil, err
+	}
+	a, err := h.applier(rr[0].Namespace)
+	if err != nil {
+		return nil, err
+	}
+	mm, err := releaseManifests(rr[0])
+	if err != nil {
+		return nil, err
+	}
+	var kept []string
+	for i := len(mm) - 1; i >= 0; i-- {
+		if isKept(mm[i]) {
+			kept = append(kept, mm[i].ID())
+			continue
+		}
+		if err := a.Delete(mm[i]); err != nil {
+			return kept, fmt.Errorf("uninstall %s failed on %s: %v", path, mm[i].ID(), err)
+		}
+	}
+	for _, r := range rr {
+		if err := h.deleteRecord(r); err != nil {
+			return kept, err
+		}
+	}
+
+	return kept, nil
+}
+
+// Rollback rolls a release back to a previous revision. Resources are merged
+// against the current revision manifest like Helm does, resources no longer
+// part of the release are deleted and a new revision is recorded. Helm hooks
+// are not run.
+func (h *Helm) Rollback(path string, rev int) (int, error) {
+	rr, err := h.History(path)
+	if err != nil {
+		return 0, err
+	}
+	current := rr[0]
+	target, err := h.Revision(path, rev)
+	if err != nil {
+		return 0, err
+	}
+	if target.Version == current.Version {
+		return 0, fmt.Errorf("revision %d is the current revision", rev)
+	}
+
+	a, err := h.applier(target.Namespace)
+	if err != nil {
+		return 0, err
+	}
+	want, err := releaseManifests(target)
+	if err != nil {
+		return 0, err
+	}
+	have, err := releaseManifests(current)
+	if err != nil {
+		return 0, err
+	}
+	originals := make(map[string]*unstructured.Unstructured, len(have))
+	for _, m := range have {
+		originals[m.ID()] = m.Object
+	}
+	ids := make(map[string]struct{}, len(want))
+	for _, m := range want {
+		id := m.ID()
+		if err := a.Merge(originals[id], m); err != nil {
+			return 0, fmt.Errorf("rollback %s failed on %s: %v", path, id, err)
+		}
+		ids[id] = struct{}{}
+	}
+	for _, m := range have {
+		if _, ok := ids[m.ID()]; ok {
+			continue
+		}
+		if err := a.Delete(m); err != nil {
+			return 0, fmt.Errorf("rollback %s failed deleting %s: %v", path, m.ID(), err)
+		}
+	}
+
+	for _, r := range rr {
+		if r.Status != HelmDeployed {
+			continue
+		}
+		r.setStatus(HelmSuperseded)
+		if err := h.writeRecord(r, false); err != nil {
+			return 0, err
+		}
+	}
+	next := target.next(current.Version+1, fmt.Sprintf("Rollback to %d", target.Version))
+	if err := h.writeRecord(next, true); err != nil {
+		return 0, err
+	}
+
+	return next.Version, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (h *Helm) applier(ns string) (*Applier, error) {
+	a, err := NewApplier(h.Client())
+	if err != nil {
+		return nil, err
+	}
+	a.SetNamespace(ns)
+
+	return a, nil
+}
+
+// Revisions lists all releases revisions from Helm secrets and configmaps storage.
+func (h *Helm) revisions(ns string, sel labels.Selector) ([]*HelmRelease, error) {
+	req, err := labels.NewRequirement(helmOwnerLabel, "=", []string{helmOwner})
+	if err != nil {
+		return nil, err
+	}
+	sel = sel.Add(*req)
+
+	var (
+		rr   []*HelmRelease
+		errs int
+	)
+	for _, gvr := range []string{"v1/secrets", "v1/configmaps"} {
+		oo, err := h.Factory.List(gvr, ns, true, sel)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Helm storage %q unavailable", gvr)
+			errs++
+			continue
+		}
+		for _, o := range oo {
+			r, err := releaseFrom(client.NewGVR(gvr), o)
+			if err != nil {
+				log.Warn().Err(err).Msg("Helm release decode failed")
+				continue
+			}
+			rr = append(rr, r)
+		}
+	}
+	if errs == 2 {
+		return nil, fmt.Errorf("unable to list helm releases in %q", ns)
+	}
+
+	return rr, nil
+}
+
+func (h *Helm) deleteRecord(r *HelmRelease) error {
+	n := helmRecordName(r.Name, r.Version)
+	if r.Storage.String() == "v1/configmaps" {
+		return h.Client().DialOrDie().CoreV1().ConfigMaps(r.Namespace).Delete(n, &metav1.DeleteOptions{})
+	}
+
+	return h.Client().DialOrDie().CoreV1().Secrets(r.Namespace).Delete(n, &metav1.DeleteOptions{})
+}
+
+func (h *Helm) writeRecord(r *HelmRelease, create bool) error {
+	data, err := EncodeHelmRelease(r.raw)
+	if err != nil {
+		return err
+	}
+	if !create {
+		return h.updateRecord(r, data)
+	}
+	meta := metav1.ObjectMeta{
+		Name:      helmRecordName(r.Name, r.Version),
+		Namespace: r.Namespace,
+		Labels: map[string]string{
+			"name":         r.Name,
+			helmOwnerLabel: helmOwner,
+			"status":       r.Status,
+			"version":      strconv.Itoa(r.Version),
+		},
+	}
+
+	dial := h.Client().DialOrDie().CoreV1()
+	if r.Storage.String() == "v1/configmaps" {
+		cm := v1.ConfigMap{ObjectMeta: meta, Data: map[string]string{helmReleaseKey: data}}
+		_, err = dial.ConfigMaps(r.Namespace).Create(&cm)
+		return err
+	}
+	sec := v1.Secret{ObjectMeta: meta, Type: helmReleaseType, Data: map[string][]byte{helmReleaseKey: []byte(data)}}
+	_, err = dial.Secrets(r.Namespace).Create(&sec)
+
+	return err
+}
+
+// UpdateRecord rewrites a release record payload and status label. The record
+// metadata is kept as Helm selects records by their labels.
+func (h *Helm) updateRecord(r *HelmRelease, data string) error {
+	n, dial := helmRecordName(r.Name, r.Version), h.Client().DialOrDie().CoreV1()
+	if r.Storage.String() == "v1/configmaps" {
+		cm, err := dial.ConfigMaps(r.Namespace).Get(n, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		cm.Labels = recordLabels(cm.Labels, r.Status)
+		if cm.Data == nil {
+			cm.Data = make(map[string]string, 1)
+		}
+		cm.Data[helmReleaseKey] = data
+		_, err = dial.ConfigMaps(r.Namespace).Update(cm)
+		return err
+	}
+
+	sec, err := dial.Secrets(r.Namespace).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	sec.Labels = recordLabels(sec.Labels, r.Status)
+	if sec.Data == nil {
+		sec.Data = make(map[string][]byte, 1)
+	}
+	sec.Data[helmReleaseKey] = []byte(data)
+	_, err = dial.Secrets(r.Namespace).Update(sec)
+
+	return err
+}
+
+func recordLabels(ll map[string]string, status string) map[string]string {
+	if ll == nil {
+		ll = make(map[string]string, 1)
+	}
+	ll["status"] = status
+
+	return ll
+}
+
+func (r *HelmRelease) setStatus(s string) {
+	r.Status = s
+	_ = unstructured.SetNestedField(r.raw, s, "info", "status")
+}
+
+// Next returns a copy of the release as a new revision.
+func (r *HelmRelease) next(version int, desc string) *HelmRelease {
+	raw := runtimeCopy(r.raw)
+	now := time.Now().Format(time.RFC3339Nano)
+	raw["version"] = int64(version)
+	_ = unstructured.SetNestedField(raw, HelmDeployed, "info", "status")
+	_ = unstructured.SetNestedField(raw, desc, "info", "description")
+	_ = unstructured.SetNestedField(raw, now, "info", "last_deployed")
+	unstructured.RemoveNestedField(raw, "info", "deleted")
+
+	n := *r
+	n.raw, n.Version, n.Status, n.Description = raw, version, HelmDeployed, desc
+
+	return &n
+}
+
+func helmRecordName(name string, version int) string {
+	return fmt.Sprintf("sh.helm.release.v1.%s.v%d", name, version)
+}
+
+func releaseFrom(gvr client.GVR, o runtime.Object) (*HelmRelease, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	var data string
+	switch gvr.String() {
+	case "v1/configmaps":
+		data, _, _ = unstructured.NestedString(u.Object, "data", helmReleaseKey)
+	default:
+		var sec v1.Secret
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sec); err != nil {
+			return nil, err
+		}
+		data = string(sec.Data[helmReleaseKey])
+	}
+	if data == "" {
+		return nil, fmt.Errorf("no release data found in %s", u.GetName())
+	}
+	raw, err := DecodeHelmRelease(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", u.GetName(), err)
+	}
+	r := NewHelmRelease(raw)
+	r.Storage = gvr
+	if r.Namespace == "" {
+		r.Namespace = u.GetNamespace()
+	}
+
+	return r, nil
+}
+
+// NewHelmRelease returns a release from its decoded storage representation.
+func NewHelmRelease(raw map[string]interface{}) *HelmRelease {
+	str := func(ff ...string) string {
+		s, _, _ := unstructured.NestedString(raw, ff...)
+		return s
+	}
+	vals := func(ff ...string) map[string]interface{} {
+		m, _, _ := unstructured.NestedMap(raw, ff...)
+		return m
+	}
+
+	r := HelmRelease{
+		raw:         raw,
+		Name:        str("name"),
+		Namespace:   str("namespace"),
+		Status:      str("info", "status"),
+		Description: str("info", "description"),
+		Notes:       str("info", "notes"),
+		Manifest:    str("manifest"),
+		AppVersion:  str("chart", "metadata", "appVersion"),
+		Values:      vals("chart", "values"),
+		Config:      vals("config"),
+	}
+	if n, v := str("chart", "metadata", "name"), str("chart", "metadata", "version"); n != "" {
+		r.Chart = n + "-" + v
+	}
+	if v, ok := raw["version"].(int64); ok {
+		r.Version = int(v)
+	}
+	if t, err := time.Parse(time.RFC3339Nano, str("info", "last_deployed")); err == nil {
+		r.Updated = t
+	}
+
+	return &r
+}
+
+// DecodeHelmRelease decodes a Helm release storage payload.
+func DecodeHelmRelease(data string) (map[string]interface{}, error) {
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(raw, gzipMagic) {
+		r, err := gzip.NewReader(bytes.NewReader(raw))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		if raw, err = ioutil.ReadAll(r); err != nil {
+			return nil, err
+		}
+	}
+
+	var rel map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	if err := d.Decode(&rel); err != nil {
+		return nil, err
+	}
+
+	return jsonNumbers(rel).(map[string]interface{}), nil
+}
+
+// EncodeHelmRelease encodes a release the way Helm stores it.
+func EncodeHelmRelease(rel map[string]interface{}) (string, error) {
+	raw, err := json.Marshal(rel)
+	if err != nil {
+		return "", err
+	}
+	var buff bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buff, gzip.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(raw); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(buff.Bytes()), nil
+}
+
+func releaseManifests(r *HelmRelease) ([]Manifest, error) {
+	oo, err := decodeManifest([]byte(r.Manifest))
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest for release %s: %v", r.FQN(), err)
+	}
+	mm := make([]Manifest, 0, len(oo))
+	for _, o := range oo {
+		mm = append(mm, Manifest{Path: r.FQN(), Object: o})
+	}
+
+	return mm, nil
+}
+
+// JSONNumbers converts json numbers to int64 or float64 so that decoded
+// releases can be used as unstructured objects.
+func jsonNumbers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = jsonNumbers(e)
+		}
+		return t
+	case []interface{}:
+		for i, e := range t {
+			t[i] = jsonNumbers(e)
+		}
+		return t
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	default:
+		return v
+	}
+}
+
+// CoalesceValues merges user supplied values over chart defaults.
+func coalesceValues(dst, src map[string]interface{}) map[string]interface{} {
+	if dst == nil {
+		dst = make(map[string]interface{}, len(src))
+	}
+	for k, v := range src {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+		sm, ok := v.(map[string]interface{})
+		dm, dok := dst[k].(map[string]interface{})
+		if ok && dok {
+			dst[k] = coalesceValues(dm, sm)
+			continue
+		}
+		dst[k] = v
+	}
+
+	return dst
+}
+
+// IsKept checks if a resource must survive its release uninstall.
+func isKept(m Manifest) bool {
+	return m.Object.GetAnnotations()[helmPolicyKey] == helmPolicyKeep
+}
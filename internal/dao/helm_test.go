@@ -0,0 +1,153 @@
+package dao
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestHelmReleaseCodec(t *testing.T) {
+	rel := helmRelease()
+
+	data, err := EncodeHelmRelease(rel)
+	assert.Nil(t, err)
+	raw, err := DecodeHelmRelease(data)
+	assert.Nil(t, err)
+	assert.Equal(t, rel, raw)
+
+	r := NewHelmRelease(raw)
+	assert.Equal(t, "fred/blee", r.FQN())
+	assert.Equal(t, 3, r.Version)
+	assert.Equal(t, HelmDeployed, r.Status)
+	assert.Equal(t, "nginx-1.2.0", r.Chart)
+	assert.Equal(t, "1.17", r.AppVersion)
+	assert.Equal(t, 2019, r.Updated.Year())
+	assert.Equal(t, "Enjoy!", r.Notes)
+}
+
+func TestHelmDecodeUncompressed(t *testing.T) {
+	data := base64.StdEncoding.EncodeToString([]byte(`{"name": "blee", "version": 1}`))
+
+	raw, err := DecodeHelmRelease(data)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "blee", "version": int64(1)}, raw)
+}
+
+func TestHelmValues(t *testing.T) {
+	r := NewHelmRelease(helmRelease())
+
+	assert.Equal(t, map[string]interface{}{
+		"replicas": int64(2),
+		"image":    map[string]interface{}{"repository": "nginx", "tag": "1.17"},
+	}, r.ComputedValues())
+	assert.Equal(t, "1.16", r.Values["image"].(map[string]interface{})["tag"])
+
+	user, err := r.ValuesYAML(false)
+	assert.Nil(t, err)
+	assert.Equal(t, "image:\n  tag: \"1.17\"\nreplicas: 2\n", user)
+}
+
+func TestHelmReleaseFrom(t *testing.T) {
+	data, err := EncodeHelmRelease(helmRelease())
+	assert.Nil(t, err)
+
+	sec := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "sh.helm.release.v1.blee.v3", "namespace": "fred"},
+		"data":       map[string]interface{}{"release": base64.StdEncoding.EncodeToString([]byte(data))},
+	}}
+	r, err := releaseFrom(client.NewGVR("v1/secrets"), &sec)
+	assert.Nil(t, err)
+	assert.Equal(t, "fred/blee", r.FQN())
+	assert.Equal(t, "v1/secrets", r.Storage.String())
+
+	cm := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "sh.helm.release.v1.blee.v3", "namespace": "fred"},
+		"data":       map[string]interface{}{"release": data},
+	}}
+	r, err = releaseFrom(client.NewGVR("v1/configmaps"), &cm)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, r.Version)
+
+	_, err = releaseFrom(client.NewGVR("v1/configmaps"), &unstructured.Unstructured{Object: map[string]interface{}{}})
+	assert.NotNil(t, err)
+}
+
+func TestHelmNext(t *testing.T) {
+	r := NewHelmRelease(helmRelease())
+
+	n := r.next(5, "Rollback to 3")
+	assert.Equal(t, 5, n.Version)
+	assert.Equal(t, HelmDeployed, n.Status)
+	assert.Equal(t, int64(5), n.raw["version"])
+	desc, _, _ := unstructured.NestedString(n.raw, "info", "description")
+	assert.Equal(t, "Rollback to 3", desc)
+	assert.Equal(t, int64(3), r.raw["version"])
+	assert.Equal(t, "sh.helm.release.v1.blee.v5", helmRecordName(n.Name, n.Version))
+
+	r.setStatus(HelmSuperseded)
+	st, _, _ := unstructured.NestedString(r.raw, "info", "status")
+	assert.Equal(t, HelmSuperseded, st)
+}
+
+func TestHelmReleaseManifests(t *testing.T) {
+	r := NewHelmRelease(helmRelease())
+
+	mm, err := releaseManifests(r)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(mm))
+	assert.Equal(t, "service/blee", mm[0].ID())
+	assert.Equal(t, "deployment/blee", mm[1].ID())
+}
+
+// Helpers...
+
+func helmRelease() map[string]interface{} {
+	return map[string]interface{}{
+		"name":      "blee",
+		"namespace": "fred",
+		"version":   int64(3),
+		"info": map[string]interface{}{
+			"status":        "deployed",
+			"description":   "Upgrade complete",
+			"last_deployed": "2019-12-10T10:20:30.123456789Z",
+			"notes":         "Enjoy!",
+		},
+		"chart": map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "nginx", "version": "1.2.0", "appVersion": "1.17"},
+			"values": map[string]interface{}{
+				"replicas": int64(1),
+				"image":    map[string]interface{}{"repository": "nginx", "tag": "1.16"},
+			},
+		},
+		"config": map[string]interface{}{
+			"replicas": int64(2),
+			"image":    map[string]interface{}{"tag": "1.17"},
+		},
+		"manifest": "---\n# Source: nginx/templates/svc.yaml\napiVersion: v1\nkind: Service\nmetadata:\n  name: blee\n---\n# Source: nginx/templates/dp.yaml\napiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: blee\n",
+	}
+}
+
+func TestHelmRecordLabels(t *testing.T) {
+	ll := map[string]string{"name": "fred", "owner": "helm", "status": "deployed", "version": "2", "blee": "duh"}
+
+	assert.Equal(t, map[string]string{"name": "fred", "owner": "helm", "status": "superseded", "version": "2", "blee": "duh"}, recordLabels(ll, HelmSuperseded))
+	assert.Equal(t, map[string]string{"status": "deployed"}, recordLabels(nil, HelmDeployed))
+}
+
+func TestHelmIsKept(t *testing.T) {
+	raw := helmRelease()
+	raw["manifest"] = "---\napiVersion: v1\nkind: PersistentVolumeClaim\nmetadata:\n  name: data\n  annotations:\n    helm.sh/resource-policy: keep\n---\napiVersion: v1\nkind: Service\nmetadata:\n  name: blee\n  annotations:\n    helm.sh/resource-policy: delete\n"
+	mm, err := releaseManifests(NewHelmRelease(raw))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(mm))
+
+	assert.True(t, isKept(mm[0]))
+	assert.False(t, isKept(mm[1]))
+}
@@ -0,0 +1,305 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	hpaV1GVR     = "autoscaling/v1/horizontalpodautoscalers"
+	hpaV2b2GVR   = "autoscaling/v2beta2/horizontalpodautoscalers"
+	evGVR        = "v1/events"
+	hpaKind      = "HorizontalPodAutoscaler"
+	unknownValue = "<unknown>"
+)
+
+// HorizontalPodAutoscaler represents a HPA K8s resource.
+type HorizontalPodAutoscaler struct {
+	Generic
+}
+
+var _ Accessor = (*HorizontalPodAutoscaler)(nil)
+
+// HPAMetric represents a HPA metric current and target values.
+type HPAMetric struct {
+	Type, Name      string
+	Current, Target string
+	// Over is true when the current value exceeds the target.
+	Over bool
+}
+
+// HPACondition represents a HPA status condition.
+type HPACondition struct {
+	Type, Status, Reason, Message string
+	Since                         metav1.Time
+}
+
+// HPAInsight represents a HPA scaling state across api versions.
+type HPAInsight struct {
+	Namespace, Name   string
+	TargetAPIVersion  string
+	TargetKind        string
+	TargetName        string
+	Min, Max          int32
+	Current, Desired  int32
+	LastScale         *metav1.Time
+	Metrics           []HPAMetric
+	Conditions        []HPACondition
+	CreationTimestamp metav1.Time
+}
+
+// TargetGVR returns the scale target resource.
+func (h *HPAInsight) TargetGVR() (client.GVR, error) {
+	gv, err := schema.ParseGroupVersion(h.TargetAPIVersion)
+	if err != nil {
+		return client.GVR{}, err
+	}
+	gvr, _, ok := gvrForKind(gv, h.TargetKind)
+	if !ok {
+		return client.GVR{}, fmt.Errorf("no resource found for scale target %s/%s", h.TargetAPIVersion, h.TargetKind)
+	}
+
+	return gvr, nil
+}
+
+// SetReplicas updates a HPA min and max replicas.
+func (h *HorizontalPodAutoscaler) SetReplicas(path string, min, max int32) error {
+	if min < 1 {
+		return fmt.Errorf("min replicas must be at least 1 but got %d", min)
+	}
+	if max < min {
+		return fmt.Errorf("max replicas %d must not be less than min replicas %d", max, min)
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := h.Client().CanI(ns, hpaV1GVR, []string{"patch"})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch hpa %s", path)
+	}
+
+	raw, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]int32{"minReplicas": min, "maxReplicas": max},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = h.Client().DialOrDie().AutoscalingV1().HorizontalPodAutoscalers(ns).Patch(n, types.MergePatchType, raw)
+
+	return err
+}
+
+// FetchHPAInsight returns a HPA scaling state. It favors the v2beta2 api
+// to surface all metric types and falls back to v1.
+func FetchHPAInsight(f Factory, path string) (*HPAInsight, error) {
+	if o, err := f.Get(hpaV2b2GVR, path, true, labels.Everything()); err == nil {
+		var hpa autoscalingv2beta2.HorizontalPodAutoscaler
+		if err := fromUnstructured(o, &hpa); err != nil {
+			return nil, err
+		}
+		return hpaInsightV2b2(&hpa), nil
+	}
+
+	o, err := f.Get(hpaV1GVR, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var hpa autoscalingv1.HorizontalPodAutoscaler
+	if err := fromUnstructured(o, &hpa); err != nil {
+		return nil, err
+	}
+
+	return hpaInsightV1(&hpa), nil
+}
+
+// HPAEvents returns a HPA events. Most recent events come first.
+func HPAEvents(f Factory, path string) ([]*v1.Event, error) {
+	ns, n := client.Namespaced(path)
+	oo, err := f.List(evGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	ee := make([]*v1.Event, 0, 10)
+	for _, o := range oo {
+		var ev v1.Event
+		if err := fromUnstructured(o, &ev); err != nil {
+			return nil, err
+		}
+		if ev.InvolvedObject.Kind != hpaKind || ev.InvolvedObject.Name != n {
+			continue
+		}
+		ee = append(ee, &ev)
+	}
+	sort.SliceStable(ee, func(i, j int) bool {
+		return render.EventLastSeen(*ee[i]).After(render.EventLastSeen(*ee[j]))
+	})
+
+	return ee, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func hpaInsightV1(hpa *autoscalingv1.HorizontalPodAutoscaler) *HPAInsight {
+	h := HPAInsight{
+		Namespace:         hpa.Namespace,
+		Name:              hpa.Name,
+		TargetAPIVersion:  hpa.Spec.ScaleTargetRef.APIVersion,
+		TargetKind:        hpa.Spec.ScaleTargetRef.Kind,
+		TargetName:        hpa.Spec.ScaleTargetRef.Name,
+		Min:               1,
+		Max:               hpa.Spec.MaxReplicas,
+		Current:           hpa.Status.CurrentReplicas,
+		Desired:           hpa.Status.DesiredReplicas,
+		LastScale:         hpa.Status.LastScaleTime,
+		CreationTimestamp: hpa.CreationTimestamp,
+	}
+	if hpa.Spec.MinReplicas != nil {
+		h.Min = *hpa.Spec.MinReplicas
+	}
+
+	m := HPAMetric{Type: string(autoscalingv2beta2.ResourceMetricSourceType), Name: string(v1.ResourceCPU), Current: unknownValue, Target: unknownValue}
+	if hpa.Status.CurrentCPUUtilizationPercentage != nil {
+		m.Current = toPercent(*hpa.Status.CurrentCPUUtilizationPercentage)
+	}
+	if hpa.Spec.TargetCPUUtilizationPercentage != nil {
+		m.Target = toPercent(*hpa.Spec.TargetCPUUtilizationPercentage)
+		m.Over = hpa.Status.CurrentCPUUtilizationPercentage != nil && *hpa.Status.CurrentCPUUtilizationPercentage > *hpa.Spec.TargetCPUUtilizationPercentage
+	}
+	h.Metrics = []HPAMetric{m}
+
+	return &h
+}
+
+func hpaInsightV2b2(hpa *autoscalingv2beta2.HorizontalPodAutoscaler) *HPAInsight {
+	h := HPAInsight{
+		Namespace:         hpa.Namespace,
+		Name:              hpa.Name,
+		TargetAPIVersion:  hpa.Spec.ScaleTargetRef.APIVersion,
+		TargetKind:        hpa.Spec.ScaleTargetRef.Kind,
+		TargetName:        hpa.Spec.ScaleTargetRef.Name,
+		Min:               1,
+		Max:               hpa.Spec.MaxReplicas,
+		Current:           hpa.Status.CurrentReplicas,
+		Desired:           hpa.Status.DesiredReplicas,
+		LastScale:         hpa.Status.LastScaleTime,
+		CreationTimestamp: hpa.CreationTimestamp,
+	}
+	if hpa.Spec.MinReplicas != nil {
+		h.Min = *hpa.Spec.MinReplicas
+	}
+	for _, spec := range hpa.Spec.Metrics {
+		h.Metrics = append(h.Metrics, hpaMetricV2b2(spec, hpa.Status.CurrentMetrics))
+	}
+	for _, c := range hpa.Status.Conditions {
+		h.Conditions = append(h.Conditions, HPACondition{
+			Type:    string(c.Type),
+			Status:  string(c.Status),
+			Reason:  c.Reason,
+			Message: c.Message,
+			Since:   c.LastTransitionTime,
+		})
+	}
+
+	return &h
+}
+
+func hpaMetricV2b2(spec autoscalingv2beta2.MetricSpec, ss []autoscalingv2beta2.MetricStatus) HPAMetric {
+	m := HPAMetric{Type: string(spec.Type), Current: unknownValue}
+	var (
+		target  autoscalingv2beta2.MetricTarget
+		current *autoscalingv2beta2.MetricValueStatus
+	)
+	switch spec.Type {
+	case autoscalingv2beta2.ResourceMetricSourceType:
+		m.Name, target = string(spec.Resource.Name), spec.Resource.Target
+		for _, s := range ss {
+			if s.Resource != nil && s.Resource.Name == spec.Resource.Name {
+				current = &s.Resource.Current
+			}
+		}
+	case autoscalingv2beta2.PodsMetricSourceType:
+		m.Name, target = spec.Pods.Metric.Name, spec.Pods.Target
+		for _, s := range ss {
+			if s.Pods != nil && s.Pods.Metric.Name == spec.Pods.Metric.Name {
+				current = &s.Pods.Current
+			}
+		}
+	case autoscalingv2beta2.ObjectMetricSourceType:
+		ref := spec.Object.DescribedObject
+		m.Name, target = strings.ToLower(ref.Kind)+"/"+ref.Name+" "+spec.Object.Metric.Name, spec.Object.Target
+		for _, s := range ss {
+			if s.Object != nil && s.Object.Metric.Name == spec.Object.Metric.Name && s.Object.DescribedObject.Name == ref.Name {
+				current = &s.Object.Current
+			}
+		}
+	case autoscalingv2beta2.ExternalMetricSourceType:
+		m.Name, target = spec.External.Metric.Name, spec.External.Target
+		for _, s := range ss {
+			if s.External != nil && s.External.Metric.Name == spec.External.Metric.Name {
+				current = &s.External.Current
+			}
+		}
+	default:
+		m.Target = unknownValue
+		return m
+	}
+
+	m.Target = metricTarget(target)
+	if current != nil {
+		m.Current, m.Over = metricCurrent(target, *current)
+	}
+
+	return m
+}
+
+func metricTarget(t autoscalingv2beta2.MetricTarget) string {
+	switch {
+	case t.AverageUtilization != nil:
+		return toPercent(*t.AverageUtilization)
+	case t.AverageValue != nil:
+		return t.AverageValue.String() + " (avg)"
+	case t.Value != nil:
+		return t.Value.String()
+	default:
+		return unknownValue
+	}
+}
+
+func metricCurrent(t autoscalingv2beta2.MetricTarget, c autoscalingv2beta2.MetricValueStatus) (string, bool) {
+	switch {
+	case t.AverageUtilization != nil && c.AverageUtilization != nil:
+		return toPercent(*c.AverageUtilization), *c.AverageUtilization > *t.AverageUtilization
+	case t.AverageValue != nil && c.AverageValue != nil:
+		return c.AverageValue.String() + " (avg)", c.AverageValue.Cmp(*t.AverageValue) > 0
+	case t.Value != nil && c.Value != nil:
+		return c.Value.String(), c.Value.Cmp(*t.Value) > 0
+	case c.AverageUtilization != nil:
+		return toPercent(*c.AverageUtilization), false
+	case c.AverageValue != nil:
+		return c.AverageValue.String() + " (avg)", false
+	case c.Value != nil:
+		return c.Value.String(), false
+	default:
+		return unknownValue, false
+	}
+}
+
+func toPercent(v int32) string {
+	return strconv.Itoa(int(v)) + "%"
+}
@@ -0,0 +1,175 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestHPAInsightV1(t *testing.T) {
+	min, target, current := int32(2), int32(50), int32(75)
+	hpa := autoscalingv1.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred"},
+		Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef:                 autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "fred"},
+			MinReplicas:                    &min,
+			MaxReplicas:                    5,
+			TargetCPUUtilizationPercentage: &target,
+		},
+		Status: autoscalingv1.HorizontalPodAutoscalerStatus{
+			CurrentReplicas:                 3,
+			DesiredReplicas:                 4,
+			CurrentCPUUtilizationPercentage: &current,
+		},
+	}
+
+	h := hpaInsightV1(&hpa)
+
+	assert.Equal(t, int32(2), h.Min)
+	assert.Equal(t, int32(5), h.Max)
+	assert.Equal(t, int32(3), h.Current)
+	assert.Equal(t, int32(4), h.Desired)
+	assert.Equal(t, []HPAMetric{{Type: "Resource", Name: "cpu", Current: "75%", Target: "50%", Over: true}}, h.Metrics)
+}
+
+func TestHPATargetGVR(t *testing.T) {
+	defer func(m ResourceMetas) { resMetas = m }(resMetas)
+	resMetas = ResourceMetas{
+		client.NewGVR("apps/v1/deployments"):          {Name: "deployments", Kind: "Deployment", Namespaced: true},
+		client.NewGVR("flagger.app/v1beta1/canaries"): {Name: "canaries", Kind: "Canary", Namespaced: true},
+	}
+
+	uu := map[string]struct {
+		apiVersion, kind string
+		gvr              string
+		err              bool
+	}{
+		"deployment": {apiVersion: "apps/v1", kind: "Deployment", gvr: "apps/v1/deployments"},
+		"irregular":  {apiVersion: "flagger.app/v1beta1", kind: "Canary", gvr: "flagger.app/v1beta1/canaries"},
+		"unknown":    {apiVersion: "blee/v1", kind: "Duh", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			h := HPAInsight{TargetAPIVersion: u.apiVersion, TargetKind: u.kind}
+			gvr, err := h.TargetGVR()
+			assert.Equal(t, u.err, err != nil)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, u.gvr, gvr.String())
+		})
+	}
+}
+
+func TestHPAEventsLastSeen(t *testing.T) {
+	now := time.Now()
+	f := ownedFactory{watched: map[string][]runtime.Object{
+		evGVR: {
+			hpaEvent(t, "e1", v1.Event{LastTimestamp: metav1.NewTime(now.Add(-time.Hour))}),
+			hpaEvent(t, "e2", v1.Event{EventTime: metav1.NewMicroTime(now)}),
+			hpaEvent(t, "e3", v1.Event{LastTimestamp: metav1.NewTime(now.Add(-time.Minute))}),
+		},
+	}}
+
+	ee, err := HPAEvents(f, "default/fred")
+	assert.Nil(t, err)
+	nn := make([]string, 0, len(ee))
+	for _, e := range ee {
+		nn = append(nn, e.Name)
+	}
+	assert.Equal(t, []string{"e2", "e3", "e1"}, nn)
+}
+
+func TestHPAInsightV2b2(t *testing.T) {
+	util, cutil := int32(80), int32(40)
+	avg, cavg := resource.MustParse("100"), resource.MustParse("120")
+	val, cval := resource.MustParse("10"), resource.MustParse("5")
+	hpa := autoscalingv2beta2.HorizontalPodAutoscaler{
+		Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+			MaxReplicas: 10,
+			Metrics: []autoscalingv2beta2.MetricSpec{
+				{
+					Type: autoscalingv2beta2.ResourceMetricSourceType,
+					Resource: &autoscalingv2beta2.ResourceMetricSource{
+						Name:   v1.ResourceCPU,
+						Target: autoscalingv2beta2.MetricTarget{AverageUtilization: &util},
+					},
+				},
+				{
+					Type: autoscalingv2beta2.PodsMetricSourceType,
+					Pods: &autoscalingv2beta2.PodsMetricSource{
+						Metric: autoscalingv2beta2.MetricIdentifier{Name: "rps"},
+						Target: autoscalingv2beta2.MetricTarget{AverageValue: &avg},
+					},
+				},
+				{
+					Type: autoscalingv2beta2.ExternalMetricSourceType,
+					External: &autoscalingv2beta2.ExternalMetricSource{
+						Metric: autoscalingv2beta2.MetricIdentifier{Name: "queue"},
+						Target: autoscalingv2beta2.MetricTarget{Value: &val},
+					},
+				},
+				{
+					Type: autoscalingv2beta2.ExternalMetricSourceType,
+					External: &autoscalingv2beta2.ExternalMetricSource{
+						Metric: autoscalingv2beta2.MetricIdentifier{Name: "missing"},
+						Target: autoscalingv2beta2.MetricTarget{Value: &val},
+					},
+				},
+			},
+		},
+		Status: autoscalingv2beta2.HorizontalPodAutoscalerStatus{
+			CurrentMetrics: []autoscalingv2beta2.MetricStatus{
+				{
+					Type:     autoscalingv2beta2.ExternalMetricSourceType,
+					External: &autoscalingv2beta2.ExternalMetricStatus{Metric: autoscalingv2beta2.MetricIdentifier{Name: "queue"}, Current: autoscalingv2beta2.MetricValueStatus{Value: &cval}},
+				},
+				{
+					Type:     autoscalingv2beta2.ResourceMetricSourceType,
+					Resource: &autoscalingv2beta2.ResourceMetricStatus{Name: v1.ResourceCPU, Current: autoscalingv2beta2.MetricValueStatus{AverageUtilization: &cutil}},
+				},
+				{
+					Type: autoscalingv2beta2.PodsMetricSourceType,
+					Pods: &autoscalingv2beta2.PodsMetricStatus{Metric: autoscalingv2beta2.MetricIdentifier{Name: "rps"}, Current: autoscalingv2beta2.MetricValueStatus{AverageValue: &cavg}},
+				},
+			},
+			Conditions: []autoscalingv2beta2.HorizontalPodAutoscalerCondition{
+				{Type: autoscalingv2beta2.ScalingLimited, Status: v1.ConditionTrue, Reason: "TooManyReplicas"},
+			},
+		},
+	}
+
+	h := hpaInsightV2b2(&hpa)
+
+	assert.Equal(t, int32(1), h.Min)
+	assert.Equal(t, []HPAMetric{
+		{Type: "Resource", Name: "cpu", Current: "40%", Target: "80%"},
+		{Type: "Pods", Name: "rps", Current: "120 (avg)", Target: "100 (avg)", Over: true},
+		{Type: "External", Name: "queue", Current: "5", Target: "10"},
+		{Type: "External", Name: "missing", Current: "<unknown>", Target: "10"},
+	}, h.Metrics)
+	assert.Equal(t, []HPACondition{{Type: "ScalingLimited", Status: "True", Reason: "TooManyReplicas"}}, h.Conditions)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func hpaEvent(t *testing.T, n string, ev v1.Event) runtime.Object {
+	ev.Name, ev.Namespace = n, "default"
+	ev.InvolvedObject = v1.ObjectReference{Kind: hpaKind, Name: "fred"}
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&ev)
+	assert.Nil(t, err)
+
+	return &unstructured.Unstructured{Object: m}
+}
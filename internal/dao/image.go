@@ -0,0 +1,353 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const imageToken = "{image}"
+
+// Severities lists the tracked vulnerability severities by decreasing order.
+var Severities = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW"}
+
+// Image represents a container image and the pods running it.
+type Image struct {
+	Image, Digest string
+	Pods          []string
+	Namespaces    []string
+	Owners        []string
+	Containers    int
+	Init          bool
+}
+
+// Key returns an image identifier.
+func (i Image) Key() string {
+	if i.Digest == "" {
+		return i.Image
+	}
+
+	return i.Image + "@" + i.Digest
+}
+
+// Ref returns the most specific image reference.
+func (i Image) Ref() string {
+	if i.Digest == "" {
+		return i.Image
+	}
+
+	repo := strings.SplitN(i.Image, "@", 2)[0]
+	if c := strings.LastIndex(repo, ":"); c > strings.LastIndex(repo, "/") {
+		repo = repo[:c]
+	}
+
+	return repo + "@" + i.Digest
+}
+
+// Images lists all container images, init containers included, running in
+// a given namespace.
+func Images(f Factory, ns string) ([]Image, error) {
+	oo, err := f.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	mm := make(map[string]*imageRefs)
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		for _, co := range po.Spec.InitContainers {
+			imageRef(mm, &po, co, po.Status.InitContainerStatuses, true)
+		}
+		for _, co := range po.Spec.Containers {
+			imageRef(mm, &po, co, po.Status.ContainerStatuses, false)
+		}
+	}
+
+	ii := make([]Image, 0, len(mm))
+	for _, r := range mm {
+		ii = append(ii, r.image())
+	}
+	sort.Slice(ii, func(i, j int) bool {
+		return ii[i].Key() < ii[j].Key()
+	})
+
+	return ii, nil
+}
+
+// ImageDigest extracts a digest from a container status image id.
+func ImageDigest(id string) string {
+	if i := strings.LastIndex(id, "@"); i != -1 {
+		return id[i+1:]
+	}
+	if strings.HasPrefix(id, "sha256:") {
+		return id
+	}
+
+	return ""
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type imageRefs struct {
+	Image
+	pods, nss, owners map[string]struct{}
+}
+
+func imageRef(mm map[string]*imageRefs, po *v1.Pod, co v1.Container, ss []v1.ContainerStatus, init bool) {
+	var digest string
+	for _, s := range ss {
+		if s.Name == co.Name {
+			digest = ImageDigest(s.ImageID)
+			break
+		}
+	}
+	i := Image{Image: co.Image, Digest: digest}
+	r, ok := mm[i.Key()]
+	if !ok {
+		r = &imageRefs{
+			Image:  i,
+			pods:   make(map[string]struct{}),
+			nss:    make(map[string]struct{}),
+			owners: make(map[string]struct{}),
+		}
+		mm[i.Key()] = r
+	}
+	r.Containers++
+	r.Init = r.Init || init
+	r.pods[client.FQN(po.Namespace, po.Name)] = struct{}{}
+	r.nss[po.Namespace] = struct{}{}
+	for _, ref := range po.OwnerReferences {
+		if ref.Controller != nil && *ref.Controller {
+			r.owners[ref.Kind+"/"+client.FQN(po.Namespace, ref.Name)] = struct{}{}
+		}
+	}
+}
+
+func (r *imageRefs) image() Image {
+	i := r.Image
+	i.Pods, i.Namespaces, i.Owners = setKeys(r.pods), setKeys(r.nss), setKeys(r.owners)
+
+	return i
+}
+
+func setKeys(s map[string]struct{}) []string {
+	kk := make([]string, 0, len(s))
+	for k := range s {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
+
+// ----------------------------------------------------------------------------
+// Scanner...
+
+// ImageScan represents an image vulnerabilities scan result.
+type ImageScan struct {
+	Counts    map[string]int
+	Err       error
+	ScannedAt time.Time
+}
+
+// ImageScanner runs an external vulnerability scanner in the background and
+// caches its results per image digest.
+type ImageScanner struct {
+	mx      sync.Mutex
+	cache   map[string]ImageScan
+	pending map[string]struct{}
+	queue   chan scanReq
+	full    bool
+	once    sync.Once
+}
+
+type scanReq struct {
+	key, ref string
+	cfg      config.ImageScan
+}
+
+// ImageScans tracks image scan results for the session.
+var ImageScans = NewImageScanner()
+
+// NewImageScanner returns a new scanner.
+func NewImageScanner() *ImageScanner {
+	return &ImageScanner{
+		cache:   make(map[string]ImageScan),
+		pending: make(map[string]struct{}),
+		queue:   make(chan scanReq, 100),
+	}
+}
+
+// Result returns a cached scan result if any.
+func (s *ImageScanner) Result(i Image) (ImageScan, bool) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	r, ok := s.cache[scanKey(i)]
+
+	return r, ok
+}
+
+// Scan queues an image scan unless it was already scanned or is in flight.
+// Forced scans discard any cached results.
+func (s *ImageScanner) Scan(cfg *config.ImageScan, i Image, force bool) {
+	if !cfg.IsEnabled() {
+		return
+	}
+	s.once.Do(func() { go s.run() })
+	key := scanKey(i)
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if _, ok := s.pending[key]; ok {
+		return
+	}
+	if _, ok := s.cache[key]; ok && !force {
+		return
+	}
+	delete(s.cache, key)
+	select {
+	case s.queue <- scanReq{key: key, ref: i.Ref(), cfg: *cfg}:
+		s.pending[key], s.full = struct{}{}, false
+	default:
+		// Views list images on each refresh. Only warn once per backlog.
+		if !s.full {
+			log.Warn().Msgf("Image scan queue full. Skipping scans until it drains")
+			s.full = true
+		}
+	}
+}
+
+// IsPending checks if an image scan is queued or running.
+func (s *ImageScanner) IsPending(i Image) bool {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	_, ok := s.pending[scanKey(i)]
+
+	return ok
+}
+
+func (s *ImageScanner) run() {
+	for r := range s.queue {
+		res := ImageScan{ScannedAt: time.Now()}
+		res.Counts, res.Err = scanImage(r.cfg, r.ref)
+		if res.Err != nil {
+			log.Warn().Err(res.Err).Msgf("Image scan failed for %s", r.ref)
+		}
+		s.mx.Lock()
+		{
+			delete(s.pending, r.key)
+			s.cache[r.key] = res
+		}
+		s.mx.Unlock()
+	}
+}
+
+func scanKey(i Image) string {
+	if i.Digest != "" {
+		return i.Digest
+	}
+
+	return i.Image
+}
+
+func scanImage(cfg config.ImageScan, ref string) (map[string]int, error) {
+	args := make([]string, 0, len(cfg.Args)+1)
+	var subst bool
+	for _, a := range cfg.Args {
+		if strings.Contains(a, imageToken) {
+			a, subst = strings.Replace(a, imageToken, ref, -1), true
+		}
+		args = append(args, a)
+	}
+	if !subst {
+		args = append(args, ref)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.TimeoutSeconds)*time.Second)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, cfg.Command, args...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s: %v %s", cfg.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return ParseScan(stdout.Bytes())
+}
+
+// ParseScan counts vulnerabilities per severity from a scanner JSON output.
+// Either a severity to count object or any document carrying severity fields,
+// such as trivy or grype reports, is accepted.
+func ParseScan(raw []byte) (map[string]int, error) {
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("invalid scan report: %v", err)
+	}
+
+	cc := make(map[string]int, len(Severities))
+	if m, ok := doc.(map[string]interface{}); ok {
+		for k, v := range m {
+			if n, ok := v.(float64); ok && isSeverity(k) {
+				cc[strings.ToUpper(k)] += int(n)
+			}
+		}
+		if len(cc) > 0 {
+			return cc, nil
+		}
+	}
+	countSeverities(doc, cc)
+
+	return cc, nil
+}
+
+// CountSeverities counts vulnerability entries per severity. An entry
+// carrying a severity is counted once and its nested entries, such as grype
+// related vulnerabilities, are skipped.
+func countSeverities(doc interface{}, cc map[string]int) {
+	switch d := doc.(type) {
+	case []interface{}:
+		for _, v := range d {
+			countSeverities(v, cc)
+		}
+	case map[string]interface{}:
+		for k, v := range d {
+			if s, ok := v.(string); ok && strings.EqualFold(k, "severity") {
+				if isSeverity(s) {
+					cc[strings.ToUpper(s)]++
+				}
+				return
+			}
+		}
+		for k, v := range d {
+			if strings.EqualFold(k, "relatedVulnerabilities") {
+				continue
+			}
+			countSeverities(v, cc)
+		}
+	}
+}
+
+func isSeverity(s string) bool {
+	for _, sev := range Severities {
+		if strings.EqualFold(s, sev) {
+			return true
+		}
+	}
+
+	return false
+}
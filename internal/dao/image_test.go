@@ -0,0 +1,179 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestImages(t *testing.T) {
+	po := func(name, owner string, init bool) *unstructured.Unstructured {
+		co := []interface{}{map[string]interface{}{"name": "c1", "image": "nginx:1.17"}}
+		o := lintObj("v1", "Pod", name, map[string]interface{}{"containers": co})
+		o.Object["status"] = map[string]interface{}{
+			"containerStatuses": []interface{}{
+				map[string]interface{}{"name": "c1", "imageID": "docker-pullable://nginx@sha256:abc"},
+			},
+		}
+		if init {
+			o.Object["spec"].(map[string]interface{})["initContainers"] = []interface{}{
+				map[string]interface{}{"name": "i1", "image": "busybox"},
+			}
+		}
+		if owner != "" {
+			o.Object["metadata"].(map[string]interface{})["ownerReferences"] = []interface{}{
+				map[string]interface{}{"apiVersion": "apps/v1", "kind": "ReplicaSet", "name": owner, "uid": "1", "controller": true},
+			}
+		}
+		return o
+	}
+	f := lintFactory{objs: map[string][]*unstructured.Unstructured{
+		"v1/pods": {po("p1", "rs1", true), po("p2", "rs1", false), po("p3", "", false)},
+	}}
+
+	ii, err := Images(&f, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []Image{
+		{
+			Image:      "busybox",
+			Pods:       []string{"fred/p1"},
+			Namespaces: []string{"fred"},
+			Owners:     []string{"ReplicaSet/fred/rs1"},
+			Containers: 1,
+			Init:       true,
+		},
+		{
+			Image:      "nginx:1.17",
+			Digest:     "sha256:abc",
+			Pods:       []string{"fred/p1", "fred/p2", "fred/p3"},
+			Namespaces: []string{"fred"},
+			Owners:     []string{"ReplicaSet/fred/rs1"},
+			Containers: 3,
+		},
+	}, ii)
+	assert.Equal(t, "nginx@sha256:abc", ii[1].Ref())
+}
+
+func TestImageRef(t *testing.T) {
+	uu := map[string]struct {
+		i Image
+		e string
+	}{
+		"noDigest":  {i: Image{Image: "nginx:1.17"}, e: "nginx:1.17"},
+		"tag":       {i: Image{Image: "nginx:1.17", Digest: "sha256:abc"}, e: "nginx@sha256:abc"},
+		"port":      {i: Image{Image: "localhost:5000/nginx", Digest: "sha256:abc"}, e: "localhost:5000/nginx@sha256:abc"},
+		"portTag":   {i: Image{Image: "localhost:5000/nginx:1.17", Digest: "sha256:abc"}, e: "localhost:5000/nginx@sha256:abc"},
+		"hasDigest": {i: Image{Image: "nginx@sha256:abc", Digest: "sha256:abc"}, e: "nginx@sha256:abc"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.i.Ref())
+		})
+	}
+}
+
+func TestImageDigest(t *testing.T) {
+	uu := map[string]string{
+		"docker-pullable://nginx@sha256:abc": "sha256:abc",
+		"sha256:abc":                         "sha256:abc",
+		"docker://nginx":                     "",
+		"":                                   "",
+	}
+
+	for id, e := range uu {
+		assert.Equal(t, e, ImageDigest(id), id)
+	}
+}
+
+func TestParseScan(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		err bool
+		e   map[string]int
+	}{
+		"counts": {
+			raw: `{"critical": 1, "High": 2, "unknown": 3}`,
+			e:   map[string]int{"CRITICAL": 1, "HIGH": 2},
+		},
+		"trivy": {
+			raw: `[{"Target": "nginx", "Vulnerabilities": [{"Severity": "HIGH"}, {"Severity": "LOW"}, {"Severity": "HIGH"}]}]`,
+			e:   map[string]int{"HIGH": 2, "LOW": 1},
+		},
+		"grype": {
+			raw: `{"matches": [{"vulnerability": {"severity": "Critical"}}, {"vulnerability": {"severity": "Negligible"}}]}`,
+			e:   map[string]int{"CRITICAL": 1},
+		},
+		"grypeRelated": {
+			raw: `{"matches": [{"vulnerability": {"id": "GHSA-1", "severity": "High"}, "relatedVulnerabilities": [{"id": "CVE-1", "severity": "High"}, {"id": "CVE-2", "severity": "Critical"}]}]}`,
+			e:   map[string]int{"HIGH": 1},
+		},
+		"nested": {
+			raw: `[{"Vulnerabilities": [{"Severity": "LOW", "Details": {"severity": "HIGH"}}]}]`,
+			e:   map[string]int{"LOW": 1},
+		},
+		"clean": {
+			raw: `[]`,
+			e:   map[string]int{},
+		},
+		"toast": {
+			raw: `bozo`,
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cc, err := ParseScan([]byte(u.raw))
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, cc)
+		})
+	}
+}
+
+func TestImageScannerScan(t *testing.T) {
+	cfg := config.ImageScan{
+		Command:        "sh",
+		Args:           []string{"-c", `echo '{"HIGH": 4}' # {image}`},
+		TimeoutSeconds: 10,
+	}
+	i := Image{Image: "nginx:1.17", Digest: "sha256:abc"}
+	s := NewImageScanner()
+	s.Scan(&cfg, i, false)
+
+	var (
+		res ImageScan
+		ok  bool
+	)
+	for n := 0; n < 50 && !ok; n++ {
+		time.Sleep(10 * time.Millisecond)
+		res, ok = s.Result(i)
+	}
+	assert.True(t, ok)
+	assert.Nil(t, res.Err)
+	assert.Equal(t, map[string]int{"HIGH": 4}, res.Counts)
+	assert.False(t, s.IsPending(i))
+}
+
+func TestImageScannerQueueFull(t *testing.T) {
+	cfg := config.ImageScan{Command: "sh", TimeoutSeconds: 10}
+	s := NewImageScanner()
+	s.queue = make(chan scanReq)
+	// Keeps the scanner idle so the queue stays full.
+	s.once.Do(func() {})
+
+	s.Scan(&cfg, Image{Image: "nginx:1.17"}, false)
+	s.Scan(&cfg, Image{Image: "redis:5"}, false)
+
+	assert.True(t, s.full)
+	assert.False(t, s.IsPending(Image{Image: "nginx:1.17"}))
+}
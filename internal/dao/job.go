@@ -5,10 +5,19 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/derailed/k9s/internal/client"
 	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	jobUIDLabel  = "controller-uid"
+	jobNameLabel = "job-name"
 )
 
 // Job represents a K8s job resource.
@@ -38,3 +47,124 @@ func (j *Job) TailLogs(ctx context.Context, c chan<- string, opts LogOptions) er
 
 	return podLogs(ctx, c, job.Spec.Selector.MatchLabels, opts)
 }
+
+// Rerun clones a finished job under a new name. It returns the new job path.
+func (j *Job) Rerun(path string) (string, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := j.Client().CanI(ns, jobGVR, []string{"get", "create"})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to create jobs in %s", ns)
+	}
+
+	job, err := j.Client().DialOrDie().BatchV1().Jobs(ns).Get(n, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	if !IsJobFinished(job) {
+		return "", fmt.Errorf("job %s is still running", path)
+	}
+	clone, err := j.Client().DialOrDie().BatchV1().Jobs(ns).Create(rerunJob(job))
+	if err != nil {
+		return "", err
+	}
+
+	return client.FQN(clone.Namespace, clone.Name), nil
+}
+
+// IsJobFinished returns true if a job completed or failed.
+func IsJobFinished(job *batchv1.Job) bool {
+	return jobHasCondition(job, batchv1.JobComplete) || jobHasCondition(job, batchv1.JobFailed)
+}
+
+// JobFailure returns why a job failed. It favors the exit reason of the job's
+// most recently failed pod container. It returns blank if the job has no
+// failures.
+func JobFailure(job *batchv1.Job, pods []*v1.Pod) string {
+	failed := jobCondition(job, batchv1.JobFailed)
+	if failed == nil && job.Status.Failed == 0 {
+		return ""
+	}
+
+	var (
+		reason string
+		last   metav1.Time
+	)
+	for _, po := range pods {
+		if po.Labels[jobUIDLabel] != string(job.UID) {
+			continue
+		}
+		if po.Status.Phase == v1.PodFailed && po.Status.Reason != "" && reason == "" {
+			reason = po.Name + ": " + po.Status.Reason
+		}
+		ss := make([]v1.ContainerStatus, 0, len(po.Status.InitContainerStatuses)+len(po.Status.ContainerStatuses))
+		ss = append(ss, po.Status.InitContainerStatuses...)
+		for _, s := range append(ss, po.Status.ContainerStatuses...) {
+			t := s.State.Terminated
+			if t == nil {
+				t = s.LastTerminationState.Terminated
+			}
+			if t == nil || t.ExitCode == 0 || t.FinishedAt.Before(&last) {
+				continue
+			}
+			last = t.FinishedAt
+			reason = fmt.Sprintf("%s/%s: %s (exit %d)", po.Name, s.Name, t.Reason, t.ExitCode)
+		}
+	}
+	if reason != "" {
+		return reason
+	}
+	if failed != nil {
+		return failed.Reason
+	}
+
+	return fmt.Sprintf("%d failed pods", job.Status.Failed)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func rerunJob(job *batchv1.Job) *batchv1.Job {
+	name := job.Name
+	if len(name) >= maxJobNameSize {
+		name = name[:maxJobNameSize]
+	}
+
+	spec := job.Spec.DeepCopy()
+	if spec.ManualSelector == nil || !*spec.ManualSelector {
+		spec.Selector = nil
+		delete(spec.Template.Labels, jobUIDLabel)
+		delete(spec.Template.Labels, jobNameLabel)
+	}
+	ll := make(map[string]string, len(job.Labels))
+	for k, v := range job.Labels {
+		if k != jobUIDLabel && k != jobNameLabel {
+			ll[k] = v
+		}
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name + "-rerun-" + rand.String(3),
+			Namespace: job.Namespace,
+			Labels:    ll,
+		},
+		Spec: *spec,
+	}
+}
+
+func jobCondition(job *batchv1.Job, t batchv1.JobConditionType) *batchv1.JobCondition {
+	for i, c := range job.Status.Conditions {
+		if c.Type == t && c.Status == v1.ConditionTrue {
+			return &job.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+func jobHasCondition(job *batchv1.Job, t batchv1.JobConditionType) bool {
+	return jobCondition(job, t) != nil
+}
@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestJobFailure(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		job  batchv1.Job
+		pods []*v1.Pod
+		e    string
+	}{
+		"ok": {
+			job: makeJob("fred", batchv1.JobComplete, 0),
+		},
+		"container": {
+			job: makeJob("fred", batchv1.JobFailed, 2),
+			pods: []*v1.Pod{
+				makeJobPod("fred-1", "fred", "OOMKilled", 137, now.Add(-time.Minute)),
+				makeJobPod("fred-2", "fred", "Error", 1, now),
+				makeJobPod("blee-1", "blee", "Error", 2, now.Add(time.Minute)),
+			},
+			e: "fred-2/c1: Error (exit 1)",
+		},
+		"podGone": {
+			job: makeJob("fred", batchv1.JobFailed, 1),
+			e:   "BackoffLimitExceeded",
+		},
+		"retrying": {
+			job: makeJob("fred", "", 3),
+			e:   "3 failed pods",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, JobFailure(&u.job, u.pods))
+		})
+	}
+}
+
+func TestRerunJob(t *testing.T) {
+	job := makeJob("fred", batchv1.JobComplete, 0)
+	job.Labels = map[string]string{"app": "fred", jobUIDLabel: "fred", jobNameLabel: "fred"}
+	job.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{jobUIDLabel: "fred"}}
+	job.Spec.Template.Labels = map[string]string{"app": "fred", jobUIDLabel: "fred", jobNameLabel: "fred"}
+
+	clone := rerunJob(&job)
+
+	assert.Regexp(t, `^fred-rerun-\w{3}$`, clone.Name)
+	assert.Equal(t, "default", clone.Namespace)
+	assert.Equal(t, map[string]string{"app": "fred"}, clone.Labels)
+	assert.Nil(t, clone.Spec.Selector)
+	assert.Equal(t, map[string]string{"app": "fred"}, clone.Spec.Template.Labels)
+	assert.Equal(t, 3, len(job.Spec.Template.Labels))
+}
+
+func TestLastSuccess(t *testing.T) {
+	t1, t2 := metav1.NewTime(time.Now().Add(-time.Hour)), metav1.NewTime(time.Now())
+	j1, j2, j3 := makeJob("j1", batchv1.JobComplete, 0), makeJob("j2", batchv1.JobComplete, 0), makeJob("j3", batchv1.JobFailed, 1)
+	j1.Status.CompletionTime, j2.Status.CompletionTime = &t2, &t1
+
+	assert.Nil(t, LastSuccess(nil))
+	assert.Nil(t, LastSuccess([]*batchv1.Job{&j3}))
+	assert.Equal(t, &t2, LastSuccess([]*batchv1.Job{&j2, &j1, &j3}))
+}
+
+// Helpers...
+
+func makeJob(uid string, cond batchv1.JobConditionType, failed int32) batchv1.Job {
+	job := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: uid, Namespace: "default", UID: types.UID(uid)},
+		Status:     batchv1.JobStatus{Failed: failed},
+	}
+	if cond != "" {
+		c := batchv1.JobCondition{Type: cond, Status: v1.ConditionTrue}
+		if cond == batchv1.JobFailed {
+			c.Reason = "BackoffLimitExceeded"
+		}
+		job.Status.Conditions = append(job.Status.Conditions, c)
+	}
+
+	return job
+}
+
+func makeJobPod(name, uid, reason string, code int32, at time.Time) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{jobUIDLabel: uid}},
+		Status: v1.PodStatus{
+			Phase: v1.PodFailed,
+			ContainerStatuses: []v1.ContainerStatus{
+				{
+					Name: "c1",
+					State: v1.ContainerState{
+						Terminated: &v1.ContainerStateTerminated{Reason: reason, ExitCode: code, FinishedAt: metav1.NewTime(at)},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,791 @@
+package dao
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Severity represents a lint finding severity.
+type Severity int
+
+const (
+	// SeverityInfo flags a resource worth a look.
+	SeverityInfo Severity = iota
+	// SeverityWarn flags a likely misconfiguration.
+	SeverityWarn
+	// SeverityError flags a misconfiguration bound to cause issues.
+	SeverityError
+)
+
+// String returns the severity name.
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "ERROR"
+	case SeverityWarn:
+		return "WARN"
+	default:
+		return "INFO"
+	}
+}
+
+// Penalty returns the score penalty of a severity.
+func (s Severity) penalty() float64 {
+	switch s {
+	case SeverityError:
+		return 1
+	case SeverityWarn:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+const (
+	lintPodGVR  = "v1/pods"
+	lintCMGVR   = "v1/configmaps"
+	lintSecGVR  = "v1/secrets"
+	lintPVCGVR  = "v1/persistentvolumeclaims"
+	lintSvcGVR  = "v1/services"
+	lintEPGVR   = "v1/endpoints"
+	lintSAGVR   = "v1/serviceaccounts"
+	lintDPGVR   = "apps/v1/deployments"
+	lintPDBGVR  = "policy/v1beta1/poddisruptionbudgets"
+	lintIngGVR  = "extensions/v1beta1/ingresses"
+	lintRoleGVR = "rbac.authorization.k8s.io/v1/roles"
+	lintCRGVR   = "rbac.authorization.k8s.io/v1/clusterroles"
+	lintRBGVR   = "rbac.authorization.k8s.io/v1/rolebindings"
+	lintCRBGVR  = "rbac.authorization.k8s.io/v1/clusterrolebindings"
+	lintRootCA  = "kube-root-ca.crt"
+	lintLatest  = "latest"
+)
+
+// Finding represents a lint rule violation.
+type Finding struct {
+	Rule     string
+	Severity Severity
+	GVR      client.GVR
+	Path     string
+	Message  string
+	Score    int
+}
+
+// Namespace returns the namespace of the offending resource.
+func (f Finding) Namespace() string {
+	ns, _ := client.Namespaced(f.Path)
+
+	return ns
+}
+
+// LintRule represents a check run against cached resources.
+type LintRule struct {
+	Name        string
+	Description string
+
+	check func(*Linter, string) error
+}
+
+// LintRules returns all known lint rules.
+func LintRules() []LintRule {
+	return []LintRule{
+		{Name: "pod-probes", Description: "Containers without liveness or readiness probes", check: lintProbes},
+		{Name: "pod-limits", Description: "Containers without cpu or memory limits", check: lintLimits},
+		{Name: "image-latest", Description: "Containers using a latest or untagged image", check: lintImages},
+		{Name: "deploy-single-replica-pdb", Description: "Single replica deployments covered by a disruption budget", check: lintSingleReplica},
+		{Name: "orphan-configmap", Description: "ConfigMaps not referenced by any pod", check: lintOrphanConfigMaps},
+		{Name: "orphan-secret", Description: "Secrets not referenced by any pod, service account or ingress", check: lintOrphanSecrets},
+		{Name: "orphan-pvc", Description: "Volume claims not mounted by any pod", check: lintOrphanPVCs},
+		{Name: "svc-no-endpoints", Description: "Services without ready endpoints", check: lintEndpoints},
+		{Name: "rbac-missing-role", Description: "Role bindings referencing missing roles", check: lintBindings},
+	}
+}
+
+// Linter runs lint rules against informer cached resources. Rule results
+// are kept across runs and replayed while the resource versions they were
+// computed from are unchanged.
+type Linter struct {
+	Factory
+
+	mx       sync.Mutex
+	cache    map[lintDep][]*unstructured.Unstructured
+	objects  map[string]lintObject
+	results  map[string]*lintResult
+	run      *lintResult
+	findings []Finding
+	scanned  map[string]map[string]float64
+}
+
+// LintDep tracks a resource list a rule depends on.
+type lintDep struct {
+	gvr, ns string
+}
+
+// LintObject tracks a typed resource and the version it was converted from.
+type lintObject struct {
+	rv string
+	o  metav1.Object
+}
+
+// LintScan tracks a resource checked by a rule.
+type lintScan struct {
+	ns, key string
+}
+
+// LintResult tracks the outcome of a rule run.
+type lintResult struct {
+	deps     []lintDep
+	sum      uint64
+	scans    []lintScan
+	findings []Finding
+}
+
+// NewLinter returns a new cluster linter.
+func NewLinter(f Factory) *Linter {
+	return &Linter{
+		Factory: f,
+		objects: make(map[string]lintObject),
+		results: make(map[string]*lintResult),
+	}
+}
+
+// Lint runs all enabled rules in a given namespace and returns findings
+// sorted by namespace and severity.
+func (l *Linter) Lint(ns string, cfg *config.Lint) ([]Finding, error) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.cache = make(map[lintDep][]*unstructured.Unstructured)
+	l.scanned = make(map[string]map[string]float64)
+	l.findings = nil
+
+	var errs []string
+	for _, r := range LintRules() {
+		if !cfg.IsEnabled(r.Name) {
+			continue
+		}
+		if err := l.check(r, ns); err != nil {
+			log.Warn().Err(err).Msgf("Lint rule %q skipped", r.Name)
+			errs = append(errs, fmt.Sprintf("%s: %v", r.Name, err))
+		}
+	}
+	l.prune()
+	if len(errs) > 0 && len(l.scanned) == 0 {
+		return nil, fmt.Errorf("lint failed: %s", strings.Join(errs, ", "))
+	}
+
+	scores := l.Scores()
+	ff := make([]Finding, len(l.findings))
+	for i, f := range l.findings {
+		f.Score = scores[f.Namespace()]
+		ff[i] = f
+	}
+	sort.SliceStable(ff, func(i, j int) bool {
+		a, b := ff[i], ff[j]
+		if a.Namespace() != b.Namespace() {
+			return a.Namespace() < b.Namespace()
+		}
+		if a.Severity != b.Severity {
+			return a.Severity > b.Severity
+		}
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Rule < b.Rule
+	})
+
+	return ff, nil
+}
+
+// Scores returns a 0-100 score per namespace based on the share of scanned
+// resources free of warnings or errors. Cluster wide resources are scored
+// under a blank namespace.
+func (l *Linter) Scores() map[string]int {
+	ss := make(map[string]int, len(l.scanned))
+	for ns, rr := range l.scanned {
+		var total float64
+		for _, p := range rr {
+			total += 1 - p
+		}
+		ss[ns] = int(math.Round(100 * total / float64(len(rr))))
+	}
+
+	return ss
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// Check replays a rule cached results if its dependencies are unchanged or
+// runs it otherwise.
+func (l *Linter) check(r LintRule, ns string) error {
+	key := r.Name + ":" + ns
+	if res, ok := l.results[key]; ok {
+		if sum, ok := l.fingerprint(res.deps); ok && sum == res.sum {
+			l.replay(res)
+			return nil
+		}
+	}
+	delete(l.results, key)
+
+	l.run = &lintResult{}
+	defer func() { l.run = nil }()
+	if err := r.check(l, ns); err != nil {
+		return err
+	}
+	if sum, ok := l.fingerprint(l.run.deps); ok {
+		l.run.sum = sum
+		l.results[key] = l.run
+	}
+
+	return nil
+}
+
+func (l *Linter) replay(res *lintResult) {
+	for _, s := range res.scans {
+		l.addScan(s)
+	}
+	for _, f := range res.findings {
+		l.addFinding(f)
+	}
+}
+
+// Fingerprint sums up the resource versions of the given lists. Unversioned
+// resources are never considered unchanged.
+func (l *Linter) fingerprint(deps []lintDep) (uint64, bool) {
+	var sum uint64
+	for _, d := range deps {
+		uu, err := l.list(d.gvr, d.ns)
+		if err != nil {
+			return 0, false
+		}
+		for _, u := range uu {
+			rv := u.GetResourceVersion()
+			if rv == "" {
+				return 0, false
+			}
+			h := fnv.New64a()
+			_, _ = h.Write([]byte(lintKey(d.gvr, u)))
+			_, _ = h.Write([]byte(rv))
+			sum += h.Sum64()
+		}
+	}
+
+	return sum, true
+}
+
+// Prune evicts typed resources no longer listed.
+func (l *Linter) prune() {
+	keep := make(map[string]struct{}, len(l.objects))
+	for d, uu := range l.cache {
+		for _, u := range uu {
+			keep[lintKey(d.gvr, u)] = struct{}{}
+		}
+	}
+	for k := range l.objects {
+		if _, ok := keep[k]; !ok {
+			delete(l.objects, k)
+		}
+	}
+}
+
+func (l *Linter) list(gvr, ns string) ([]*unstructured.Unstructured, error) {
+	d := lintDep{gvr: gvr, ns: ns}
+	if l.run != nil && !hasLintDep(l.run.deps, d) {
+		l.run.deps = append(l.run.deps, d)
+	}
+	if uu, ok := l.cache[d]; ok {
+		return uu, nil
+	}
+	oo, err := l.List(gvr, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	uu := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			uu = append(uu, u)
+		}
+	}
+	l.cache[d] = uu
+
+	return uu, nil
+}
+
+// Typed lists resources converted to their api types. Conversions are reused
+// until a resource version changes.
+func (l *Linter) typed(gvr, ns string, newFn func() metav1.Object) ([]metav1.Object, error) {
+	uu, err := l.list(gvr, ns)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]metav1.Object, 0, len(uu))
+	for _, u := range uu {
+		k, rv := lintKey(gvr, u), u.GetResourceVersion()
+		if c, ok := l.objects[k]; ok && rv != "" && c.rv == rv {
+			oo = append(oo, c.o)
+			continue
+		}
+		o := newFn()
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, o); err != nil {
+			return nil, err
+		}
+		l.objects[k] = lintObject{rv: rv, o: o}
+		oo = append(oo, o)
+	}
+
+	return oo, nil
+}
+
+func (l *Linter) pods(ns string) ([]*v1.Pod, error) {
+	oo, err := l.typed(lintPodGVR, ns, func() metav1.Object { return new(v1.Pod) })
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		pp = append(pp, o.(*v1.Pod))
+	}
+
+	return pp, nil
+}
+
+// Scan records a resource as checked.
+func (l *Linter) scan(o metav1.Object, gvr string) {
+	s := lintScan{ns: o.GetNamespace(), key: gvr + ":" + o.GetName()}
+	if l.run != nil {
+		l.run.scans = append(l.run.scans, s)
+	}
+	l.addScan(s)
+}
+
+// Flag records a finding and bumps the resource penalty.
+func (l *Linter) flag(rule string, sev Severity, gvr string, o metav1.Object, msg string, args ...interface{}) {
+	f := Finding{
+		Rule:     rule,
+		Severity: sev,
+		GVR:      client.NewGVR(gvr),
+		Path:     client.FQN(o.GetNamespace(), o.GetName()),
+		Message:  fmt.Sprintf(msg, args...),
+	}
+	if l.run != nil {
+		l.run.findings = append(l.run.findings, f)
+	}
+	l.addFinding(f)
+}
+
+func (l *Linter) addScan(s lintScan) {
+	if _, ok := l.scanned[s.ns]; !ok {
+		l.scanned[s.ns] = make(map[string]float64)
+	}
+	if _, ok := l.scanned[s.ns][s.key]; !ok {
+		l.scanned[s.ns][s.key] = 0
+	}
+}
+
+func (l *Linter) addFinding(f Finding) {
+	ns, n := client.Namespaced(f.Path)
+	s := lintScan{ns: ns, key: f.GVR.String() + ":" + n}
+	l.addScan(s)
+	if p := f.Severity.penalty(); p > l.scanned[s.ns][s.key] {
+		l.scanned[s.ns][s.key] = p
+	}
+	l.findings = append(l.findings, f)
+}
+
+func lintKey(gvr string, u *unstructured.Unstructured) string {
+	return gvr + ":" + client.FQN(u.GetNamespace(), u.GetName())
+}
+
+func hasLintDep(dd []lintDep, d lintDep) bool {
+	for _, dep := range dd {
+		if dep == d {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PodContainers returns all init and regular containers without altering
+// the shared pod spec.
+func podContainers(po *v1.Pod) []v1.Container {
+	cc := make([]v1.Container, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
+	cc = append(cc, po.Spec.InitContainers...)
+
+	return append(cc, po.Spec.Containers...)
+}
+
+func isPodDone(po *v1.Pod) bool {
+	return po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed
+}
+
+func lintProbes(l *Linter, ns string) error {
+	pp, err := l.pods(ns)
+	if err != nil {
+		return err
+	}
+	for _, po := range pp {
+		l.scan(po, lintPodGVR)
+		if isPodDone(po) {
+			continue
+		}
+		for _, co := range po.Spec.Containers {
+			switch {
+			case co.LivenessProbe == nil && co.ReadinessProbe == nil:
+				l.flag("pod-probes", SeverityWarn, lintPodGVR, po, "container %q has no liveness or readiness probe", co.Name)
+			case co.ReadinessProbe == nil:
+				l.flag("pod-probes", SeverityInfo, lintPodGVR, po, "container %q has no readiness probe", co.Name)
+			case co.LivenessProbe == nil:
+				l.flag("pod-probes", SeverityInfo, lintPodGVR, po, "container %q has no liveness probe", co.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func lintLimits(l *Linter, ns string) error {
+	pp, err := l.pods(ns)
+	if err != nil {
+		return err
+	}
+	for _, po := range pp {
+		l.scan(po, lintPodGVR)
+		if isPodDone(po) {
+			continue
+		}
+		for _, co := range podContainers(po) {
+			var missing []string
+			for _, r := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+				if _, ok := co.Resources.Limits[r]; !ok {
+					missing = append(missing, string(r))
+				}
+			}
+			if len(missing) > 0 {
+				l.flag("pod-limits", SeverityWarn, lintPodGVR, po, "container %q has no %s limits", co.Name, strings.Join(missing, "/"))
+			}
+		}
+	}
+
+	return nil
+}
+
+func lintImages(l *Linter, ns string) error {
+	pp, err := l.pods(ns)
+	if err != nil {
+		return err
+	}
+	for _, po := range pp {
+		l.scan(po, lintPodGVR)
+		for _, co := range podContainers(po) {
+			if isLatestImage(co.Image) {
+				l.flag("image-latest", SeverityWarn, lintPodGVR, po, "container %q uses unpinned image %q", co.Name, co.Image)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsLatestImage checks if an image reference is untagged or uses the latest tag.
+func isLatestImage(img string) bool {
+	if strings.Contains(img, "@") {
+		return false
+	}
+	name := img
+	if i := strings.LastIndex(img, "/"); i >= 0 {
+		name = img[i+1:]
+	}
+	i := strings.LastIndex(name, ":")
+	if i < 0 {
+		return true
+	}
+
+	return name[i+1:] == lintLatest
+}
+
+func lintSingleReplica(l *Linter, ns string) error {
+	dd, err := l.typed(lintDPGVR, ns, func() metav1.Object { return new(appsv1.Deployment) })
+	if err != nil {
+		return err
+	}
+	bb, err := l.typed(lintPDBGVR, ns, func() metav1.Object { return new(policyv1beta1.PodDisruptionBudget) })
+	if err != nil {
+		return err
+	}
+	for _, o := range dd {
+		dp := o.(*appsv1.Deployment)
+		l.scan(dp, lintDPGVR)
+		if dp.Spec.Replicas != nil && *dp.Spec.Replicas != 1 {
+			continue
+		}
+		for _, b := range bb {
+			pdb := b.(*policyv1beta1.PodDisruptionBudget)
+			if pdb.Namespace != dp.Namespace || pdb.Spec.Selector == nil {
+				continue
+			}
+			sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+			if err != nil || sel.Empty() || !sel.Matches(labels.Set(dp.Spec.Template.Labels)) {
+				continue
+			}
+			l.flag("deploy-single-replica-pdb", SeverityError, lintDPGVR, dp, "single replica is covered by disruption budget %q and blocks node drains", pdb.Name)
+		}
+	}
+
+	return nil
+}
+
+// PodRefs tracks the configmaps, secrets and claims referenced by pods.
+type podRefs struct {
+	cms, secs, pvcs map[string]bool
+}
+
+func (l *Linter) podRefs(ns string) (podRefs, error) {
+	refs := podRefs{cms: map[string]bool{}, secs: map[string]bool{}, pvcs: map[string]bool{}}
+	pp, err := l.pods(ns)
+	if err != nil {
+		return refs, err
+	}
+	for _, po := range pp {
+		fqn := func(n string) string { return client.FQN(po.Namespace, n) }
+		for _, s := range po.Spec.ImagePullSecrets {
+			refs.secs[fqn(s.Name)] = true
+		}
+		for _, v := range po.Spec.Volumes {
+			switch {
+			case v.ConfigMap != nil:
+				refs.cms[fqn(v.ConfigMap.Name)] = true
+			case v.Secret != nil:
+				refs.secs[fqn(v.Secret.SecretName)] = true
+			case v.PersistentVolumeClaim != nil:
+				refs.pvcs[fqn(v.PersistentVolumeClaim.ClaimName)] = true
+			case v.Projected != nil:
+				for _, s := range v.Projected.Sources {
+					if s.ConfigMap != nil {
+						refs.cms[fqn(s.ConfigMap.Name)] = true
+					}
+					if s.Secret != nil {
+						refs.secs[fqn(s.Secret.Name)] = true
+					}
+				}
+			}
+		}
+		for _, co := range podContainers(po) {
+			for _, e := range co.EnvFrom {
+				if e.ConfigMapRef != nil {
+					refs.cms[fqn(e.ConfigMapRef.Name)] = true
+				}
+				if e.SecretRef != nil {
+					refs.secs[fqn(e.SecretRef.Name)] = true
+				}
+			}
+			for _, e := range co.Env {
+				if e.ValueFrom == nil {
+					continue
+				}
+				if e.ValueFrom.ConfigMapKeyRef != nil {
+					refs.cms[fqn(e.ValueFrom.ConfigMapKeyRef.Name)] = true
+				}
+				if e.ValueFrom.SecretKeyRef != nil {
+					refs.secs[fqn(e.ValueFrom.SecretKeyRef.Name)] = true
+				}
+			}
+		}
+	}
+
+	return refs, nil
+}
+
+func lintOrphanConfigMaps(l *Linter, ns string) error {
+	refs, err := l.podRefs(ns)
+	if err != nil {
+		return err
+	}
+	cc, err := l.list(lintCMGVR, ns)
+	if err != nil {
+		return err
+	}
+	for _, cm := range cc {
+		l.scan(cm, lintCMGVR)
+		if cm.GetName() == lintRootCA || len(cm.GetOwnerReferences()) > 0 {
+			continue
+		}
+		if !refs.cms[client.FQN(cm.GetNamespace(), cm.GetName())] {
+			l.flag("orphan-configmap", SeverityInfo, lintCMGVR, cm, "not referenced by any pod")
+		}
+	}
+
+	return nil
+}
+
+func lintOrphanSecrets(l *Linter, ns string) error {
+	refs, err := l.podRefs(ns)
+	if err != nil {
+		return err
+	}
+	ss, err := l.list(lintSAGVR, ns)
+	if err != nil {
+		return err
+	}
+	for _, sa := range ss {
+		for _, k := range []string{"secrets", "imagePullSecrets"} {
+			rr, _, _ := unstructured.NestedSlice(sa.Object, k)
+			for _, r := range rr {
+				if m, ok := r.(map[string]interface{}); ok {
+					n, _, _ := unstructured.NestedString(m, "name")
+					refs.secs[client.FQN(sa.GetNamespace(), n)] = true
+				}
+			}
+		}
+	}
+	ii, err := l.list(lintIngGVR, ns)
+	if err != nil {
+		log.Warn().Err(err).Msg("Lint ingresses unavailable")
+	}
+	for _, ing := range ii {
+		tt, _, _ := unstructured.NestedSlice(ing.Object, "spec", "tls")
+		for _, t := range tt {
+			if m, ok := t.(map[string]interface{}); ok {
+				n, _, _ := unstructured.NestedString(m, "secretName")
+				refs.secs[client.FQN(ing.GetNamespace(), n)] = true
+			}
+		}
+	}
+
+	secs, err := l.list(lintSecGVR, ns)
+	if err != nil {
+		return err
+	}
+	for _, sec := range secs {
+		l.scan(sec, lintSecGVR)
+		t, _, _ := unstructured.NestedString(sec.Object, "type")
+		if t == string(v1.SecretTypeServiceAccountToken) || strings.HasPrefix(t, "helm.sh/") || len(sec.GetOwnerReferences()) > 0 {
+			continue
+		}
+		if !refs.secs[client.FQN(sec.GetNamespace(), sec.GetName())] {
+			l.flag("orphan-secret", SeverityInfo, lintSecGVR, sec, "not referenced by any pod, service account or ingress")
+		}
+	}
+
+	return nil
+}
+
+func lintOrphanPVCs(l *Linter, ns string) error {
+	refs, err := l.podRefs(ns)
+	if err != nil {
+		return err
+	}
+	pp, err := l.list(lintPVCGVR, ns)
+	if err != nil {
+		return err
+	}
+	for _, pvc := range pp {
+		l.scan(pvc, lintPVCGVR)
+		if !refs.pvcs[client.FQN(pvc.GetNamespace(), pvc.GetName())] {
+			l.flag("orphan-pvc", SeverityWarn, lintPVCGVR, pvc, "not mounted by any pod")
+		}
+	}
+
+	return nil
+}
+
+func lintEndpoints(l *Linter, ns string) error {
+	ss, err := l.typed(lintSvcGVR, ns, func() metav1.Object { return new(v1.Service) })
+	if err != nil {
+		return err
+	}
+	ee, err := l.typed(lintEPGVR, ns, func() metav1.Object { return new(v1.Endpoints) })
+	if err != nil {
+		return err
+	}
+	ready := make(map[string]bool, len(ee))
+	for _, o := range ee {
+		ep := o.(*v1.Endpoints)
+		for _, s := range ep.Subsets {
+			if len(s.Addresses) > 0 {
+				ready[client.FQN(ep.Namespace, ep.Name)] = true
+				break
+			}
+		}
+	}
+	for _, o := range ss {
+		svc := o.(*v1.Service)
+		l.scan(svc, lintSvcGVR)
+		if svc.Spec.Type == v1.ServiceTypeExternalName || len(svc.Spec.Selector) == 0 {
+			continue
+		}
+		if !ready[client.FQN(svc.Namespace, svc.Name)] {
+			l.flag("svc-no-endpoints", SeverityWarn, lintSvcGVR, svc, "no ready endpoints match selector %s", labels.Set(svc.Spec.Selector))
+		}
+	}
+
+	return nil
+}
+
+func lintBindings(l *Linter, ns string) error {
+	roles := make(map[string]bool)
+	for _, gvr := range []string{lintRoleGVR, lintCRGVR} {
+		rns := ns
+		if gvr == lintCRGVR {
+			rns = render.ClusterScope
+		}
+		rr, err := l.list(gvr, rns)
+		if err != nil {
+			return err
+		}
+		for _, r := range rr {
+			roles[r.GetKind()+":"+client.FQN(r.GetNamespace(), r.GetName())] = true
+		}
+	}
+	exists := func(ref rbacv1.RoleRef, ns string) bool {
+		if ref.Kind == "ClusterRole" {
+			ns = ""
+		}
+		return roles[ref.Kind+":"+client.FQN(ns, ref.Name)]
+	}
+
+	bb, err := l.typed(lintRBGVR, ns, func() metav1.Object { return new(rbacv1.RoleBinding) })
+	if err != nil {
+		return err
+	}
+	for _, o := range bb {
+		rb := o.(*rbacv1.RoleBinding)
+		l.scan(rb, lintRBGVR)
+		if !exists(rb.RoleRef, rb.Namespace) {
+			l.flag("rbac-missing-role", SeverityError, lintRBGVR, rb, "references missing %s %q", rb.RoleRef.Kind, rb.RoleRef.Name)
+		}
+	}
+	if ns != render.AllNamespaces {
+		return nil
+	}
+	cc, err := l.typed(lintCRBGVR, render.ClusterScope, func() metav1.Object { return new(rbacv1.ClusterRoleBinding) })
+	if err != nil {
+		return err
+	}
+	for _, o := range cc {
+		crb := o.(*rbacv1.ClusterRoleBinding)
+		l.scan(crb, lintCRBGVR)
+		if !exists(crb.RoleRef, "") {
+			l.flag("rbac-missing-role", SeverityError, lintCRBGVR, crb, "references missing %s %q", crb.RoleRef.Kind, crb.RoleRef.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestLinterLint(t *testing.T) {
+	f := lintFactory{objs: map[string][]*unstructured.Unstructured{
+		lintPodGVR: {
+			lintObj("v1", "Pod", "po1", map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"name":           "c1",
+						"image":          "nginx:1.17",
+						"livenessProbe":  map[string]interface{}{},
+						"readinessProbe": map[string]interface{}{},
+						"resources": map[string]interface{}{
+							"limits": map[string]interface{}{"cpu": "100m", "memory": "10Mi"},
+						},
+						"envFrom": []interface{}{
+							map[string]interface{}{"configMapRef": map[string]interface{}{"name": "cm1"}},
+						},
+					},
+				},
+				"volumes": []interface{}{
+					map[string]interface{}{"name": "v1", "persistentVolumeClaim": map[string]interface{}{"claimName": "pvc1"}},
+				},
+			}),
+			lintObj("v1", "Pod", "po2", map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{"name": "c1", "image": "fred/nginx"},
+				},
+			}),
+		},
+		lintCMGVR: {
+			lintObj("v1", "ConfigMap", "cm1", nil),
+			lintObj("v1", "ConfigMap", "cm2", nil),
+			lintObj("v1", "ConfigMap", lintRootCA, nil),
+		},
+		lintPVCGVR: {
+			lintObj("v1", "PersistentVolumeClaim", "pvc1", nil),
+			lintObj("v1", "PersistentVolumeClaim", "pvc2", nil),
+		},
+		lintDPGVR: {
+			lintObj("apps/v1", "Deployment", "dp1", map[string]interface{}{
+				"replicas": int64(1),
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "dp1"}},
+				},
+			}),
+		},
+		lintPDBGVR: {
+			lintObj("policy/v1beta1", "PodDisruptionBudget", "pdb1", map[string]interface{}{
+				"minAvailable": int64(1),
+				"selector":     map[string]interface{}{"matchLabels": map[string]interface{}{"app": "dp1"}},
+			}),
+		},
+		lintSvcGVR: {
+			lintObj("v1", "Service", "svc1", map[string]interface{}{
+				"selector": map[string]interface{}{"app": "dp1"},
+			}),
+		},
+		lintRBGVR: {
+			lintBinding("RoleBinding", "rb1", "Role", "ro1"),
+			lintBinding("RoleBinding", "rb2", "ClusterRole", "cr1"),
+		},
+		lintCRGVR: {
+			lintObj("rbac.authorization.k8s.io/v1", "ClusterRole", "cr1", nil),
+		},
+	}}
+
+	cfg := config.NewLint()
+	cfg.Rules["pod-limits"] = false
+	ff, err := NewLinter(&f).Lint("fred", cfg)
+	assert.Nil(t, err)
+
+	type finding struct{ rule, path, sev string }
+	ee := []finding{
+		{"deploy-single-replica-pdb", "fred/dp1", "ERROR"},
+		{"rbac-missing-role", "fred/rb1", "ERROR"},
+		{"image-latest", "fred/po2", "WARN"},
+		{"pod-probes", "fred/po2", "WARN"},
+		{"orphan-pvc", "fred/pvc2", "WARN"},
+		{"svc-no-endpoints", "fred/svc1", "WARN"},
+		{"orphan-configmap", "fred/cm2", "INFO"},
+	}
+	aa := make([]finding, 0, len(ff))
+	for _, f := range ff {
+		aa = append(aa, finding{f.Rule, f.Path, f.Severity.String()})
+		assert.Equal(t, 68, f.Score)
+	}
+	assert.Equal(t, ee, aa)
+}
+
+func TestLinterCache(t *testing.T) {
+	po := lintObj("v1", "Pod", "po1", map[string]interface{}{
+		"containers": []interface{}{
+			map[string]interface{}{"name": "c1", "image": "nginx"},
+		},
+	})
+	po.SetResourceVersion("1")
+	f := lintFactory{objs: map[string][]*unstructured.Unstructured{lintPodGVR: {po}}}
+	cfg := config.NewLint()
+	for _, r := range LintRules() {
+		cfg.Rules[r.Name] = r.Name == "image-latest"
+	}
+	l := NewLinter(&f)
+
+	ff, err := l.Lint("fred", cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ff))
+	o := l.objects[lintPodGVR+":fred/po1"].o
+
+	// Same version, the cached finding and conversion are reused.
+	assert.Nil(t, unstructured.SetNestedSlice(po.Object, []interface{}{
+		map[string]interface{}{"name": "c1", "image": "nginx:1.17"},
+	}, "spec", "containers"))
+	ff, err = l.Lint("fred", cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ff))
+	assert.True(t, o == l.objects[lintPodGVR+":fred/po1"].o)
+
+	// New version, the rule runs again.
+	po.SetResourceVersion("2")
+	ff, err = l.Lint("fred", cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(ff))
+	assert.False(t, o == l.objects[lintPodGVR+":fred/po1"].o)
+
+	// Deleted resources are evicted.
+	f.objs[lintPodGVR] = nil
+	_, err = l.Lint("fred", cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(l.objects))
+}
+
+func TestIsLatestImage(t *testing.T) {
+	uu := map[string]bool{
+		"nginx":                            true,
+		"nginx:latest":                     true,
+		"localhost:5000/nginx":             true,
+		"localhost:5000/nginx:1.17":        false,
+		"nginx:1.17":                       false,
+		"nginx@sha256:abcdef0123456789abc": false,
+	}
+
+	for img, e := range uu {
+		assert.Equal(t, e, isLatestImage(img), img)
+	}
+}
+
+// Helpers...
+
+type lintFactory struct {
+	Factory
+
+	objs map[string][]*unstructured.Unstructured
+}
+
+func (f *lintFactory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	oo := make([]runtime.Object, 0, len(f.objs[gvr]))
+	for _, o := range f.objs[gvr] {
+		oo = append(oo, o)
+	}
+	return oo, nil
+}
+
+func lintObj(apiVersion, kind, name string, spec map[string]interface{}) *unstructured.Unstructured {
+	o := unstructured.Unstructured{Object: map[string]interface{}{}}
+	o.SetAPIVersion(apiVersion)
+	o.SetKind(kind)
+	if kind != "ClusterRole" {
+		o.SetNamespace("fred")
+	}
+	o.SetName(name)
+	if spec != nil {
+		o.Object["spec"] = spec
+	}
+
+	return &o
+}
+
+func lintBinding(kind, name, roleKind, role string) *unstructured.Unstructured {
+	o := lintObj("rbac.authorization.k8s.io/v1", kind, name, nil)
+	o.Object["roleRef"] = map[string]interface{}{
+		"apiGroup": "rbac.authorization.k8s.io",
+		"kind":     roleKind,
+		"name":     role,
+	}
+
+	return o
+}
@@ -0,0 +1,169 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const noGVR = "v1/nodes"
+
+// Node represents a node.
+type Node struct {
+	Generic
+}
+
+var _ Accessor = (*Node)(nil)
+
+// Taint adds a taint to a node or replaces the taint matching its key and
+// effect.
+func (n *Node) Taint(path string, t v1.Taint) error {
+	no, err := n.node(path, "update")
+	if err != nil {
+		return err
+	}
+	tt := make([]v1.Taint, 0, len(no.Spec.Taints)+1)
+	for _, e := range no.Spec.Taints {
+		if !e.MatchTaint(&t) {
+			tt = append(tt, e)
+		}
+	}
+	if t.Effect == v1.TaintEffectNoExecute {
+		now := metav1.Now()
+		t.TimeAdded = &now
+	}
+	no.Spec.Taints = append(tt, t)
+	_, err = n.Client().DialOrDie().CoreV1().Nodes().Update(no)
+
+	return err
+}
+
+// Untaint removes node taints matching a key and effect. A blank effect
+// removes all taints for the key.
+func (n *Node) Untaint(path, key string, effect v1.TaintEffect) error {
+	no, err := n.node(path, "update")
+	if err != nil {
+		return err
+	}
+	tt := make([]v1.Taint, 0, len(no.Spec.Taints))
+	for _, t := range no.Spec.Taints {
+		if t.Key == key && (effect == "" || t.Effect == effect) {
+			continue
+		}
+		tt = append(tt, t)
+	}
+	if len(tt) == len(no.Spec.Taints) {
+		return fmt.Errorf("no taint %q found on node %s", key, no.Name)
+	}
+	no.Spec.Taints = tt
+	_, err = n.Client().DialOrDie().CoreV1().Nodes().Update(no)
+
+	return err
+}
+
+// Label sets a node label.
+func (n *Node) Label(path, key, value string) error {
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return fmt.Errorf("invalid label key %q: %s", key, strings.Join(errs, ", "))
+	}
+	if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+		return fmt.Errorf("invalid label value %q: %s", value, strings.Join(errs, ", "))
+	}
+
+	return n.patchLabel(path, key, &value)
+}
+
+// Unlabel removes a node label.
+func (n *Node) Unlabel(path, key string) error {
+	return n.patchLabel(path, key, nil)
+}
+
+func (n *Node) patchLabel(path, key string, value *string) error {
+	no, err := n.node(path, "patch")
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]*string{key: value},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = n.Client().DialOrDie().CoreV1().Nodes().Patch(no.Name, types.MergePatchType, raw)
+
+	return err
+}
+
+// Node fetches a node from the api server once the user is allowed to
+// perform a given verb on it.
+func (n *Node) node(path, verb string) (*v1.Node, error) {
+	_, name := client.Namespaced(path)
+	auth, err := n.Client().CanI("", noGVR, []string{verb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to %s node %s", verb, name)
+	}
+
+	return n.Client().DialOrDie().CoreV1().Nodes().Get(name, metav1.GetOptions{})
+}
+
+// ParseTaint parses a taint spec of the form key[=value]:Effect.
+func ParseTaint(s string) (v1.Taint, error) {
+	var t v1.Taint
+	i := strings.LastIndex(s, ":")
+	if i == -1 {
+		return t, fmt.Errorf("invalid taint %q. Expecting key[=value]:Effect", s)
+	}
+	kv, effect := s[:i], v1.TaintEffect(s[i+1:])
+	switch effect {
+	case v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule, v1.TaintEffectNoExecute:
+	default:
+		return t, fmt.Errorf("invalid taint effect %q. Expecting one of %s, %s or %s", effect,
+			v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule, v1.TaintEffectNoExecute)
+	}
+	tokens := strings.SplitN(kv, "=", 2)
+	t.Key, t.Effect = tokens[0], effect
+	if len(tokens) == 2 {
+		t.Value = tokens[1]
+	}
+	if errs := validation.IsQualifiedName(t.Key); len(errs) > 0 {
+		return t, fmt.Errorf("invalid taint key %q: %s", t.Key, strings.Join(errs, ", "))
+	}
+	if errs := validation.IsValidLabelValue(t.Value); len(errs) > 0 {
+		return t, fmt.Errorf("invalid taint value %q: %s", t.Value, strings.Join(errs, ", "))
+	}
+
+	return t, nil
+}
+
+// NodePods returns the active pods scheduled on a given node.
+func NodePods(f Factory, node string) ([]*v1.Pod, error) {
+	oo, err := f.List("v1/pods", "", true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		if po.Spec.NodeName != node || isPodDone(&po) {
+			continue
+		}
+		pp = append(pp, &po)
+	}
+
+	return pp, nil
+}
@@ -0,0 +1,143 @@
+package dao
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	shellPodPrefix    = "k9s-shell-"
+	shellContainer    = "shell"
+	shellNodeLabel    = "k9s.node-shell"
+	shellPollInterval = time.Second
+	podExecGVR        = "v1/pods:exec"
+	// ShellPodTTL bounds the shell pod lifetime should k9s fail to clean it up.
+	shellPodTTL = int64(time.Hour / time.Second)
+)
+
+// NodeShellCmd enters the node host namespaces from the shell pod.
+var NodeShellCmd = []string{"nsenter", "--target", "1", "--mount", "--uts", "--ipc", "--net", "--pid", "--", "sh", "-l"}
+
+// LaunchShell schedules a privileged pod pinned to a node and waits for it to
+// run. The pod must be removed once the shell session ends.
+func (n *Node) LaunchShell(path string, cfg *config.NodeShell) (*v1.Pod, error) {
+	if cfg.Disabled {
+		return nil, fmt.Errorf("node shells are disabled")
+	}
+	auth, err := n.Client().CanI(cfg.Namespace, "v1/pods", []string{"create", "delete"})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to run shell pods in namespace %s", cfg.Namespace)
+	}
+	// Check attach rights upfront so no privileged pod is left behind.
+	auth, err = n.Client().CanI(cfg.Namespace, podExecGVR, []string{"create"})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to exec into shell pods in namespace %s", cfg.Namespace)
+	}
+
+	no, err := n.node(path, "get")
+	if err != nil {
+		return nil, err
+	}
+	pods := n.Client().DialOrDie().CoreV1().Pods(cfg.Namespace)
+	po, err := pods.Create(NodeShellPod(no.Name, cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+	err = wait.PollImmediate(shellPollInterval, timeout, func() (bool, error) {
+		o, err := pods.Get(po.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch o.Status.Phase {
+		case v1.PodRunning:
+			return true, nil
+		case v1.PodFailed, v1.PodSucceeded:
+			return false, fmt.Errorf("shell pod %s exited with phase %s", po.Name, o.Status.Phase)
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		if e := n.DeleteShell(po); e != nil {
+			return nil, fmt.Errorf("%v (cleanup failed: %v)", err, e)
+		}
+		return nil, err
+	}
+
+	return po, nil
+}
+
+// DeleteShell removes a node shell pod.
+func (n *Node) DeleteShell(po *v1.Pod) error {
+	grace := int64(0)
+
+	return n.Client().DialOrDie().CoreV1().Pods(po.Namespace).Delete(po.Name, &metav1.DeleteOptions{
+		GracePeriodSeconds: &grace,
+	})
+}
+
+// NodeShellPod returns a privileged pod sharing a node host namespaces.
+func NodeShellPod(node string, cfg *config.NodeShell) *v1.Pod {
+	privileged, ttl, grace := true, shellPodTTL, int64(0)
+	tt := make([]v1.Toleration, 0, len(cfg.Tolerations))
+	for _, t := range cfg.Tolerations {
+		tt = append(tt, v1.Toleration{
+			Key:      t.Key,
+			Operator: v1.TolerationOperator(t.Operator),
+			Value:    t.Value,
+			Effect:   v1.TaintEffect(t.Effect),
+		})
+	}
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: shellPodPrefix + shellName(node) + "-",
+			Namespace:    cfg.Namespace,
+			Labels:       map[string]string{shellNodeLabel: shellName(node)},
+		},
+		Spec: v1.PodSpec{
+			NodeName:                      node,
+			HostPID:                       true,
+			HostNetwork:                   true,
+			HostIPC:                       true,
+			RestartPolicy:                 v1.RestartPolicyNever,
+			ActiveDeadlineSeconds:         &ttl,
+			TerminationGracePeriodSeconds: &grace,
+			Tolerations:                   tt,
+			Containers: []v1.Container{
+				{
+					Name:            shellContainer,
+					Image:           cfg.Image,
+					Command:         []string{"sleep", fmt.Sprintf("%d", ttl)},
+					Stdin:           true,
+					TTY:             true,
+					SecurityContext: &v1.SecurityContext{Privileged: &privileged},
+				},
+			},
+		},
+	}
+}
+
+// ShellName trims a node name so generated pod names stay valid.
+func shellName(node string) string {
+	const maxLen = 40
+	if len(node) > maxLen {
+		node = node[:maxLen]
+	}
+
+	return strings.TrimRight(node, ".-")
+}
@@ -0,0 +1,105 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseTaint(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		err  bool
+		e    v1.Taint
+	}{
+		"full": {
+			spec: "dedicated=gpu:NoSchedule",
+			e:    v1.Taint{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule},
+		},
+		"noValue": {
+			spec: "example.com/maintenance:NoExecute",
+			e:    v1.Taint{Key: "example.com/maintenance", Effect: v1.TaintEffectNoExecute},
+		},
+		"noEffect": {
+			spec: "dedicated=gpu",
+			err:  true,
+		},
+		"badEffect": {
+			spec: "dedicated=gpu:Never",
+			err:  true,
+		},
+		"badKey": {
+			spec: "-bozo=gpu:NoSchedule",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ta, err := ParseTaint(u.spec)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ta)
+		})
+	}
+}
+
+func TestNodePods(t *testing.T) {
+	po := func(name, node, phase string) *unstructured.Unstructured {
+		o := lintObj("v1", "Pod", name, map[string]interface{}{"nodeName": node})
+		o.Object["status"] = map[string]interface{}{"phase": phase}
+		return o
+	}
+	f := lintFactory{objs: map[string][]*unstructured.Unstructured{
+		"v1/pods": {
+			po("p1", "n1", "Running"),
+			po("p2", "n2", "Running"),
+			po("p3", "n1", "Succeeded"),
+			po("p4", "n1", "Pending"),
+		},
+	}}
+
+	pp, err := NodePods(&f, "n1")
+	assert.Nil(t, err)
+	nn := make([]string, 0, len(pp))
+	for _, p := range pp {
+		nn = append(nn, p.Name)
+	}
+	assert.Equal(t, []string{"p1", "p4"}, nn)
+}
+
+func TestNodeShellPod(t *testing.T) {
+	cfg := config.NewNodeShell()
+	cfg.Namespace, cfg.Image = "fred", "alpine:3.11"
+	po := NodeShellPod("ip-10-0-0-1.ec2.internal", cfg)
+
+	assert.Equal(t, "fred", po.Namespace)
+	assert.Equal(t, "k9s-shell-ip-10-0-0-1.ec2.internal-", po.GenerateName)
+	assert.Equal(t, "ip-10-0-0-1.ec2.internal", po.Spec.NodeName)
+	assert.True(t, po.Spec.HostPID)
+	assert.True(t, po.Spec.HostNetwork)
+	assert.Equal(t, []v1.Toleration{{Operator: v1.TolerationOpExists}}, po.Spec.Tolerations)
+	assert.Equal(t, 1, len(po.Spec.Containers))
+	assert.Equal(t, "alpine:3.11", po.Spec.Containers[0].Image)
+	assert.True(t, *po.Spec.Containers[0].SecurityContext.Privileged)
+	assert.Equal(t, int64(3600), *po.Spec.ActiveDeadlineSeconds)
+}
+
+func TestShellName(t *testing.T) {
+	uu := map[string]string{
+		"n1": "n1",
+		"a-very-long-node-name-that-goes-on.and-on.compute.internal":  "a-very-long-node-name-that-goes-on.and-o",
+		"a-very-long-node-name-that-goes-on-and.-on.compute.internal": "a-very-long-node-name-that-goes-on-and",
+	}
+
+	for n, e := range uu {
+		assert.Equal(t, e, shellName(n), n)
+	}
+}
@@ -0,0 +1,223 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	ownedScanWorkers = 10
+	ownedPageSize    = 500
+)
+
+// ownedSkips tracks plentiful resources that are only scanned when already
+// watched.
+var ownedSkips = map[string]struct{}{
+	"v1/secrets":    {},
+	"v1/configmaps": {},
+}
+
+// Dependent represents a resource owned by another resource.
+type Dependent struct {
+	GVR        client.GVR
+	Object     *unstructured.Unstructured
+	Controller bool
+}
+
+// OwnerOf returns the gvr and path of a resource owner. The controller
+// reference wins when a resource has several owners.
+func OwnerOf(o *unstructured.Unstructured) (client.GVR, string, error) {
+	rr := o.GetOwnerReferences()
+	if len(rr) == 0 {
+		return client.GVR{}, "", fmt.Errorf("%s has no owner", client.FQN(o.GetNamespace(), o.GetName()))
+	}
+	ref := rr[0]
+	for _, r := range rr {
+		if r.Controller != nil && *r.Controller {
+			ref = r
+			break
+		}
+	}
+
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return client.GVR{}, "", err
+	}
+	gvr, meta, ok := gvrForKind(gv, ref.Kind)
+	if !ok {
+		return client.GVR{}, "", fmt.Errorf("no resource found for owner kind %s/%s", ref.APIVersion, ref.Kind)
+	}
+	if !meta.Namespaced {
+		return gvr, ref.Name, nil
+	}
+
+	return gvr, client.FQN(o.GetNamespace(), ref.Name), nil
+}
+
+// Owned lists all resources referencing a given owner across all listable
+// resources. Namespaced owners only own resources in their namespace.
+// Watched resources are read off the informers cache.
+func Owned(f Factory, owner *unstructured.Unstructured) ([]Dependent, error) {
+	var (
+		ns    = owner.GetNamespace()
+		gvrs  = make(chan client.GVR)
+		mx    sync.Mutex
+		wg    sync.WaitGroup
+		seen  = make(map[types.UID]struct{})
+		deps  []Dependent
+		found = func(gvr client.GVR, ll []unstructured.Unstructured) {
+			mx.Lock()
+			defer mx.Unlock()
+			for i := range ll {
+				ctrl, ok := ownedBy(&ll[i], owner.GetUID())
+				if !ok {
+					continue
+				}
+				if _, ok := seen[ll[i].GetUID()]; ok {
+					continue
+				}
+				seen[ll[i].GetUID()] = struct{}{}
+				deps = append(deps, Dependent{GVR: gvr, Object: &ll[i], Controller: ctrl})
+			}
+		}
+	)
+
+	for i := 0; i < ownedScanWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for gvr := range gvrs {
+				ll, err := ownedList(f, gvr, ns)
+				if err != nil {
+					log.Debug().Err(err).Msgf("Owned scan skipping %q", gvr)
+					continue
+				}
+				found(gvr, ll)
+			}
+		}()
+	}
+	for _, gvr := range ownedCandidates(ns != "") {
+		gvrs <- gvr
+	}
+	close(gvrs)
+	wg.Wait()
+
+	sort.Slice(deps, func(i, j int) bool {
+		a, b := deps[i].Object, deps[j].Object
+		if a.GetKind() != b.GetKind() {
+			return a.GetKind() < b.GetKind()
+		}
+		return client.FQN(a.GetNamespace(), a.GetName()) < client.FQN(b.GetNamespace(), b.GetName())
+	})
+
+	return deps, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// OwnedList lists a resource off the informers cache if it is watched or
+// by pages otherwise.
+func ownedList(f Factory, gvr client.GVR, ns string) ([]unstructured.Unstructured, error) {
+	if f.IsWatched(ns, gvr.String()) {
+		oo, err := f.List(gvr.String(), ns, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		ll := make([]unstructured.Unstructured, 0, len(oo))
+		for _, o := range oo {
+			if u, ok := o.(*unstructured.Unstructured); ok {
+				ll = append(ll, *u)
+			}
+		}
+		return ll, nil
+	}
+	if _, ok := ownedSkips[gvr.String()]; ok {
+		return nil, nil
+	}
+
+	res := f.Client().DynDialOrDie().Resource(gvr.AsGVR())
+	var dial dynamic.ResourceInterface = res
+	if ns != "" {
+		dial = res.Namespace(ns)
+	}
+	var (
+		ll   []unstructured.Unstructured
+		opts = metav1.ListOptions{Limit: ownedPageSize}
+	)
+	for {
+		l, err := dial.List(opts)
+		if err != nil {
+			return nil, err
+		}
+		ll = append(ll, l.Items...)
+		if opts.Continue = l.GetContinue(); opts.Continue == "" {
+			return ll, nil
+		}
+	}
+}
+
+// OwnedCandidates returns all resources that could reference an owner.
+func ownedCandidates(namespaced bool) client.GVRs {
+	var gvrs client.GVRs
+	for _, gvr := range AllGVRs() {
+		meta, err := MetaFor(gvr)
+		if err != nil || IsK9sMeta(meta) || strings.Contains(meta.Name, "/") {
+			continue
+		}
+		if namespaced && !meta.Namespaced {
+			continue
+		}
+		// Events are never owned and can be plenty.
+		if meta.Kind == "Event" || !client.Can(meta.Verbs, "view") {
+			continue
+		}
+		gvrs = append(gvrs, gvr)
+	}
+
+	return gvrs
+}
+
+func gvrForKind(gv schema.GroupVersion, kind string) (client.GVR, metav1.APIResource, bool) {
+	var (
+		match client.GVR
+		meta  metav1.APIResource
+		ok    bool
+	)
+	for _, gvr := range AllGVRs() {
+		m, err := MetaFor(gvr)
+		if err != nil || IsK9sMeta(m) || m.Kind != kind || strings.Contains(m.Name, "/") {
+			continue
+		}
+		if gvr.AsGV().Group != gv.Group {
+			continue
+		}
+		match, meta, ok = gvr, m, true
+		if gvr.AsGV().Version == gv.Version {
+			break
+		}
+	}
+
+	return match, meta, ok
+}
+
+func ownedBy(o *unstructured.Unstructured, uid types.UID) (bool, bool) {
+	for _, r := range o.GetOwnerReferences() {
+		if r.UID == uid {
+			return r.Controller != nil && *r.Controller, true
+		}
+	}
+
+	return false, false
+}
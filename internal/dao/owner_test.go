@@ -0,0 +1,137 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestOwnerOf(t *testing.T) {
+	defer func(m ResourceMetas) { resMetas = m }(resMetas)
+	resMetas = ResourceMetas{
+		client.NewGVR("apps/v1/replicasets"):            {Name: "replicasets", Kind: "ReplicaSet", Namespaced: true},
+		client.NewGVR("extensions/v1beta1/replicasets"): {Name: "replicasets", Kind: "ReplicaSet", Namespaced: true},
+		client.NewGVR("v1/nodes"):                       {Name: "nodes", Kind: "Node"},
+		client.NewGVR("argoproj.io/v1alpha1/workflows"): {Name: "workflows", Kind: "Workflow", Namespaced: true},
+	}
+
+	yes := true
+	uu := map[string]struct {
+		rr   []metav1.OwnerReference
+		gvr  string
+		path string
+		err  bool
+	}{
+		"none": {err: true},
+		"controller": {
+			rr: []metav1.OwnerReference{
+				{APIVersion: "v1", Kind: "Node", Name: "n1"},
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs1", Controller: &yes},
+			},
+			gvr:  "apps/v1/replicasets",
+			path: "fred/rs1",
+		},
+		"cluster": {
+			rr:   []metav1.OwnerReference{{APIVersion: "v1", Kind: "Node", Name: "n1"}},
+			gvr:  "v1/nodes",
+			path: "n1",
+		},
+		"crd": {
+			rr:   []metav1.OwnerReference{{APIVersion: "argoproj.io/v1alpha1", Kind: "Workflow", Name: "w1", Controller: &yes}},
+			gvr:  "argoproj.io/v1alpha1/workflows",
+			path: "fred/w1",
+		},
+		"unknown": {
+			rr:  []metav1.OwnerReference{{APIVersion: "blee/v1", Kind: "Duh", Name: "d1"}},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var o unstructured.Unstructured
+			o.SetName("p1")
+			o.SetNamespace("fred")
+			o.SetOwnerReferences(u.rr)
+			gvr, path, err := OwnerOf(&o)
+			assert.Equal(t, u.err, err != nil)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, u.gvr, gvr.String())
+			assert.Equal(t, u.path, path)
+		})
+	}
+}
+
+func TestOwnedCandidates(t *testing.T) {
+	defer func(m ResourceMetas) { resMetas = m }(resMetas)
+	resMetas = ResourceMetas{
+		client.NewGVR("v1/pods"):            {Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"list"}},
+		client.NewGVR("v1/pods/log"):        {Name: "pods/log", Kind: "Pod", Namespaced: true, Verbs: []string{"get"}},
+		client.NewGVR("v1/events"):          {Name: "events", Kind: "Event", Namespaced: true, Verbs: []string{"list"}},
+		client.NewGVR("v1/nodes"):           {Name: "nodes", Kind: "Node", Verbs: []string{"list"}},
+		client.NewGVR("v1/bindings"):        {Name: "bindings", Kind: "Binding", Namespaced: true, Verbs: []string{"create"}},
+		client.NewGVR("screendumps"):        {Name: "screendumps", Categories: []string{"k9s"}},
+		client.NewGVR("apps/v1/daemonsets"): {Name: "daemonsets", Kind: "DaemonSet", Namespaced: true, Verbs: []string{"list"}},
+	}
+
+	assert.Equal(t, client.GVRs{client.NewGVR("v1/pods"), client.NewGVR("apps/v1/daemonsets")}, ownedCandidates(true))
+	assert.Equal(t, 3, len(ownedCandidates(false)))
+}
+
+func TestOwnedList(t *testing.T) {
+	o := &unstructured.Unstructured{}
+	o.SetName("o1")
+	f := ownedFactory{watched: map[string][]runtime.Object{
+		"v1/pods":    {o},
+		"v1/secrets": {o},
+	}}
+
+	uu := map[string]struct {
+		gvr     string
+		watched bool
+		count   int
+	}{
+		"watched":           {gvr: "v1/pods", watched: true, count: 1},
+		"watchedSecrets":    {gvr: "v1/secrets", watched: true, count: 1},
+		"skippedSecrets":    {gvr: "v1/secrets"},
+		"skippedConfigMaps": {gvr: "v1/configmaps"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ff := f
+			if !u.watched {
+				ff = ownedFactory{}
+			}
+			ll, err := ownedList(ff, client.NewGVR(u.gvr), "fred")
+			assert.Nil(t, err)
+			assert.Equal(t, u.count, len(ll))
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type ownedFactory struct {
+	Factory
+	watched map[string][]runtime.Object
+}
+
+func (f ownedFactory) IsWatched(_, gvr string) bool {
+	_, ok := f.watched[gvr]
+	return ok
+}
+
+func (f ownedFactory) List(gvr, _ string, _ bool, _ labels.Selector) ([]runtime.Object, error) {
+	return f.watched[gvr], nil
+}
@@ -0,0 +1,255 @@
+package dao
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// ProgressFunc reports a transfer progress. Total is zero when unknown.
+type ProgressFunc func(done, total int64)
+
+// Exec runs a command in a pod container and streams its io.
+func (p *Pod) Exec(path, co string, cmd []string, in io.Reader, out, errOut io.Writer) error {
+	ns, n := client.Namespaced(path)
+	cfg, err := p.Client().Config().RESTConfig()
+	if err != nil {
+		return err
+	}
+	req := p.Client().DialOrDie().CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(n).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: co,
+			Command:   cmd,
+			Stdin:     in != nil,
+			Stdout:    out != nil,
+			Stderr:    errOut != nil,
+		}, scheme.ParameterCodec)
+	exec, err := remotecommand.NewSPDYExecutor(cfg, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	err = exec.Stream(remotecommand.StreamOptions{Stdin: in, Stdout: out, Stderr: errOut})
+	if isMissingCmd(err) {
+		return fmt.Errorf("container %s has no %q command. Distroless images can't be browsed", co, cmd[0])
+	}
+
+	return err
+}
+
+// CopyFrom copies a container file or directory into a local directory.
+func (p *Pod) CopyFrom(fqn, co, src, dst string, progress ProgressFunc) (int64, error) {
+	src = path.Clean(src)
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return 0, err
+	}
+	total := p.containerSize(fqn, co, src)
+
+	pr, pw := io.Pipe()
+	go func() {
+		var errOut bytes.Buffer
+		err := p.Exec(fqn, co, []string{"tar", "cf", "-", "-C", path.Dir(src), path.Base(src)}, nil, pw, &errOut)
+		_ = pw.CloseWithError(execError(err, &errOut))
+	}()
+	r := progressReader{Reader: pr, total: total, progress: progress}
+	err := untar(&r, dst)
+	_ = pr.Close()
+
+	return r.done, err
+}
+
+// CopyTo copies a local file or directory into a container directory.
+func (p *Pod) CopyTo(path, co, src, dst string, progress ProgressFunc) (int64, error) {
+	total, err := localSize(src)
+	if err != nil {
+		return 0, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		_ = pw.CloseWithError(tarDir(pw, src))
+	}()
+	r := progressReader{Reader: pr, total: total, progress: progress}
+	var errOut bytes.Buffer
+	err = p.Exec(path, co, []string{"tar", "xf", "-", "-C", dst}, &r, nil, &errOut)
+	_ = pr.Close()
+
+	return r.done, execError(err, &errOut)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ContainerSize estimates a container path size. Zero means unknown.
+func (p *Pod) containerSize(path, co, src string) int64 {
+	var out, errOut bytes.Buffer
+	if err := p.Exec(path, co, []string{"du", "-sk", src}, nil, &out, &errOut); err != nil {
+		log.Debug().Err(execError(err, &errOut)).Msgf("Unable to size %s", src)
+		return 0
+	}
+	ff := strings.Fields(out.String())
+	if len(ff) == 0 {
+		return 0
+	}
+	kb, err := strconv.ParseInt(ff[0], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return kb * 1024
+}
+
+func execError(err error, errOut *bytes.Buffer) error {
+	if err == nil {
+		return nil
+	}
+	if msg := strings.TrimSpace(errOut.String()); msg != "" {
+		return fmt.Errorf("%s: %v", msg, err)
+	}
+
+	return err
+}
+
+type progressReader struct {
+	io.Reader
+
+	done, total int64
+	progress    ProgressFunc
+}
+
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.Reader.Read(b)
+	r.done += int64(n)
+	if n > 0 && r.progress != nil {
+		r.progress(r.done, r.total)
+	}
+
+	return n, err
+}
+
+// Untar extracts an archive into a directory. Entries escaping the directory
+// and links are skipped.
+func untar(r io.Reader, dst string) error {
+	root, err := filepath.Abs(dst)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(root, filepath.FromSlash(hdr.Name))
+		if target != root && !strings.HasPrefix(target, root+string(filepath.Separator)) {
+			log.Warn().Msgf("Skipping archive entry %q outside of %s", hdr.Name, root)
+			continue
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg, tar.TypeRegA:
+			if err := writeFile(target, os.FileMode(hdr.Mode).Perm(), tr); err != nil {
+				return err
+			}
+		default:
+			log.Debug().Msgf("Skipping archive entry %q of type %c", hdr.Name, hdr.Typeflag)
+		}
+	}
+}
+
+func writeFile(target string, mode os.FileMode, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// TarDir archives a local file or directory rooted at its base name.
+func tarDir(w io.Writer, src string) error {
+	src = filepath.Clean(src)
+	base := filepath.Dir(src)
+	tw := tar.NewWriter(w)
+	err := filepath.Walk(src, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() && !fi.IsDir() {
+			log.Debug().Msgf("Skipping non regular file %s", p)
+			return nil
+		}
+		rel, err := filepath.Rel(base, p)
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(fi, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+func localSize(src string) (int64, error) {
+	var size int64
+	err := filepath.Walk(src, func(_ string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.Mode().IsRegular() {
+			size += fi.Size()
+		}
+		return nil
+	})
+
+	return size, err
+}
@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTarRoundTrip(t *testing.T) {
+	src, err := ioutil.TempDir("", "k9s-cp-src")
+	assert.Nil(t, err)
+	defer os.RemoveAll(src)
+	dst, err := ioutil.TempDir("", "k9s-cp-dst")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dst)
+
+	dir := filepath.Join(src, "fred")
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "blee"), 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "a.txt"), []byte("hello"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "blee", "b.txt"), []byte("world!"), 0600))
+
+	size, err := localSize(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), size)
+
+	var buff bytes.Buffer
+	assert.Nil(t, tarDir(&buff, dir))
+	var calls int
+	r := progressReader{Reader: &buff, progress: func(done, total int64) { calls++ }}
+	assert.Nil(t, untar(&r, dst))
+	assert.True(t, calls > 0)
+	assert.True(t, r.done > 0)
+
+	raw, err := ioutil.ReadFile(filepath.Join(dst, "fred", "a.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(raw))
+	fi, err := os.Stat(filepath.Join(dst, "fred", "blee", "b.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+}
+
+func TestUntarSkipsEscapes(t *testing.T) {
+	dst, err := ioutil.TempDir("", "k9s-cp-dst")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dst)
+
+	var buff bytes.Buffer
+	tw := tar.NewWriter(&buff)
+	for _, n := range []string{"../evil.txt", "ok.txt"} {
+		assert.Nil(t, tw.WriteHeader(&tar.Header{Name: n, Mode: 0644, Size: 2, Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte("hi"))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, tw.WriteHeader(&tar.Header{Name: "link", Linkname: "/etc/passwd", Typeflag: tar.TypeSymlink}))
+	assert.Nil(t, tw.Close())
+
+	assert.Nil(t, untar(&buff, dst))
+	_, err = os.Stat(filepath.Join(filepath.Dir(dst), "evil.txt"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dst, "ok.txt"))
+	assert.Nil(t, err)
+	_, err = os.Lstat(filepath.Join(dst, "link"))
+	assert.True(t, os.IsNotExist(err))
+}
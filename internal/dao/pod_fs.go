@@ -0,0 +1,162 @@
+package dao
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// MaxFileSize tracks the largest container file content to read.
+const MaxFileSize = 1024 * 1024
+
+const (
+	exitCmdNotExec  = 126
+	exitCmdNotFound = 127
+)
+
+// Container filesystem entry types.
+const (
+	FileTypeDir     = "dir"
+	FileTypeLink    = "link"
+	FileTypeRegular = "file"
+	FileTypeOther   = "other"
+)
+
+var lsRX = regexp.MustCompile(`^([-bcdlps])([-rwxsStTl]{9})\S*\s+\d+\s+(\S+)\s+(\S+)\s+(\d+,\s*\d+|\d+)\s+(\w{3}\s+\d{1,2}\s+(?:\d{1,2}:\d{2}|\d{4}))\s(.+)$`)
+
+// ContainerFile represents a container filesystem entry.
+type ContainerFile struct {
+	Name, Type     string
+	Mode, Owner    string
+	Size           int64
+	Modified, Link string
+}
+
+// IsDir returns true if the entry is a directory.
+func (f ContainerFile) IsDir() bool {
+	return f.Type == FileTypeDir
+}
+
+// ListFiles lists a container directory. Directories come first.
+func (p *Pod) ListFiles(path, co, dir string) ([]ContainerFile, error) {
+	if !strings.HasSuffix(dir, "/") {
+		dir += "/"
+	}
+	var out, errOut bytes.Buffer
+	if err := p.Exec(path, co, []string{"ls", "-la", "--", dir}, nil, &out, &errOut); err != nil {
+		return nil, execError(err, &errOut)
+	}
+
+	return parseLs(out.String()), nil
+}
+
+// ReadFile reads a container text file. Content past MaxFileSize is
+// truncated.
+func (p *Pod) ReadFile(path, co, file string) (string, bool, error) {
+	var (
+		out    = limitWriter{max: MaxFileSize}
+		errOut bytes.Buffer
+	)
+	err := p.Exec(path, co, []string{"cat", "--", file}, nil, &out, &errOut)
+	if err != nil && !out.truncated {
+		return "", false, execError(err, &errOut)
+	}
+	if bytes.IndexByte(out.buff.Bytes(), 0) >= 0 {
+		return "", false, fmt.Errorf("%s is not a text file", file)
+	}
+
+	return out.buff.String(), out.truncated, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// IsMissingCmd checks if a command could not be found or run in a container.
+func isMissingCmd(err error) bool {
+	var e utilexec.ExitError
+	if !errors.As(err, &e) {
+		return false
+	}
+
+	return e.ExitStatus() == exitCmdNotExec || e.ExitStatus() == exitCmdNotFound
+}
+
+func parseLs(out string) []ContainerFile {
+	ff := make([]ContainerFile, 0, 10)
+	for _, l := range strings.Split(out, "\n") {
+		f, ok := parseLsLine(l)
+		if !ok || f.Name == "." || f.Name == ".." {
+			continue
+		}
+		ff = append(ff, f)
+	}
+	sort.SliceStable(ff, func(i, j int) bool {
+		if ff[i].IsDir() != ff[j].IsDir() {
+			return ff[i].IsDir()
+		}
+		return ff[i].Name < ff[j].Name
+	})
+
+	return ff
+}
+
+func parseLsLine(l string) (ContainerFile, bool) {
+	mm := lsRX.FindStringSubmatch(l)
+	if mm == nil {
+		return ContainerFile{}, false
+	}
+
+	f := ContainerFile{
+		Type:     fileType(mm[1]),
+		Mode:     mm[1] + mm[2],
+		Owner:    mm[3] + ":" + mm[4],
+		Modified: strings.Join(strings.Fields(mm[6]), " "),
+		Name:     mm[7],
+	}
+	if size, err := strconv.ParseInt(mm[5], 10, 64); err == nil {
+		f.Size = size
+	}
+	if f.Type == FileTypeLink {
+		if i := strings.Index(f.Name, " -> "); i >= 0 {
+			f.Name, f.Link = f.Name[:i], f.Name[i+4:]
+		}
+	}
+
+	return f, true
+}
+
+func fileType(t string) string {
+	switch t {
+	case "d":
+		return FileTypeDir
+	case "l":
+		return FileTypeLink
+	case "-":
+		return FileTypeRegular
+	default:
+		return FileTypeOther
+	}
+}
+
+// LimitWriter buffers up to max bytes and fails past it.
+type limitWriter struct {
+	buff      bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (w *limitWriter) Write(b []byte) (int, error) {
+	if left := w.max - w.buff.Len(); len(b) > left {
+		w.buff.Write(b[:left])
+		w.truncated = true
+		return left, fmt.Errorf("content exceeds %d bytes", w.max)
+	}
+
+	return w.buff.Write(b)
+}
@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+func TestParseLs(t *testing.T) {
+	out := `total 12
+drwxr-xr-x    1 root     root          4096 Mar  3 10:12 .
+drwxr-xr-x    1 root     root          4096 Mar  3 10:12 ..
+-rw-r--r--    1 root     root           220 Apr  4  2018 .bashrc
+lrwxrwxrwx    1 root     root             7 Jan  1 00:00 bin -> usr/bin
+drwxr-xr-x    2 nobody   nogroup       4096 Mar  3 10:12 etc
+crw-rw-rw-    1 root     root        1,   3 Mar  3 10:12 null
+-rw-r--r--. 1 fred blee 1234 Mar 13 09:01 my notes.txt
+`
+	ff := parseLs(out)
+
+	assert.Equal(t, []ContainerFile{
+		{Name: "etc", Type: FileTypeDir, Mode: "drwxr-xr-x", Owner: "nobody:nogroup", Size: 4096, Modified: "Mar 3 10:12"},
+		{Name: ".bashrc", Type: FileTypeRegular, Mode: "-rw-r--r--", Owner: "root:root", Size: 220, Modified: "Apr 4 2018"},
+		{Name: "bin", Type: FileTypeLink, Mode: "lrwxrwxrwx", Owner: "root:root", Size: 7, Modified: "Jan 1 00:00", Link: "usr/bin"},
+		{Name: "my notes.txt", Type: FileTypeRegular, Mode: "-rw-r--r--", Owner: "fred:blee", Size: 1234, Modified: "Mar 13 09:01"},
+		{Name: "null", Type: FileTypeOther, Mode: "crw-rw-rw-", Owner: "root:root", Modified: "Mar 3 10:12"},
+	}, ff)
+}
+
+func TestIsMissingCmd(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		e   bool
+	}{
+		"none":       {},
+		"notExec":    {err: utilexec.CodeExitError{Err: errors.New("command terminated with exit code 126"), Code: 126}, e: true},
+		"missing":    {err: utilexec.CodeExitError{Err: errors.New("command terminated with exit code 127"), Code: 127}, e: true},
+		"wrapped":    {err: fmt.Errorf("exec failed: %w", utilexec.CodeExitError{Code: 127}), e: true},
+		"fileAbsent": {err: utilexec.CodeExitError{Err: errors.New("command terminated with exit code 2"), Code: 2}},
+		"other":      {err: errors.New(`exec: "ls": executable file not found in $PATH`)},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, isMissingCmd(u.err))
+		})
+	}
+}
+
+func TestLimitWriter(t *testing.T) {
+	w := limitWriter{max: 5}
+	n, err := w.Write([]byte("abc"))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, n)
+	assert.False(t, w.truncated)
+
+	n, err = w.Write([]byte("defg"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, n)
+	assert.True(t, w.truncated)
+	assert.Equal(t, "abcde", w.buff.String())
+}
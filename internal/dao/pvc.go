@@ -0,0 +1,267 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	scGVR           = "storage.k8s.io/v1/storageclasses"
+	volStatsTTL     = 30 * time.Second
+	volStatsTimeout = 5 * time.Second
+	kubeletSumFmt   = "/api/v1/nodes/%s/proxy/stats/summary"
+)
+
+// PersistentVolumeClaim represents a PVC.
+type PersistentVolumeClaim struct {
+	Generic
+}
+
+var _ Accessor = (*PersistentVolumeClaim)(nil)
+
+// Resize grows a claim storage request. The claim storage class must allow
+// volume expansion.
+func (p *PersistentVolumeClaim) Resize(path, size string) error {
+	qty, err := resource.ParseQuantity(size)
+	if err != nil {
+		return err
+	}
+	pvc, err := p.claim(path)
+	if err != nil {
+		return err
+	}
+	if curr := pvc.Spec.Resources.Requests[v1.ResourceStorage]; qty.Cmp(curr) <= 0 {
+		return fmt.Errorf("claims can only grow. Requested %s but current is %s", qty.String(), curr.String())
+	}
+	ok, err := p.CanExpand(path)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("storage class %q does not allow volume expansion", ClaimClass(pvc))
+	}
+
+	auth, err := p.Client().CanI(pvc.Namespace, p.gvr.String(), []string{"patch"})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to resize claim %s", path)
+	}
+	raw, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"requests": map[string]string{string(v1.ResourceStorage): qty.String()},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = p.Client().DialOrDie().CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(pvc.Name, types.MergePatchType, raw)
+
+	return err
+}
+
+// CanExpand checks if a claim storage class allows volume expansion.
+func (p *PersistentVolumeClaim) CanExpand(path string) (bool, error) {
+	pvc, err := p.claim(path)
+	if err != nil {
+		return false, err
+	}
+	class := ClaimClass(pvc)
+	if class == "" {
+		return false, nil
+	}
+	o, err := p.Get(scGVR, client.FQN(render.ClusterScope, class), true, labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	var sc storagev1.StorageClass
+	if err := fromUnstructured(o, &sc); err != nil {
+		return false, err
+	}
+
+	return sc.AllowVolumeExpansion != nil && *sc.AllowVolumeExpansion, nil
+}
+
+func (p *PersistentVolumeClaim) claim(path string) (*v1.PersistentVolumeClaim, error) {
+	o, err := p.Get(p.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var pvc v1.PersistentVolumeClaim
+	if err := fromUnstructured(o, &pvc); err != nil {
+		return nil, err
+	}
+
+	return &pvc, nil
+}
+
+// ClaimClass returns a claim storage class name.
+func ClaimClass(pvc *v1.PersistentVolumeClaim) string {
+	if class, ok := pvc.Annotations[v1.BetaStorageClassAnnotation]; ok {
+		return class
+	}
+	if pvc.Spec.StorageClassName != nil {
+		return *pvc.Spec.StorageClassName
+	}
+
+	return ""
+}
+
+// ClaimConsumers returns the active pods mounting claims in a given namespace
+// keyed by claim path.
+func ClaimConsumers(f Factory, ns string) (map[string][]*v1.Pod, error) {
+	oo, err := f.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	cc := make(map[string][]*v1.Pod)
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		if isPodDone(&po) {
+			continue
+		}
+		for _, v := range po.Spec.Volumes {
+			if v.PersistentVolumeClaim == nil {
+				continue
+			}
+			fqn := client.FQN(po.Namespace, v.PersistentVolumeClaim.ClaimName)
+			cc[fqn] = append(cc[fqn], &po)
+		}
+	}
+
+	return cc, nil
+}
+
+// VolumeStats represents a claim volume usage as reported by the kubelet.
+type VolumeStats struct {
+	Used, Available, Capacity int64
+	Inodes, InodesUsed        int64
+}
+
+type volStatsEntry struct {
+	sync.Mutex
+	stats map[string]VolumeStats
+	err   error
+	at    time.Time
+}
+
+var volStats = struct {
+	sync.Mutex
+	cache map[string]*volStatsEntry
+}{cache: make(map[string]*volStatsEntry)}
+
+// NodeVolumeStats returns the claims volume usage on a given node keyed by
+// claim path. Stats are sourced from the kubelet summary api and cached.
+func NodeVolumeStats(c client.Connection, node string) (map[string]VolumeStats, error) {
+	e := nodeVolStats(volStatsKey(c, node))
+	e.Lock()
+	defer e.Unlock()
+	if !e.at.IsZero() && time.Since(e.at) < volStatsTTL {
+		return e.stats, e.err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), volStatsTimeout)
+	defer cancel()
+	raw, err := c.DialOrDie().CoreV1().RESTClient().Get().
+		Context(ctx).
+		AbsPath(fmt.Sprintf(kubeletSumFmt, node)).
+		DoRaw()
+	if err != nil {
+		e.stats, e.err = nil, err
+	} else {
+		e.stats, e.err = decodeVolumeStats(raw)
+	}
+	e.at = time.Now()
+
+	return e.stats, e.err
+}
+
+// VolStatsKey returns a node stats cache key. Nodes are keyed by context as
+// node names are only unique within a cluster.
+func volStatsKey(c client.Connection, node string) string {
+	ctx, err := c.Config().CurrentContextName()
+	if err != nil {
+		log.Warn().Err(err).Msg("Unable to resolve current context")
+	}
+
+	return ctx + "/" + node
+}
+
+// NodeVolStats returns a node stats cache entry. Entries are locked per node
+// so a slow kubelet only holds up callers for that node.
+func nodeVolStats(key string) *volStatsEntry {
+	volStats.Lock()
+	defer volStats.Unlock()
+	e, ok := volStats.cache[key]
+	if !ok {
+		e = &volStatsEntry{}
+		volStats.cache[key] = e
+	}
+
+	return e
+}
+
+// KubeletSummary represents the kubelet stats summary volume sections.
+type kubeletSummary struct {
+	Pods []struct {
+		Volume []struct {
+			AvailableBytes *int64 `json:"availableBytes"`
+			CapacityBytes  *int64 `json:"capacityBytes"`
+			UsedBytes      *int64 `json:"usedBytes"`
+			Inodes         *int64 `json:"inodes"`
+			InodesUsed     *int64 `json:"inodesUsed"`
+			PVCRef         *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+func decodeVolumeStats(raw []byte) (map[string]VolumeStats, error) {
+	var sum kubeletSummary
+	if err := json.Unmarshal(raw, &sum); err != nil {
+		return nil, err
+	}
+	val := func(v *int64) int64 {
+		if v == nil {
+			return 0
+		}
+		return *v
+	}
+	ss := make(map[string]VolumeStats)
+	for _, po := range sum.Pods {
+		for _, v := range po.Volume {
+			if v.PVCRef == nil {
+				continue
+			}
+			ss[client.FQN(v.PVCRef.Namespace, v.PVCRef.Name)] = VolumeStats{
+				Used:       val(v.UsedBytes),
+				Available:  val(v.AvailableBytes),
+				Capacity:   val(v.CapacityBytes),
+				Inodes:     val(v.Inodes),
+				InodesUsed: val(v.InodesUsed),
+			}
+		}
+	}
+
+	return ss, nil
+}
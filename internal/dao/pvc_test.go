@@ -0,0 +1,31 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeVolStats(t *testing.T) {
+	n1 := nodeVolStats("n1")
+	assert.True(t, n1 == nodeVolStats("n1"))
+
+	// A node fetch in flight must not block other nodes.
+	n1.Lock()
+	defer n1.Unlock()
+	done := make(chan *volStatsEntry)
+	go func() {
+		e := nodeVolStats("n2")
+		e.Lock()
+		e.Unlock()
+		done <- e
+	}()
+
+	select {
+	case n2 := <-done:
+		assert.False(t, n1 == n2)
+	case <-time.After(time.Second):
+		assert.Fail(t, "node stats locked across nodes")
+	}
+}
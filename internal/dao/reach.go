@@ -0,0 +1,447 @@
+package dao
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	nsGVR = "v1/namespaces"
+	npGVR = "networking.k8s.io/v1/networkpolicies"
+
+	// ReachEgress tracks connections opened by the source pod.
+	ReachEgress = "OUT"
+	// ReachIngress tracks connections opened by a peer.
+	ReachIngress = "IN"
+
+	anyPort = "all"
+)
+
+// ReachPath represents the network reachability between two pods.
+type ReachPath struct {
+	Direction string
+	Peer      string
+	Ports     string
+	Allowed   bool
+	Reason    string
+}
+
+// Reachability evaluates NetworkPolicies against cached pods.
+type Reachability struct {
+	Factory
+
+	pods []*v1.Pod
+	nss  map[string]labels.Set
+	pols []*netv1.NetworkPolicy
+}
+
+// NewReachability returns a new network reachability evaluator.
+func NewReachability(f Factory) *Reachability {
+	return &Reachability{Factory: f}
+}
+
+// Reach lists which pods a given pod can reach and which pods can reach it.
+func (r *Reachability) Reach(path string) ([]ReachPath, error) {
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	var src *v1.Pod
+	for _, po := range r.pods {
+		if client.FQN(po.Namespace, po.Name) == path {
+			src = po
+			break
+		}
+	}
+	if src == nil {
+		return nil, fmt.Errorf("no pod found for %q", path)
+	}
+
+	pp := make([]ReachPath, 0, 2*len(r.pods))
+	for _, po := range r.pods {
+		if po.UID == src.UID || isPodDone(po) {
+			continue
+		}
+		out := r.Evaluate(src, po)
+		out.Direction, out.Peer = ReachEgress, client.FQN(po.Namespace, po.Name)
+		in := r.Evaluate(po, src)
+		in.Direction, in.Peer = ReachIngress, client.FQN(po.Namespace, po.Name)
+		pp = append(pp, out, in)
+	}
+	sort.SliceStable(pp, func(i, j int) bool {
+		if pp[i].Direction != pp[j].Direction {
+			return pp[i].Direction > pp[j].Direction
+		}
+		return pp[i].Peer < pp[j].Peer
+	})
+
+	return pp, nil
+}
+
+// Evaluate checks if a pod can open connections to another pod. Both the
+// egress side of the client and the ingress side of the server must allow it.
+// Pods not selected by any policy for a direction are not isolated.
+func (r *Reachability) Evaluate(from, to *v1.Pod) ReachPath {
+	eg := r.side(from, to, to, netv1.PolicyTypeEgress)
+	in := r.side(to, from, to, netv1.PolicyTypeIngress)
+
+	var p ReachPath
+	switch {
+	case eg.blocked():
+		p.Reason = eg.String()
+	case in.blocked():
+		p.Reason = in.String()
+	default:
+		ports := eg.ports.intersect(in.ports)
+		if len(ports) == 0 {
+			p.Reason = fmt.Sprintf("no common ports (egress %s, ingress %s)", eg.ports, in.ports)
+			break
+		}
+		p.Allowed, p.Ports = true, ports.String()
+		p.Reason = eg.String() + "; " + in.String()
+	}
+	if !p.Allowed {
+		p.Ports = "none"
+	}
+
+	return p
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (r *Reachability) load() error {
+	oo, err := r.List("v1/pods", "", true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	r.pods = make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return err
+		}
+		r.pods = append(r.pods, &po)
+	}
+
+	oo, err = r.List(nsGVR, render.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	r.nss = make(map[string]labels.Set, len(oo))
+	for _, o := range oo {
+		var ns v1.Namespace
+		if err := fromUnstructured(o, &ns); err != nil {
+			return err
+		}
+		r.nss[ns.Name] = labels.Set(ns.Labels)
+	}
+
+	oo, err = r.List(npGVR, "", true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	r.pols = make([]*netv1.NetworkPolicy, 0, len(oo))
+	for _, o := range oo {
+		var np netv1.NetworkPolicy
+		if err := fromUnstructured(o, &np); err != nil {
+			return err
+		}
+		r.pols = append(r.pols, &np)
+	}
+
+	return nil
+}
+
+func fromUnstructured(o runtime.Object, res interface{}) error {
+	u, ok := o.(runtime.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), res)
+}
+
+// ReachSide tracks how policies selecting a pod treat a given direction.
+type reachSide struct {
+	dir       netv1.PolicyType
+	pod       string
+	isolating []string
+	allowing  []string
+	ports     portSet
+}
+
+func (s reachSide) blocked() bool {
+	return len(s.isolating) > 0 && len(s.allowing) == 0
+}
+
+// String explains the side verdict.
+func (s reachSide) String() string {
+	dir := strings.ToLower(string(s.dir))
+	switch {
+	case len(s.isolating) == 0:
+		return fmt.Sprintf("%s open (no policy isolates %s)", dir, s.pod)
+	case len(s.allowing) == 0:
+		return fmt.Sprintf("%s denied by %s", dir, strings.Join(s.isolating, ","))
+	default:
+		return fmt.Sprintf("%s allowed by %s", dir, strings.Join(s.allowing, ","))
+	}
+}
+
+// Side evaluates the policies selecting a pod for a given direction against
+// a peer. Named ports are resolved against the server pod.
+func (r *Reachability) side(po, peer, server *v1.Pod, dir netv1.PolicyType) reachSide {
+	s := reachSide{dir: dir, pod: client.FQN(po.Namespace, po.Name)}
+	for _, np := range r.pols {
+		if np.Namespace != po.Namespace || !policyApplies(np, dir) {
+			continue
+		}
+		sel, err := metav1.LabelSelectorAsSelector(&np.Spec.PodSelector)
+		if err != nil || !sel.Matches(labels.Set(po.Labels)) {
+			continue
+		}
+		s.isolating = append(s.isolating, np.Name)
+
+		allowed := false
+		for _, rule := range policyRules(np, dir) {
+			if !r.peersMatch(np.Namespace, rule.peers, peer) {
+				continue
+			}
+			allowed = true
+			s.ports = s.ports.union(rulePorts(rule.ports, server))
+		}
+		if allowed {
+			s.allowing = append(s.allowing, np.Name)
+		}
+	}
+	if len(s.isolating) == 0 {
+		s.ports = portSet{{}}
+	}
+
+	return s
+}
+
+func policyApplies(np *netv1.NetworkPolicy, dir netv1.PolicyType) bool {
+	if len(np.Spec.PolicyTypes) == 0 {
+		return dir == netv1.PolicyTypeIngress || len(np.Spec.Egress) > 0
+	}
+	for _, t := range np.Spec.PolicyTypes {
+		if t == dir {
+			return true
+		}
+	}
+
+	return false
+}
+
+type policyRule struct {
+	peers []netv1.NetworkPolicyPeer
+	ports []netv1.NetworkPolicyPort
+}
+
+func policyRules(np *netv1.NetworkPolicy, dir netv1.PolicyType) []policyRule {
+	var rr []policyRule
+	if dir == netv1.PolicyTypeIngress {
+		for _, r := range np.Spec.Ingress {
+			rr = append(rr, policyRule{peers: r.From, ports: r.Ports})
+		}
+		return rr
+	}
+	for _, r := range np.Spec.Egress {
+		rr = append(rr, policyRule{peers: r.To, ports: r.Ports})
+	}
+
+	return rr
+}
+
+// PeersMatch checks if a pod matches a rule peers. An empty list matches all.
+func (r *Reachability) peersMatch(ns string, pp []netv1.NetworkPolicyPeer, po *v1.Pod) bool {
+	if len(pp) == 0 {
+		return true
+	}
+	for _, p := range pp {
+		if r.peerMatches(ns, p, po) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Reachability) peerMatches(ns string, p netv1.NetworkPolicyPeer, po *v1.Pod) bool {
+	if p.IPBlock != nil {
+		return inBlock(p.IPBlock, po.Status.PodIP)
+	}
+	if p.NamespaceSelector == nil {
+		if po.Namespace != ns {
+			return false
+		}
+	} else {
+		sel, err := metav1.LabelSelectorAsSelector(p.NamespaceSelector)
+		if err != nil || !sel.Matches(r.nss[po.Namespace]) {
+			return false
+		}
+	}
+	if p.PodSelector == nil {
+		return true
+	}
+	sel, err := metav1.LabelSelectorAsSelector(p.PodSelector)
+
+	return err == nil && sel.Matches(labels.Set(po.Labels))
+}
+
+func inBlock(b *netv1.IPBlock, ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	if _, cidr, err := net.ParseCIDR(b.CIDR); err != nil || !cidr.Contains(addr) {
+		return false
+	}
+	for _, e := range b.Except {
+		if _, cidr, err := net.ParseCIDR(e); err == nil && cidr.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Port represents a protocol port. A zero port matches all ports and a blank
+// protocol matches all protocols.
+type port struct {
+	proto string
+	port  int32
+}
+
+func (p port) String() string {
+	switch {
+	case p.proto == "":
+		return anyPort
+	case p.port == 0:
+		return p.proto + "/*"
+	default:
+		return p.proto + "/" + strconv.Itoa(int(p.port))
+	}
+}
+
+func (p port) intersect(o port) (port, bool) {
+	res := p
+	switch {
+	case p.proto == "":
+		res.proto = o.proto
+	case o.proto != "" && o.proto != p.proto:
+		return port{}, false
+	}
+	switch {
+	case p.port == 0:
+		res.port = o.port
+	case o.port != 0 && o.port != p.port:
+		return port{}, false
+	}
+
+	return res, true
+}
+
+type portSet []port
+
+func (s portSet) String() string {
+	if len(s) == 0 {
+		return "none"
+	}
+	ss := make([]string, 0, len(s))
+	for _, p := range s {
+		ss = append(ss, p.String())
+	}
+	sort.Strings(ss)
+
+	return strings.Join(ss, ",")
+}
+
+func (s portSet) union(o portSet) portSet {
+	res := s
+	for _, p := range o {
+		if !res.has(p) {
+			res = append(res, p)
+		}
+	}
+
+	return res
+}
+
+func (s portSet) intersect(o portSet) portSet {
+	var res portSet
+	for _, a := range s {
+		for _, b := range o {
+			if p, ok := a.intersect(b); ok && !res.has(p) {
+				res = append(res, p)
+			}
+		}
+	}
+
+	return res
+}
+
+func (s portSet) has(p port) bool {
+	for _, q := range s {
+		if q == p {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RulePorts converts rule ports. No ports means all ports. Named ports not
+// exposed by the server pod never match.
+func rulePorts(pp []netv1.NetworkPolicyPort, server *v1.Pod) portSet {
+	if len(pp) == 0 {
+		return portSet{{}}
+	}
+	var res portSet
+	for _, p := range pp {
+		proto := string(v1.ProtocolTCP)
+		if p.Protocol != nil {
+			proto = string(*p.Protocol)
+		}
+		switch {
+		case p.Port == nil:
+			res = append(res, port{proto: proto})
+		case p.Port.Type == intstr.Int:
+			res = append(res, port{proto: proto, port: p.Port.IntVal})
+		default:
+			if n, ok := namedPort(server, p.Port.StrVal, proto); ok {
+				res = append(res, port{proto: proto, port: n})
+			}
+		}
+	}
+
+	return res
+}
+
+func namedPort(po *v1.Pod, name, proto string) (int32, bool) {
+	for _, co := range po.Spec.Containers {
+		for _, p := range co.Ports {
+			pr := p.Protocol
+			if pr == "" {
+				pr = v1.ProtocolTCP
+			}
+			if p.Name == name && string(pr) == proto {
+				return p.ContainerPort, true
+			}
+		}
+	}
+
+	return 0, false
+}
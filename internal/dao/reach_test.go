@@ -0,0 +1,167 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestReachabilityEvaluate(t *testing.T) {
+	f := lintFactory{objs: map[string][]*unstructured.Unstructured{
+		"v1/pods": {
+			reachPod("fred", "web", "web", "10.0.0.1", v1.ContainerPort{Name: "http", ContainerPort: 8080}),
+			reachPod("fred", "api", "api", "10.0.0.2", v1.ContainerPort{Name: "http", ContainerPort: 9090}),
+			reachPod("fred", "db", "db", "10.0.0.3"),
+			reachPod("blee", "x", "x", "10.1.0.1"),
+			reachPod("zorg", "ext", "ext", "192.168.0.1"),
+		},
+		nsGVR: {
+			reachObj(&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "fred"}}),
+			reachObj(&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "blee", Labels: map[string]string{"team": "blee"}}}),
+			reachObj(&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "zorg"}}),
+		},
+		npGVR: {
+			reachPolicy("default-deny", nil, netv1.NetworkPolicySpec{}),
+			reachPolicy("allow-web-api", map[string]string{"app": "api"}, netv1.NetworkPolicySpec{
+				Ingress: []netv1.NetworkPolicyIngressRule{{
+					From:  []netv1.NetworkPolicyPeer{{PodSelector: selector("app", "web")}},
+					Ports: []netv1.NetworkPolicyPort{{Port: portRef(intstr.FromString("http"))}},
+				}},
+			}),
+			reachPolicy("allow-blee", map[string]string{"app": "web"}, netv1.NetworkPolicySpec{
+				Ingress: []netv1.NetworkPolicyIngressRule{{
+					From: []netv1.NetworkPolicyPeer{
+						{NamespaceSelector: selector("team", "blee")},
+						{IPBlock: &netv1.IPBlock{CIDR: "192.168.0.0/16", Except: []string{"192.168.1.0/24"}}},
+					},
+				}},
+			}),
+			reachPolicy("db-egress", map[string]string{"app": "db"}, netv1.NetworkPolicySpec{
+				PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeEgress},
+			}),
+		},
+	}}
+
+	r := NewReachability(&f)
+	assert.Nil(t, r.load())
+	pods := make(map[string]*v1.Pod)
+	for _, po := range r.pods {
+		pods[po.Name] = po
+	}
+
+	uu := map[string]struct {
+		from, to string
+		e        ReachPath
+	}{
+		"allowed": {
+			from: "web", to: "api",
+			e: ReachPath{Allowed: true, Ports: "TCP/9090", Reason: "egress open (no policy isolates fred/web); ingress allowed by allow-web-api"},
+		},
+		"default-deny": {
+			from: "api", to: "web",
+			e: ReachPath{Ports: "none", Reason: "ingress denied by default-deny,allow-blee"},
+		},
+		"egress-deny": {
+			from: "db", to: "api",
+			e: ReachPath{Ports: "none", Reason: "egress denied by db-egress"},
+		},
+		"other-ns": {
+			from: "x", to: "api",
+			e: ReachPath{Ports: "none", Reason: "ingress denied by default-deny,allow-web-api"},
+		},
+		"ns-selector": {
+			from: "x", to: "web",
+			e: ReachPath{Allowed: true, Ports: "all", Reason: "egress open (no policy isolates blee/x); ingress allowed by allow-blee"},
+		},
+		"ip-block": {
+			from: "ext", to: "web",
+			e: ReachPath{Allowed: true, Ports: "all", Reason: "egress open (no policy isolates zorg/ext); ingress allowed by allow-blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, r.Evaluate(pods[u.from], pods[u.to]))
+		})
+	}
+}
+
+func TestReachabilityReach(t *testing.T) {
+	f := lintFactory{objs: map[string][]*unstructured.Unstructured{
+		"v1/pods": {
+			reachPod("fred", "web", "web", "10.0.0.1"),
+			reachPod("fred", "api", "api", "10.0.0.2"),
+		},
+	}}
+
+	pp, err := NewReachability(&f).Reach("fred/web")
+	assert.Nil(t, err)
+	assert.Equal(t, []ReachPath{
+		{Direction: ReachEgress, Peer: "fred/api", Ports: "all", Allowed: true, Reason: "egress open (no policy isolates fred/web); ingress open (no policy isolates fred/api)"},
+		{Direction: ReachIngress, Peer: "fred/api", Ports: "all", Allowed: true, Reason: "egress open (no policy isolates fred/api); ingress open (no policy isolates fred/web)"},
+	}, pp)
+
+	_, err = NewReachability(&f).Reach("fred/zorg")
+	assert.NotNil(t, err)
+}
+
+func TestPortSetIntersect(t *testing.T) {
+	uu := map[string]struct {
+		a, b portSet
+		e    string
+	}{
+		"all":      {a: portSet{{}}, b: portSet{{proto: "TCP", port: 80}}, e: "TCP/80"},
+		"proto":    {a: portSet{{proto: "TCP"}}, b: portSet{{proto: "TCP", port: 80}, {proto: "UDP", port: 53}}, e: "TCP/80"},
+		"mismatch": {a: portSet{{proto: "TCP", port: 80}}, b: portSet{{proto: "TCP", port: 443}}, e: "none"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.a.intersect(u.b).String())
+		})
+	}
+}
+
+// Helpers...
+
+func reachObj(o runtime.Object) *unstructured.Unstructured {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	if err != nil {
+		panic(err)
+	}
+
+	return &unstructured.Unstructured{Object: m}
+}
+
+func reachPod(ns, name, app, ip string, pp ...v1.ContainerPort) *unstructured.Unstructured {
+	return reachObj(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name, UID: types.UID("uid-" + name), Labels: map[string]string{"app": app}},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "c1", Ports: pp}}},
+		Status:     v1.PodStatus{Phase: v1.PodRunning, PodIP: ip},
+	})
+}
+
+func reachPolicy(name string, sel map[string]string, spec netv1.NetworkPolicySpec) *unstructured.Unstructured {
+	spec.PodSelector = metav1.LabelSelector{MatchLabels: sel}
+	return reachObj(&netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "fred", Name: name},
+		Spec:       spec,
+	})
+}
+
+func selector(k, v string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: map[string]string{k: v}}
+}
+
+func portRef(p intstr.IntOrString) *intstr.IntOrString {
+	return &p
+}
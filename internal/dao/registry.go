@@ -28,16 +28,23 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("contexts"):                      &Context{},
 		client.NewGVR("containers"):                    &Container{},
 		client.NewGVR("screendumps"):                   &ScreenDump{},
+		client.NewGVR("templates"):                     &Template{},
+		client.NewGVR("helm"):                          &Helm{},
 		client.NewGVR("benchmarks"):                    &Benchmark{},
 		client.NewGVR("portforwards"):                  &PortForward{},
+		client.NewGVR("v1/configmaps"):                 &ConfigMap{},
 		client.NewGVR("v1/services"):                   &Service{},
 		client.NewGVR("v1/pods"):                       &Pod{},
+		client.NewGVR("v1/nodes"):                      &Node{},
+		client.NewGVR("v1/persistentvolumeclaims"):     &PersistentVolumeClaim{},
 		client.NewGVR("apps/v1/deployments"):           &Deployment{},
 		client.NewGVR("apps/v1/daemonsets"):            &DaemonSet{},
 		client.NewGVR("extensions/v1beta1/daemonsets"): &DaemonSet{},
 		client.NewGVR("apps/v1/statefulsets"):          &StatefulSet{},
 		client.NewGVR("batch/v1beta1/cronjobs"):        &CronJob{},
 		client.NewGVR("batch/v1/jobs"):                 &Job{},
+		client.NewGVR(hpaV1GVR):                        &HorizontalPodAutoscaler{},
+		client.NewGVR(hpaV2b2GVR):                      &HorizontalPodAutoscaler{},
 	}
 
 	r, ok := m[gvr]
@@ -118,6 +125,61 @@ func loadNonResource(m ResourceMetas) {
 		Verbs:      []string{"delete"},
 		Categories: []string{"k9s"},
 	}
+	m[client.NewGVR("templates")] = metav1.APIResource{
+		Name:       "templates",
+		Kind:       "Templates",
+		ShortNames: []string{"tpl"},
+		Verbs:      []string{"delete"},
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("helm")] = metav1.APIResource{
+		Name:       "helm",
+		Kind:       "Helm",
+		ShortNames: []string{"hr", "releases"},
+		Namespaced: true,
+		Verbs:      []string{"delete"},
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("helmhistory")] = metav1.APIResource{
+		Name:       "helmhistory",
+		Kind:       "HelmHistory",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("timeline")] = metav1.APIResource{
+		Name:       "timeline",
+		Kind:       "Timeline",
+		Namespaced: true,
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("lint")] = metav1.APIResource{
+		Name:       "lint",
+		Kind:       "Lint",
+		ShortNames: []string{"sanitize"},
+		Namespaced: true,
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("reach")] = metav1.APIResource{
+		Name:       "reach",
+		Kind:       "Reach",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("images")] = metav1.APIResource{
+		Name:       "images",
+		Kind:       "Images",
+		ShortNames: []string{"img"},
+		Namespaced: true,
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("nodedetails")] = metav1.APIResource{
+		Name:       "nodedetails",
+		Kind:       "NodeDetails",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("hpadetails")] = metav1.APIResource{
+		Name:       "hpadetails",
+		Kind:       "HPADetails",
+		Categories: []string{"k9s"},
+	}
 	m[client.NewGVR("benchmarks")] = metav1.APIResource{
 		Name:       "benchmarks",
 		Kind:       "Benchmarks",
@@ -138,6 +200,30 @@ func loadNonResource(m ResourceMetas) {
 		Kind:       "Containers",
 		Categories: []string{"k9s"},
 	}
+	m[client.NewGVR("metricshistory")] = metav1.APIResource{
+		Name:       "metricshistory",
+		Kind:       "MetricsHistory",
+		ShortNames: []string{"mxh"},
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("podextras")] = metav1.APIResource{
+		Name:       "podextras",
+		Kind:       "PodExtras",
+		ShortNames: []string{"pox"},
+		Namespaced: true,
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("informers")] = metav1.APIResource{
+		Name:       "informers",
+		Kind:       "Informers",
+		ShortNames: []string{"inf"},
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("configmapkeys")] = metav1.APIResource{
+		Name:       "configmapkeys",
+		Kind:       "ConfigMapKeys",
+		Categories: []string{"k9s"},
+	}
 
 	loadRBAC(m)
 }
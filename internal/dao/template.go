@@ -0,0 +1,208 @@
+package dao
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var defaultTemplates = map[string]string{
+	"pod.yaml": `apiVersion: v1
+kind: Pod
+metadata:
+  name: {{ .Name }}
+  namespace: {{ .Namespace }}
+spec:
+  restartPolicy: Never
+  containers:
+  - name: {{ .Name }}
+    image: {{ .Image }}
+    command: ["sleep", "3600"]
+`,
+	"job.yaml": `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: {{ .Name }}
+  namespace: {{ .Namespace }}
+spec:
+  backoffLimit: 0
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: {{ .Name }}
+        image: {{ .Image }}
+        command: ["sh", "-c", "echo hello"]
+`,
+	"configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Name }}
+  namespace: {{ .Namespace }}
+data:
+  key: value
+`,
+}
+
+// Template represents a resource creation template.
+type Template struct {
+	Generic
+}
+
+var _ Accessor = (*Template)(nil)
+var _ Nuker = (*Template)(nil)
+
+// Delete a Template.
+func (t *Template) Delete(path string, cascade, force bool) error {
+	return os.Remove(path)
+}
+
+// EnsureTemplates seeds a templates directory with default templates
+// unless it already exists.
+func EnsureTemplates(dir string) error {
+	if _, err := os.Stat(dir); err == nil || !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for n, t := range defaultTemplates {
+		if err := ioutil.WriteFile(filepath.Join(dir, n), []byte(t), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TemplateVars returns the variables referenced by a template in order of
+// appearance.
+func TemplateVars(raw []byte) ([]string, error) {
+	t, err := template.New("vars").Parse(string(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		vv   []string
+		seen = make(map[string]struct{})
+	)
+	walkFields(t.Tree.Root, func(v string) {
+		if _, ok := seen[v]; ok {
+			return
+		}
+		seen[v] = struct{}{}
+		vv = append(vv, v)
+	})
+
+	return vv, nil
+}
+
+// RenderTemplate renders a template with the given variables. All variables
+// must be provided.
+func RenderTemplate(raw []byte, vars map[string]string) ([]byte, error) {
+	t, err := template.New("template").Option("missingkey=error").Parse(string(raw))
+	if err != nil {
+		return nil, err
+	}
+	var buff bytes.Buffer
+	if err := t.Execute(&buff, vars); err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// Duplicate clones a resource under a new name. Server managed fields and
+// fields that would prevent the clone from being created are removed.
+func Duplicate(o *unstructured.Unstructured, name string) *unstructured.Unstructured {
+	c := &unstructured.Unstructured{Object: StripNoise(o.Object)}
+	c.SetName(name)
+	c.SetGenerateName("")
+	c.SetOwnerReferences(nil)
+	c.SetFinalizers(nil)
+
+	switch c.GetKind() {
+	case "Service":
+		unstructured.RemoveNestedField(c.Object, "spec", "clusterIP")
+		unstructured.RemoveNestedField(c.Object, "spec", "clusterIPs")
+		unstructured.RemoveNestedField(c.Object, "spec", "healthCheckNodePort")
+		unstructured.RemoveNestedField(c.Object, "spec", "loadBalancerIP")
+		stripNodePorts(c)
+	case "Job":
+		// Job selectors are generated and bound to the original job uid.
+		unstructured.RemoveNestedField(c.Object, "spec", "selector")
+		for _, l := range []string{"controller-uid", "job-name"} {
+			unstructured.RemoveNestedField(c.Object, "spec", "template", "metadata", "labels", l)
+		}
+	case "PersistentVolumeClaim":
+		unstructured.RemoveNestedField(c.Object, "spec", "volumeName")
+	}
+
+	return c
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// StripNodePorts clears allocated node ports so they do not collide with the
+// original service.
+func stripNodePorts(o *unstructured.Unstructured) {
+	pp, ok, err := unstructured.NestedSlice(o.Object, "spec", "ports")
+	if err != nil || !ok {
+		return
+	}
+	for _, p := range pp {
+		if m, ok := p.(map[string]interface{}); ok {
+			delete(m, "nodePort")
+		}
+	}
+	if err := unstructured.SetNestedSlice(o.Object, pp, "spec", "ports"); err != nil {
+		log.Error().Err(err).Msgf("Unable to strip node ports")
+	}
+}
+
+func walkFields(n parse.Node, fn func(string)) {
+	switch t := n.(type) {
+	case *parse.ListNode:
+		if t == nil {
+			return
+		}
+		for _, c := range t.Nodes {
+			walkFields(c, fn)
+		}
+	case *parse.ActionNode:
+		walkFields(t.Pipe, fn)
+	case *parse.PipeNode:
+		if t == nil {
+			return
+		}
+		for _, c := range t.Cmds {
+			walkFields(c, fn)
+		}
+	case *parse.CommandNode:
+		for _, a := range t.Args {
+			walkFields(a, fn)
+		}
+	case *parse.FieldNode:
+		fn(t.Ident[0])
+	case *parse.IfNode:
+		walkBranch(&t.BranchNode, fn)
+	case *parse.RangeNode:
+		walkBranch(&t.BranchNode, fn)
+	case *parse.WithNode:
+		walkBranch(&t.BranchNode, fn)
+	}
+}
+
+func walkBranch(b *parse.BranchNode, fn func(string)) {
+	walkFields(b.Pipe, fn)
+	walkFields(b.List, fn)
+	walkFields(b.ElseList, fn)
+}
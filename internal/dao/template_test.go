@@ -0,0 +1,137 @@
+package dao
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestTemplateVars(t *testing.T) {
+	uu := map[string]struct {
+		t   string
+		e   []string
+		err bool
+	}{
+		"none":   {t: "kind: Pod", e: nil},
+		"dups":   {t: "name: {{ .Name }}\nns: {{.Namespace}}\nc: {{ .Name }}", e: []string{"Name", "Namespace"}},
+		"pipe":   {t: `image: {{ .Image | printf "%s:latest" }}`, e: []string{"Image"}},
+		"branch": {t: "{{ if .Debug }}debug: {{ .Level }}{{ else }}{{ .Name }}{{ end }}", e: []string{"Debug", "Level", "Name"}},
+		"bad":    {t: "name: {{ .Name", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vv, err := TemplateVars([]byte(u.t))
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, vv)
+		})
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	raw := []byte("name: {{ .Name }}\nnamespace: {{ .Namespace }}\n")
+
+	res, err := RenderTemplate(raw, map[string]string{"Name": "fred", "Namespace": "blee"})
+	assert.Nil(t, err)
+	assert.Equal(t, "name: fred\nnamespace: blee\n", string(res))
+
+	_, err = RenderTemplate(raw, map[string]string{"Name": "fred"})
+	assert.NotNil(t, err)
+}
+
+func TestEnsureTemplates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-templates")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	tpl := filepath.Join(dir, "templates")
+	assert.Nil(t, EnsureTemplates(tpl))
+	ff, err := ioutil.ReadDir(tpl)
+	assert.Nil(t, err)
+	assert.Equal(t, len(defaultTemplates), len(ff))
+	for _, f := range ff {
+		raw, err := ioutil.ReadFile(filepath.Join(tpl, f.Name()))
+		assert.Nil(t, err)
+		vv, err := TemplateVars(raw)
+		assert.Nil(t, err)
+		assert.Contains(t, vv, "Name")
+	}
+
+	assert.Nil(t, os.Remove(filepath.Join(tpl, "pod.yaml")))
+	assert.Nil(t, EnsureTemplates(tpl))
+	_, err = os.Stat(filepath.Join(tpl, "pod.yaml"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDuplicate(t *testing.T) {
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata": map[string]interface{}{
+			"name":            "j1",
+			"namespace":       "fred",
+			"uid":             "u1",
+			"resourceVersion": "10",
+			"labels":          map[string]interface{}{"app": "j"},
+			"ownerReferences": []interface{}{map[string]interface{}{"kind": "CronJob", "name": "cj1"}},
+		},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"controller-uid": "u1"}},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"controller-uid": "u1", "job-name": "j1", "app": "j"},
+				},
+			},
+		},
+		"status": map[string]interface{}{"succeeded": int64(1)},
+	}}
+
+	c := Duplicate(o, "j2")
+	assert.Equal(t, "j2", c.GetName())
+	assert.Equal(t, "fred", c.GetNamespace())
+	assert.Equal(t, "", c.GetResourceVersion())
+	assert.Equal(t, "", string(c.GetUID()))
+	assert.Empty(t, c.GetOwnerReferences())
+	assert.Equal(t, map[string]string{"app": "j"}, c.GetLabels())
+	_, ok := c.Object["status"]
+	assert.False(t, ok)
+	_, ok, _ = unstructured.NestedMap(c.Object, "spec", "selector")
+	assert.False(t, ok)
+	ll, _, _ := unstructured.NestedStringMap(c.Object, "spec", "template", "metadata", "labels")
+	assert.Equal(t, map[string]string{"app": "j"}, ll)
+
+	assert.Equal(t, "j1", o.GetName())
+	assert.Equal(t, "10", o.GetResourceVersion())
+}
+
+func TestDuplicateService(t *testing.T) {
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"name": "s1", "namespace": "fred"},
+		"spec": map[string]interface{}{
+			"type":                "LoadBalancer",
+			"clusterIP":           "10.0.0.1",
+			"loadBalancerIP":      "1.2.3.4",
+			"healthCheckNodePort": int64(32000),
+			"ports": []interface{}{
+				map[string]interface{}{"port": int64(80), "nodePort": int64(30080)},
+			},
+		},
+	}}
+
+	c := Duplicate(o, "s2")
+	spec, _, _ := unstructured.NestedMap(c.Object, "spec")
+	assert.Equal(t, map[string]interface{}{
+		"type":  "LoadBalancer",
+		"ports": []interface{}{map[string]interface{}{"port": int64(80)}},
+	}, spec)
+
+	pp, _, _ := unstructured.NestedSlice(o.Object, "spec", "ports")
+	assert.Equal(t, int64(30080), pp[0].(map[string]interface{})["nodePort"])
+}
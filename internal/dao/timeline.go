@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const maxOwnerDepth = 5
+
+// Timeline tracks events involving a resource and its dependents.
+type Timeline struct {
+	Factory
+
+	uid     types.UID
+	related map[types.UID]bool
+}
+
+// NewTimeline returns a new events timeline for a given resource.
+func NewTimeline(f Factory, o *unstructured.Unstructured) *Timeline {
+	return &Timeline{
+		Factory: f,
+		uid:     o.GetUID(),
+		related: map[types.UID]bool{o.GetUID(): true},
+	}
+}
+
+// Events returns all events involving the resource or its dependents in a
+// given namespace, latest first.
+func (t *Timeline) Events(ns string) ([]*unstructured.Unstructured, error) {
+	oo, err := t.List("v1/events", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	type entry struct {
+		o    *unstructured.Unstructured
+		last time.Time
+	}
+	ee := make([]entry, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var ev v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ev); err != nil {
+			return nil, err
+		}
+		if !t.isRelated(ev.InvolvedObject, 0) {
+			continue
+		}
+		ee = append(ee, entry{o: u, last: render.EventLastSeen(ev)})
+	}
+	sort.SliceStable(ee, func(i, j int) bool {
+		return ee[i].last.After(ee[j].last)
+	})
+
+	res := make([]*unstructured.Unstructured, 0, len(ee))
+	for _, e := range ee {
+		res = append(res, e.o)
+	}
+
+	return res, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// IsRelated checks if a resource is the timeline resource or one of its
+// dependents by walking up its owners.
+func (t *Timeline) isRelated(ref v1.ObjectReference, depth int) bool {
+	if ref.UID != "" {
+		if r, ok := t.related[ref.UID]; ok {
+			return r
+		}
+	}
+	if depth >= maxOwnerDepth {
+		return false
+	}
+
+	o, ok := t.fetch(ref)
+	if !ok {
+		return false
+	}
+	res := false
+	for _, owner := range o.GetOwnerReferences() {
+		if owner.UID == t.uid || t.isRelated(v1.ObjectReference{
+			APIVersion: owner.APIVersion,
+			Kind:       owner.Kind,
+			Namespace:  o.GetNamespace(),
+			Name:       owner.Name,
+			UID:        owner.UID,
+		}, depth+1) {
+			res = true
+			break
+		}
+	}
+	t.related[o.GetUID()] = res
+	if ref.UID != "" {
+		t.related[ref.UID] = res
+	}
+
+	return res
+}
+
+func (t *Timeline) fetch(ref v1.ObjectReference) (*unstructured.Unstructured, bool) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil, false
+	}
+	gvr, meta, ok := gvrForKind(gv, ref.Kind)
+	if !ok {
+		return nil, false
+	}
+	path := ref.Name
+	if meta.Namespaced {
+		path = client.FQN(ref.Namespace, ref.Name)
+	}
+	o, err := t.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, false
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok || (ref.UID != "" && u.GetUID() != ref.UID) {
+		return nil, false
+	}
+
+	return u, true
+}
@@ -0,0 +1,111 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestTimelineEvents(t *testing.T) {
+	defer func(m ResourceMetas) { resMetas = m }(resMetas)
+	resMetas = ResourceMetas{
+		client.NewGVR("apps/v1/deployments"): {Name: "deployments", Kind: "Deployment", Namespaced: true},
+		client.NewGVR("apps/v1/replicasets"): {Name: "replicasets", Kind: "ReplicaSet", Namespaced: true},
+		client.NewGVR("v1/pods"):             {Name: "pods", Kind: "Pod", Namespaced: true},
+	}
+
+	dp := timelineObj("apps/v1", "Deployment", "dp1", "dp-uid")
+	rs := timelineObj("apps/v1", "ReplicaSet", "rs1", "rs-uid", dp)
+	po := timelineObj("v1", "Pod", "po1", "po-uid", rs)
+	other := timelineObj("v1", "Pod", "po2", "po2-uid")
+	now := time.Now()
+	f := timelineFactory{
+		objs: map[string]*unstructured.Unstructured{
+			"apps/v1/deployments:fred/dp1": dp,
+			"apps/v1/replicasets:fred/rs1": rs,
+			"v1/pods:fred/po1":             po,
+			"v1/pods:fred/po2":             other,
+		},
+		events: []runtime.Object{
+			timelineEvent("e1", dp, now.Add(-3*time.Minute)),
+			timelineEvent("e2", po, now.Add(-1*time.Minute)),
+			timelineEvent("e3", other, now),
+			timelineEvent("e4", rs, now.Add(-2*time.Minute)),
+			timelineEvent("e5", timelineObj("v1", "Pod", "gone", "gone-uid"), now),
+		},
+	}
+
+	ee, err := NewTimeline(&f, dp).Events("fred")
+	assert.Nil(t, err)
+	names := make([]string, 0, len(ee))
+	for _, e := range ee {
+		names = append(names, e.GetName())
+	}
+	assert.Equal(t, []string{"e2", "e4", "e1"}, names)
+}
+
+// Helpers...
+
+type timelineFactory struct {
+	Factory
+
+	objs   map[string]*unstructured.Unstructured
+	events []runtime.Object
+}
+
+func (f *timelineFactory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
+	o, ok := f.objs[gvr+":"+path]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return o, nil
+}
+
+func (f *timelineFactory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	return f.events, nil
+}
+
+func timelineObj(apiVersion, kind, name, uid string, owners ...*unstructured.Unstructured) *unstructured.Unstructured {
+	var o unstructured.Unstructured
+	o.SetAPIVersion(apiVersion)
+	o.SetKind(kind)
+	o.SetNamespace("fred")
+	o.SetName(name)
+	o.SetUID(types.UID(uid))
+	rr := make([]metav1.OwnerReference, 0, len(owners))
+	for _, owner := range owners {
+		rr = append(rr, metav1.OwnerReference{
+			APIVersion: owner.GetAPIVersion(),
+			Kind:       owner.GetKind(),
+			Name:       owner.GetName(),
+			UID:        owner.GetUID(),
+		})
+	}
+	o.SetOwnerReferences(rr)
+
+	return &o
+}
+
+func timelineEvent(name string, o *unstructured.Unstructured, last time.Time) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Event",
+		"metadata":   map[string]interface{}{"name": name, "namespace": "fred"},
+		"involvedObject": map[string]interface{}{
+			"apiVersion": o.GetAPIVersion(),
+			"kind":       o.GetKind(),
+			"namespace":  o.GetNamespace(),
+			"name":       o.GetName(),
+			"uid":        string(o.GetUID()),
+		},
+		"lastTimestamp": last.UTC().Format(time.RFC3339),
+	}}
+}
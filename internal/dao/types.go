@@ -29,6 +29,9 @@ type Factory interface {
 	// CanForResource fetch an informer for a given resource if authorized
 	CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error)
 
+	// IsWatched checks if a resource is already tracked by an informer.
+	IsWatched(ns, gvr string) bool
+
 	// WaitForCacheSync synchronize the cache.
 	WaitForCacheSync()
 
@@ -25,4 +25,8 @@ const (
 	KeyApp         ContextKey = "app"
 	KeyStyles      ContextKey = "styles"
 	KeyMetrics     ContextKey = "metrics"
+	KeyHistory     ContextKey = "history"
+	KeyTargetGVR   ContextKey = "targetGVR"
+	KeyLint        ContextKey = "lint"
+	KeyImageScan   ContextKey = "imageScan"
 )
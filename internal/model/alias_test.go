@@ -77,6 +77,9 @@ func (f testFactory) ForResource(ns, gvr string) informers.GenericInformer {
 func (f testFactory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
 	return nil, nil
 }
+func (f testFactory) IsWatched(ns, gvr string) bool {
+	return false
+}
 func (f testFactory) WaitForCacheSync() {}
 func (f testFactory) Forwarders() watch.Forwarders {
 	return nil
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ConfigMapKey represents a configmap keys model.
+type ConfigMapKey struct {
+	Resource
+}
+
+// List returns a collection of configmap keys.
+func (c *ConfigMapKey) List(ctx context.Context) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", c.gvr)
+	}
+	ns, _ := render.Namespaced(path)
+	c.namespace = ns
+	o, err := c.factory.Get("v1/configmaps", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var cm v1.ConfigMap
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &cm)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(cm.Data)+len(cm.BinaryData))
+	for k, v := range cm.Data {
+		res = append(res, render.ConfigMapKeyRes{Key: k, Value: []byte(v)})
+	}
+	for k, v := range cm.BinaryData {
+		res = append(res, render.ConfigMapKeyRes{Key: k, Value: v, Binary: true})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].(render.ConfigMapKeyRes).Key < res[j].(render.ConfigMapKeyRes).Key
+	})
+
+	return res, nil
+}
@@ -67,9 +67,10 @@ func (f podFactory) ForResource(ns, gvr string) informers.GenericInformer { retu
 func (f podFactory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
 	return nil, nil
 }
-func (f podFactory) WaitForCacheSync()            {}
-func (f podFactory) Forwarders() watch.Forwarders { return nil }
-func (f podFactory) DeleteForwarder(string)       {}
+func (f podFactory) IsWatched(ns, gvr string) bool { return false }
+func (f podFactory) WaitForCacheSync()             {}
+func (f podFactory) Forwarders() watch.Forwarders  { return nil }
+func (f podFactory) DeleteForwarder(string)        {}
 
 func makePodFactory() dao.Factory {
 	return podFactory{}
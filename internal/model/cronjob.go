@@ -0,0 +1,43 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// CronJob represents a collection of cronjobs and their runs.
+type CronJob struct {
+	Resource
+}
+
+// List returns a collection of cronjobs.
+func (c *CronJob) List(ctx context.Context) ([]runtime.Object, error) {
+	oo, err := c.Resource.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := dao.CronJobRuns(c.factory, c.namespace)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to list cronjob runs")
+	}
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		res = append(res, &render.CronJobWithRuns{
+			Raw:         u,
+			LastSuccess: dao.LastSuccess(runs[u.GetUID()]),
+		})
+	}
+
+	return res, nil
+}
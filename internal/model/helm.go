@@ -0,0 +1,74 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Helm represents a collection of Helm releases.
+type Helm struct {
+	Resource
+}
+
+// List returns the latest revision of all Helm releases.
+func (h *Helm) List(ctx context.Context) ([]runtime.Object, error) {
+	rr, err := h.helm().Releases(h.namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return helmResources(rr), nil
+}
+
+func (h *Helm) helm() *dao.Helm {
+	var d dao.Helm
+	d.Init(h.factory, client.NewGVR("helm"))
+
+	return &d
+}
+
+// HelmHistory represents a collection of Helm release revisions.
+type HelmHistory struct {
+	Helm
+}
+
+// List returns all revisions of a Helm release.
+func (h *HelmHistory) List(ctx context.Context) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", h.gvr)
+	}
+	rr, err := h.helm().History(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return helmResources(rr), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func helmResources(rr []*dao.HelmRelease) []runtime.Object {
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, render.HelmRes{
+			Name:        r.Name,
+			Namespace:   r.Namespace,
+			Revision:    r.Version,
+			Chart:       r.Chart,
+			AppVersion:  r.AppVersion,
+			Status:      r.Status,
+			Description: r.Description,
+			Updated:     r.Updated,
+		})
+	}
+
+	return oo
+}
@@ -3,6 +3,7 @@ package model
 import (
 	"fmt"
 
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tview"
 	runewidth "github.com/mattn/go-runewidth"
 	"github.com/rs/zerolog/log"
@@ -37,6 +38,20 @@ func extractFQN(o runtime.Object) string {
 	return FQN(ns, n)
 }
 
+// ObjectFQN returns the fully qualified name of a raw or metrics decorated resource.
+func objectFQN(o runtime.Object) string {
+	switch r := o.(type) {
+	case *render.PodWithMetrics:
+		return extractFQN(r.Raw)
+	case *render.NodeWithMetrics:
+		return extractFQN(r.Raw)
+	case *render.PersistentVolumeClaimWithUsage:
+		return extractFQN(r.Raw)
+	default:
+		return extractFQN(o)
+	}
+}
+
 // MetaFQN returns a fully qualified resource name.
 func MetaFQN(m metav1.ObjectMeta) string {
 	if m.Namespace == "" {
@@ -0,0 +1,150 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MaxHPAEvents tracks the number of most recent HPA events to show.
+const maxHPAEvents = 10
+
+// HPADetail represents a HPA replicas, metrics, conditions and events.
+type HPADetail struct {
+	Resource
+}
+
+// List returns a collection of HPA details.
+func (h *HPADetail) List(ctx context.Context) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", h.gvr)
+	}
+
+	hpa, err := dao.FetchHPAInsight(h.factory, path)
+	if err != nil {
+		return nil, err
+	}
+	var ss []client.Sample
+	if history, ok := ctx.Value(internal.KeyHistory).(*client.MetricsHistory); ok && history != nil {
+		ss = history.Samples(client.HPASeries, path)
+	}
+	ee, err := dao.HPAEvents(h.factory, path)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to list HPA events")
+	}
+
+	return hpaDetails(hpa, ss, ee), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func hpaDetails(hpa *dao.HPAInsight, ss []client.Sample, ee []*v1.Event) []runtime.Object {
+	oo := make([]runtime.Object, 0, len(hpa.Metrics)+len(hpa.Conditions)+len(ee)+4)
+	oo = append(oo, hpaReplicas(hpa, ss)...)
+	oo = append(oo, render.HPADetailRes{
+		Section: render.HPASectionTarget,
+		Name:    hpa.TargetKind + "/" + hpa.TargetName,
+		Info:    hpa.TargetAPIVersion,
+	})
+
+	for _, m := range hpa.Metrics {
+		var warn string
+		if m.Over {
+			warn = "above target"
+		}
+		oo = append(oo, render.HPADetailRes{
+			Section: render.HPASectionMetric,
+			Name:    m.Type + ":" + m.Name,
+			Current: m.Current,
+			Target:  m.Target,
+			Warning: warn,
+		})
+	}
+
+	for _, c := range hpa.Conditions {
+		res := render.HPADetailRes{
+			Section: render.HPASectionCondition,
+			Name:    c.Type,
+			Current: c.Status,
+			Info:    c.Message,
+			Since:   c.Since.Time,
+		}
+		if conditionDegraded(c) {
+			res.Warning = c.Reason
+		}
+		oo = append(oo, res)
+	}
+
+	if len(ee) > maxHPAEvents {
+		ee = ee[:maxHPAEvents]
+	}
+	for _, e := range ee {
+		oo = append(oo, render.HPADetailRes{
+			Section: render.HPASectionEvent,
+			Name:    e.Reason,
+			Key:     e.Name,
+			Current: e.Type,
+			Info:    e.Message,
+			Since:   render.EventLastSeen(*e),
+		})
+	}
+
+	return oo
+}
+
+func hpaReplicas(hpa *dao.HPAInsight, ss []client.Sample) []runtime.Object {
+	current := render.HPADetailRes{
+		Section: render.HPASectionReplicas,
+		Name:    "current",
+		Current: strconv.Itoa(int(hpa.Current)),
+		Target:  strconv.Itoa(int(hpa.Desired)),
+	}
+	if hpa.LastScale != nil {
+		current.Since = hpa.LastScale.Time
+	}
+	switch {
+	case hpa.Desired >= hpa.Max:
+		current.Warning = "at max replicas"
+	case hpa.Current < hpa.Min:
+		current.Warning = "below min replicas"
+	}
+	if len(ss) > 0 {
+		rr := make([]float64, len(ss))
+		for i, s := range ss {
+			rr[i] = float64(s.Replicas)
+		}
+		current.Info = render.SparkLine(lastSamples(rr, 30))
+	}
+
+	return []runtime.Object{
+		current,
+		render.HPADetailRes{Section: render.HPASectionReplicas, Name: "min", Current: strconv.Itoa(int(hpa.Min))},
+		render.HPADetailRes{Section: render.HPASectionReplicas, Name: "max", Current: strconv.Itoa(int(hpa.Max))},
+	}
+}
+
+func lastSamples(vv []float64, n int) []float64 {
+	if len(vv) > n {
+		return vv[len(vv)-n:]
+	}
+	return vv
+}
+
+// ConditionDegraded checks if a HPA condition prevents or limits scaling.
+func conditionDegraded(c dao.HPACondition) bool {
+	if c.Type == "ScalingLimited" {
+		return c.Status == string(v1.ConditionTrue)
+	}
+
+	return c.Status == string(v1.ConditionFalse)
+}
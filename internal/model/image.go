@@ -0,0 +1,75 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Image represents the container images running on the cluster.
+type Image struct {
+	Resource
+}
+
+// List returns a collection of images. Images not yet scanned are queued for
+// a vulnerability scan when a scanner is configured.
+func (i *Image) List(ctx context.Context) ([]runtime.Object, error) {
+	cfg, ok := ctx.Value(internal.KeyImageScan).(*config.ImageScan)
+	if !ok {
+		return nil, fmt.Errorf("no image scan config found in context")
+	}
+
+	ns := i.namespace
+	if ns == render.ClusterScope {
+		ns = render.AllNamespaces
+	}
+	ii, err := dao.Images(i.factory, ns)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(ii))
+	for _, img := range ii {
+		res := render.ImageRes{
+			Image:      img.Image,
+			Digest:     img.Digest,
+			Init:       img.Init,
+			Containers: img.Containers,
+			Pods:       len(img.Pods),
+			Namespaces: img.Namespaces,
+			Owners:     img.Owners,
+			ScanStatus: render.ScanDisabled,
+		}
+		if cfg.IsEnabled() {
+			dao.ImageScans.Scan(cfg, img, false)
+			res.Vulns, res.ScanStatus = scanResult(img)
+		}
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func scanResult(img dao.Image) (*render.VulnCounts, string) {
+	s, ok := dao.ImageScans.Result(img)
+	switch {
+	case !ok:
+		return nil, render.ScanPending
+	case s.Err != nil:
+		return nil, render.ScanFailed
+	default:
+		return &render.VulnCounts{
+			Critical: s.Counts["CRITICAL"],
+			High:     s.Counts["HIGH"],
+			Medium:   s.Counts["MEDIUM"],
+			Low:      s.Counts["LOW"],
+		}, render.ScanDone
+	}
+}
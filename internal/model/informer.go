@@ -0,0 +1,37 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// InformerStater represents a factory with informers diagnostics.
+type InformerStater interface {
+	// Stats returns all tracked informers diagnostics.
+	Stats() []watch.InformerStats
+}
+
+// Informer represents an informers diagnostics model.
+type Informer struct {
+	Resource
+}
+
+// List returns a collection of informers diagnostics.
+func (i *Informer) List(ctx context.Context) ([]runtime.Object, error) {
+	st, ok := i.factory.(InformerStater)
+	if !ok {
+		return nil, fmt.Errorf("expecting an informers stater but got %T", i.factory)
+	}
+
+	ss := st.Stats()
+	oo := make([]runtime.Object, 0, len(ss))
+	for _, s := range ss {
+		oo = append(oo, render.InformerRes{Stats: s})
+	}
+
+	return oo, nil
+}
@@ -3,13 +3,18 @@ package model
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -34,27 +39,61 @@ func (c *Job) List(ctx context.Context) ([]runtime.Object, error) {
 	if err != nil {
 		return nil, err
 	}
-	if uid == "" {
-		return oo, nil
-	}
 
 	_, cronName := client.Namespaced(path)
-	jj := make([]runtime.Object, 0, len(oo))
-	for _, j := range oo {
+	jj := make([]*batchv1.Job, 0, len(oo))
+	rr := make([]*unstructured.Unstructured, 0, len(oo))
+	var failed bool
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
 		var job batchv1.Job
-		err = runtime.DefaultUnstructuredConverter.FromUnstructured(j.(*unstructured.Unstructured).Object, &job)
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &job)
 		if err != nil {
 			return nil, err
 		}
-		log.Debug().Msgf("Looking at job %q -- %q", job.Name, cronName)
-		if !isNamedAfter(cronName, job.Name) {
+		if uid != "" && !isNamedAfter(cronName, job.Name) {
 			continue
 		}
-		log.Debug().Msgf("GOT Job %s -- %#v -- %q -- %q", job.Name, job.Labels, uid, path)
-		jj = append(jj, j)
+		failed = failed || job.Status.Failed > 0
+		jj, rr = append(jj, &job), append(rr, u)
+	}
+
+	var pods []*v1.Pod
+	if failed {
+		if pods, err = c.pods(); err != nil {
+			log.Warn().Err(err).Msgf("Unable to list job pods")
+		}
+	}
+	res := make([]runtime.Object, 0, len(jj))
+	for i, job := range jj {
+		res = append(res, &render.JobWithFailure{Raw: rr[i], Failure: dao.JobFailure(job, pods)})
+	}
+
+	return res, nil
+}
+
+func (c *Job) pods() ([]*v1.Pod, error) {
+	oo, err := c.factory.List("v1/pods", c.namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		pp = append(pp, &po)
 	}
 
-	return jj, nil
+	return pp, nil
 }
 
 // ----------------------------------------------------------------------------
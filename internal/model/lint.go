@@ -0,0 +1,63 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Lint represents a collection of cluster lint findings.
+type Lint struct {
+	Resource
+
+	mx     sync.Mutex
+	linter *dao.Linter
+}
+
+// List runs the enabled lint rules and returns their findings.
+func (l *Lint) List(ctx context.Context) ([]runtime.Object, error) {
+	cfg, ok := ctx.Value(internal.KeyLint).(*config.Lint)
+	if !ok {
+		return nil, fmt.Errorf("no lint config found in context")
+	}
+
+	ff, err := l.linterFor(l.factory).Lint(l.namespace, cfg)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(ff))
+	for _, f := range ff {
+		ns, n := client.Namespaced(f.Path)
+		oo = append(oo, render.LintRes{
+			Namespace: ns,
+			Name:      n,
+			GVR:       f.GVR.String(),
+			Rule:      f.Rule,
+			Severity:  f.Severity.String(),
+			Message:   f.Message,
+			Score:     f.Score,
+		})
+	}
+
+	return oo, nil
+}
+
+// LinterFor returns a linter for the given factory. Linters are kept across
+// refreshes so unchanged resources are not linted again.
+func (l *Lint) linterFor(f dao.Factory) *dao.Linter {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if l.linter == nil || l.linter.Factory != f {
+		l.linter = dao.NewLinter(f)
+	}
+
+	return l.linter
+}
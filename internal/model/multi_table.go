@@ -0,0 +1,257 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+// ContextSep separates a context from a resource path in multi contexts row ids.
+const ContextSep = "@"
+
+// MultiTable represents a table model aggregating a resource across contexts.
+type MultiTable struct {
+	gvr         string
+	namespace   string
+	factories   map[string]dao.Factory
+	failed      map[string]error
+	tables      map[string]*Table
+	data        *render.TableData
+	listeners   []TableListener
+	inUpdate    int32
+	refreshRate time.Duration
+}
+
+// NewMultiTable returns a new multi contexts table model.
+func NewMultiTable(gvr string, ff map[string]dao.Factory) *MultiTable {
+	t := MultiTable{
+		gvr:         gvr,
+		factories:   ff,
+		failed:      make(map[string]error),
+		tables:      make(map[string]*Table, len(ff)),
+		data:        render.NewTableData(),
+		refreshRate: refreshRate,
+	}
+	for c := range ff {
+		t.tables[c] = NewTable(gvr)
+	}
+
+	return &t
+}
+
+// ContextPath returns the context and resource path from a multi contexts row id.
+func ContextPath(id string) (string, string) {
+	i := strings.LastIndex(id, ContextSep)
+	if i < 0 {
+		return "", id
+	}
+
+	return id[:i], id[i+1:]
+}
+
+// Contexts returns the table contexts.
+func (t *MultiTable) Contexts() []string {
+	cc := make([]string, 0, len(t.factories))
+	for c := range t.factories {
+		cc = append(cc, c)
+	}
+	sort.Strings(cc)
+
+	return cc
+}
+
+// FactoryFor returns the factory for a given context.
+func (t *MultiTable) FactoryFor(context string) (dao.Factory, bool) {
+	f, ok := t.factories[context]
+	return f, ok
+}
+
+// SetFailed records a context that could not be connected to. Failed
+// contexts are shown as error rows.
+func (t *MultiTable) SetFailed(context string, err error) {
+	t.failed[context] = err
+}
+
+// Watch initiates model updates.
+func (t *MultiTable) Watch(ctx context.Context) {
+	t.refresh(ctx)
+	go t.updater(ctx)
+}
+
+// Refresh update the model now.
+func (t *MultiTable) Refresh(ctx context.Context) {
+	t.refresh(ctx)
+}
+
+// GetNamespace returns the model namespace.
+func (t *MultiTable) GetNamespace() string {
+	return t.namespace
+}
+
+// SetNamespace sets up model namespace.
+func (t *MultiTable) SetNamespace(ns string) {
+	t.namespace = ns
+	for _, tt := range t.tables {
+		tt.SetNamespace(ns)
+	}
+	t.data.Clear()
+}
+
+// SetRefreshRate sets model refresh duration.
+func (t *MultiTable) SetRefreshRate(d time.Duration) {
+	t.refreshRate = d
+}
+
+// ClusterWide always returns true as the leading column tracks the context.
+func (t *MultiTable) ClusterWide() bool {
+	return true
+}
+
+// InNamespace checks if current namespace matches desired namespace.
+func (t *MultiTable) InNamespace(ns string) bool {
+	return t.namespace == ns
+}
+
+// Empty return true if no model data.
+func (t *MultiTable) Empty() bool {
+	return len(t.data.RowEvents) == 0
+}
+
+// Peek returns model data.
+func (t *MultiTable) Peek() render.TableData {
+	return *t.data
+}
+
+// AddListener adds a new model listener.
+func (t *MultiTable) AddListener(l TableListener) {
+	t.listeners = append(t.listeners, l)
+}
+
+func (t *MultiTable) updater(ctx context.Context) {
+	defer log.Debug().Msgf("Multi model canceled -- %q", t.gvr)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(t.refreshRate):
+			t.refresh(ctx)
+		}
+	}
+}
+
+func (t *MultiTable) refresh(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&t.inUpdate, 0, 1) {
+		log.Debug().Msgf("Dropping update...")
+		return
+	}
+	defer atomic.StoreInt32(&t.inUpdate, 0)
+
+	if err := t.reconcile(ctx); err != nil {
+		log.Error().Err(err).Msg("Multi reconcile failed")
+		for _, l := range t.listeners {
+			l.TableLoadFailed(err)
+		}
+	}
+	for _, l := range t.listeners {
+		l.TableDataChanged(*t.data)
+	}
+}
+
+func (t *MultiTable) reconcile(ctx context.Context) error {
+	var (
+		rows   render.Rows
+		header render.HeaderRow
+		errs   []string
+	)
+	for _, c := range t.Contexts() {
+		tt := t.tables[c]
+		if err := tt.reconcile(t.contextFor(ctx, c)); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", c, err))
+			continue
+		}
+		data := tt.Peek()
+		data.Mutex.RLock()
+		header = data.Header
+		for _, re := range data.RowEvents {
+			rows = append(rows, render.Row{
+				ID:     c + ContextSep + re.Row.ID,
+				Fields: append(render.Fields{c}, re.Row.Fields...),
+			})
+		}
+		data.Mutex.RUnlock()
+	}
+
+	if header == nil && len(t.failed) > 0 {
+		header = render.HeaderRow{render.Header{Name: "ERROR"}}
+	}
+	rows = append(rows, t.failedRows(len(header))...)
+
+	t.data.Mutex.Lock()
+	defer t.data.Mutex.Unlock()
+	t.data.Update(rows)
+	t.data.Namespace = t.namespace
+	if header != nil {
+		t.data.Header = append(render.HeaderRow{render.Header{Name: "CONTEXT"}}, header...)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// FailedRows returns an error row per failed context. Error rows have no
+// resource path.
+func (t *MultiTable) failedRows(cols int) render.Rows {
+	cc := make([]string, 0, len(t.failed))
+	for c := range t.failed {
+		cc = append(cc, c)
+	}
+	sort.Strings(cc)
+
+	rows := make(render.Rows, 0, len(cc))
+	for _, c := range cc {
+		ff := make(render.Fields, cols+1)
+		ff[0], ff[1] = c, t.failed[c].Error()
+		rows = append(rows, render.Row{ID: c + ContextSep, Fields: ff})
+	}
+
+	return rows
+}
+
+func (t *MultiTable) contextFor(ctx context.Context, c string) context.Context {
+	f := t.factories[c]
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+	if f.Client() == nil || !f.Client().HasMetrics() {
+		return ctx
+	}
+
+	mx := client.NewMetricsServer(f.Client())
+	switch t.gvr {
+	case "v1/pods":
+		pmx, err := mx.FetchPodsMetrics(t.namespace)
+		if err != nil {
+			log.Warn().Err(err).Msgf("No pods metrics for context %q", c)
+			return ctx
+		}
+		return context.WithValue(ctx, internal.KeyMetrics, pmx)
+	case "v1/nodes":
+		nmx, err := mx.FetchNodesMetrics()
+		if err != nil {
+			log.Warn().Err(err).Msgf("No nodes metrics for context %q", c)
+			return ctx
+		}
+		return context.WithValue(ctx, internal.KeyMetrics, nmx)
+	default:
+		return ctx
+	}
+}
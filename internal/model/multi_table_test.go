@@ -0,0 +1,105 @@
+package model_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestMultiTableRefresh(t *testing.T) {
+	m := model.NewMultiTable("v1/namespaces", map[string]dao.Factory{
+		"prod":    nsFactory{names: []string{"fred", "blee"}},
+		"staging": nsFactory{names: []string{"fred"}},
+	})
+	m.SetNamespace(render.ClusterScope)
+	m.Refresh(context.Background())
+
+	data := m.Peek()
+	assert.True(t, m.ClusterWide())
+	assert.Equal(t, []string{"prod", "staging"}, m.Contexts())
+	assert.Equal(t, "CONTEXT", data.Header[0].Name)
+	assert.Equal(t, "NAME", data.Header[1].Name)
+	assert.Equal(t, 3, len(data.RowEvents))
+	assert.Equal(t, "staging@fred", data.RowEvents[2].Row.ID)
+	assert.Equal(t, "staging", data.RowEvents[2].Row.Fields[0])
+	assert.Equal(t, "fred", data.RowEvents[2].Row.Fields[1])
+}
+
+func TestMultiTableFailed(t *testing.T) {
+	m := model.NewMultiTable("v1/namespaces", map[string]dao.Factory{
+		"prod": nsFactory{names: []string{"fred"}},
+	})
+	m.SetNamespace(render.ClusterScope)
+	m.SetFailed("staging", errors.New("boom"))
+	m.Refresh(context.Background())
+
+	data := m.Peek()
+	assert.Equal(t, 2, len(data.RowEvents))
+	assert.Equal(t, "staging@", data.RowEvents[1].Row.ID)
+	assert.Equal(t, "staging", data.RowEvents[1].Row.Fields[0])
+	assert.Equal(t, "boom", data.RowEvents[1].Row.Fields[1])
+	assert.Equal(t, len(data.Header), len(data.RowEvents[1].Row.Fields))
+}
+
+func TestMultiTableAllFailed(t *testing.T) {
+	m := model.NewMultiTable("v1/namespaces", map[string]dao.Factory{})
+	m.SetNamespace(render.ClusterScope)
+	m.SetFailed("prod", errors.New("boom"))
+	m.Refresh(context.Background())
+
+	data := m.Peek()
+	assert.Equal(t, render.HeaderRow{{Name: "CONTEXT"}, {Name: "ERROR"}}, data.Header)
+	assert.Equal(t, render.Fields{"prod", "boom"}, data.RowEvents[0].Row.Fields)
+}
+
+func TestContextPath(t *testing.T) {
+	uu := map[string]struct {
+		id, ctx, path string
+	}{
+		"plain":   {"prod@default/fred", "prod", "default/fred"},
+		"user":    {"admin@prod@default/fred", "admin@prod", "default/fred"},
+		"cluster": {"prod@fred", "prod", "fred"},
+		"none":    {"default/fred", "", "default/fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx, path := model.ContextPath(u.id)
+			assert.Equal(t, u.ctx, ctx)
+			assert.Equal(t, u.path, path)
+		})
+	}
+}
+
+// Helpers...
+
+type nsFactory struct {
+	testFactory
+	names []string
+}
+
+func (f nsFactory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	oo := make([]runtime.Object, 0, len(f.names))
+	for _, n := range f.names {
+		oo = append(oo, &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata": map[string]interface{}{
+				"name":              n,
+				"creationTimestamp": "2020-01-01T00:00:00Z",
+			},
+			"status": map[string]interface{}{"phase": "Active"},
+		}})
+	}
+
+	return oo, nil
+}
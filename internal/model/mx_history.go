@@ -0,0 +1,37 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MetricsHistory represents a metrics history model.
+type MetricsHistory struct {
+	Resource
+}
+
+// List returns a collection of metrics series summaries.
+func (m *MetricsHistory) List(ctx context.Context) ([]runtime.Object, error) {
+	h, ok := ctx.Value(internal.KeyHistory).(*client.MetricsHistory)
+	if !ok {
+		return nil, errors.New("no metrics history found in context")
+	}
+
+	var oo []runtime.Object
+	for _, kind := range []string{client.NodeSeries, client.PodSeries} {
+		for _, fqn := range h.Keys(kind) {
+			st, ok := h.Stats(kind, fqn)
+			if !ok {
+				continue
+			}
+			oo = append(oo, render.MetricsHistoryRes{Kind: kind, FQN: fqn, Stats: st})
+		}
+	}
+
+	return oo, nil
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
@@ -37,6 +39,7 @@ func (n *Node) List(ctx context.Context) ([]runtime.Object, error) {
 		return nil, err
 	}
 
+	req, lim := n.allocations()
 	oo := make([]runtime.Object, len(nn.Items))
 	for i, no := range nn.Items {
 		o, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&nn.Items[i])
@@ -44,8 +47,10 @@ func (n *Node) List(ctx context.Context) ([]runtime.Object, error) {
 			return nil, err
 		}
 		oo[i] = &render.NodeWithMetrics{
-			Raw: &unstructured.Unstructured{Object: o},
-			MX:  nodeMetricsFor(MetaFQN(no.ObjectMeta), nmx),
+			Raw:      &unstructured.Unstructured{Object: o},
+			MX:       nodeMetricsFor(MetaFQN(no.ObjectMeta), nmx),
+			Requests: req[no.Name],
+			Limits:   lim[no.Name],
 		}
 	}
 
@@ -77,7 +82,63 @@ func (n *Node) Hydrate(oo []runtime.Object, rr render.Rows, re Renderer) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// Allocations sums up active pods requests and limits per node. Pods are read
+// off the informer cache only, allocations are skipped when pods can't be watched.
+func (n *Node) allocations() (map[string]v1.ResourceList, map[string]v1.ResourceList) {
+	req, lim := make(map[string]v1.ResourceList), make(map[string]v1.ResourceList)
+	inf, err := n.factory.CanForResource(render.AllNamespaces, "v1/pods", []string{"list", "watch"})
+	if err != nil {
+		log.Warn().Err(err).Msg("Unable to compute nodes allocations")
+		return req, lim
+	}
+	if inf == nil || !inf.Informer().HasSynced() {
+		log.Debug().Msg("No pods cache available for nodes allocations")
+		return req, lim
+	}
+	oo, err := inf.Lister().List(labels.Everything())
+	if err != nil {
+		log.Warn().Err(err).Msg("Unable to compute nodes allocations")
+		return req, lim
+	}
+
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			continue
+		}
+		if po.Spec.NodeName == "" || po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		r, l := render.PodResources(&po)
+		req[po.Spec.NodeName] = addResourceList(req[po.Spec.NodeName], r)
+		lim[po.Spec.NodeName] = addResourceList(lim[po.Spec.NodeName], l)
+	}
+
+	return req, lim
+}
+
+func addResourceList(acc, rl v1.ResourceList) v1.ResourceList {
+	if acc == nil {
+		acc = make(v1.ResourceList, len(rl))
+	}
+	for k, q := range rl {
+		if v, ok := acc[k]; ok {
+			q.Add(v)
+		}
+		acc[k] = q
+	}
+
+	return acc
+}
+
 func nodeMetricsFor(fqn string, mmx *mv1beta1.NodeMetricsList) *mv1beta1.NodeMetrics {
+	if mmx == nil {
+		return nil
+	}
 	for _, mx := range mmx.Items {
 		if MetaFQN(mx.ObjectMeta) == fqn {
 			return &mx
@@ -0,0 +1,217 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// NodeDetail represents a node conditions, taints, labels and allocations.
+type NodeDetail struct {
+	Resource
+}
+
+// List returns a collection of node details.
+func (n *NodeDetail) List(ctx context.Context) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", n.gvr)
+	}
+
+	_, name := client.Namespaced(path)
+	o, err := n.factory.Get("v1/nodes", client.FQN(render.ClusterScope, name), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	var no v1.Node
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &no); err != nil {
+		return nil, err
+	}
+	pp, err := dao.NodePods(n.factory, no.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		nmx *mv1beta1.NodeMetrics
+		pmx *mv1beta1.PodMetricsList
+	)
+	if n.factory.Client() != nil {
+		mx := client.NewMetricsServer(n.factory.Client())
+		nn, err := mx.FetchNodesMetrics()
+		if err != nil {
+			log.Warn().Err(err).Msgf("No node metrics")
+		}
+		nmx = nodeMetricsFor(no.Name, nn)
+		if pmx, err = mx.FetchPodsMetrics(render.AllNamespaces); err != nil {
+			log.Warn().Err(err).Msgf("No pod metrics")
+		}
+	}
+
+	return nodeDetails(&no, pp, nmx, pmx), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func nodeDetails(no *v1.Node, pp []*v1.Pod, nmx *mv1beta1.NodeMetrics, pmx *mv1beta1.PodMetricsList) []runtime.Object {
+	oo := make([]runtime.Object, 0, len(no.Status.Conditions)+len(no.Spec.Taints)+len(no.Labels)+len(pp)+4)
+	oo = append(oo, nodeCapacity(no, pp, nmx)...)
+
+	var pressures []string
+	for _, c := range no.Status.Conditions {
+		if c.Type != v1.NodeReady && c.Status == v1.ConditionTrue {
+			pressures = append(pressures, string(c.Type))
+		}
+		info := c.Reason
+		if c.Message != "" {
+			info += ": " + c.Message
+		}
+		oo = append(oo, render.NodeDetailRes{
+			Section: render.NodeSectionCondition,
+			Name:    string(c.Type),
+			Value:   string(c.Status),
+			Info:    info,
+			Since:   c.LastTransitionTime.Time,
+		})
+	}
+	pressure := render.NodeDetailRes{Section: render.NodeSectionCapacity, Name: "pressure", Value: "none"}
+	if len(pressures) > 0 {
+		pressure.Value = strings.Join(pressures, ",")
+		pressure.Warning = "node under pressure"
+	}
+	oo = append(oo, pressure)
+
+	for _, t := range no.Spec.Taints {
+		d := render.NodeDetailRes{
+			Section: render.NodeSectionTaint,
+			Name:    t.Key + ":" + string(t.Effect),
+			Value:   t.Value,
+		}
+		if t.TimeAdded != nil {
+			d.Since = t.TimeAdded.Time
+		}
+		oo = append(oo, d)
+	}
+	for _, k := range sortedKeys(no.Labels) {
+		oo = append(oo, render.NodeDetailRes{Section: render.NodeSectionLabel, Name: k, Value: no.Labels[k]})
+	}
+
+	usage := podsUsage(pmx)
+	for _, po := range pp {
+		req, lim := render.PodResources(po)
+		fqn := client.FQN(po.Namespace, po.Name)
+		oo = append(oo, render.NodeDetailRes{
+			Section: render.NodeSectionPod,
+			Name:    fqn,
+			Value:   string(po.Status.Phase),
+			Alloc:   &render.NodeAlloc{Requests: req, Limits: lim, Usage: usage[fqn]},
+			Info:    "qos " + string(po.Status.QOSClass),
+			Since:   po.CreationTimestamp.Time,
+		})
+	}
+
+	return oo
+}
+
+func nodeCapacity(no *v1.Node, pp []*v1.Pod, nmx *mv1beta1.NodeMetrics) []runtime.Object {
+	alloc := render.NodeAlloc{Requests: v1.ResourceList{}, Limits: v1.ResourceList{}}
+	for _, po := range pp {
+		req, lim := render.PodResources(po)
+		alloc.Requests = addResourceList(alloc.Requests, req)
+		alloc.Limits = addResourceList(alloc.Limits, lim)
+	}
+	if nmx != nil {
+		alloc.Usage = nmx.Usage
+	}
+	acpu, amem := no.Status.Allocatable.Cpu(), no.Status.Allocatable.Memory()
+	allocated := render.NodeDetailRes{
+		Section: render.NodeSectionCapacity,
+		Name:    "allocated",
+		Value:   fmt.Sprintf("cpu %dm mem %sMi", acpu.MilliValue(), render.ToMi(render.ToMB(amem.Value()))),
+		Alloc:   &alloc,
+		Info:    "allocatable",
+	}
+	if overCommitted(alloc.Limits, v1.ResourceCPU, acpu) || overCommitted(alloc.Limits, v1.ResourceMemory, amem) {
+		allocated.Warning = "limits overcommitted"
+	}
+
+	max := no.Status.Allocatable.Pods().Value()
+	pods := render.NodeDetailRes{
+		Section: render.NodeSectionCapacity,
+		Name:    "pods",
+		Value:   fmt.Sprintf("%d/%d", len(pp), max),
+		Info:    "running/max",
+	}
+	if max > 0 && int64(len(pp)) >= max {
+		pods.Warning = "max pods reached"
+	}
+
+	sched := render.NodeDetailRes{
+		Section: render.NodeSectionCapacity,
+		Name:    "schedulable",
+		Value:   "true",
+	}
+	if no.Spec.Unschedulable {
+		sched.Value, sched.Warning = "false", "node is cordoned"
+	}
+
+	return []runtime.Object{allocated, pods, sched}
+}
+
+func overCommitted(rl v1.ResourceList, n v1.ResourceName, alloc *resource.Quantity) bool {
+	q, ok := rl[n]
+	if !ok || alloc.IsZero() {
+		return false
+	}
+
+	return q.Cmp(*alloc) > 0
+}
+
+// PodsUsage sums up pods containers usage keyed by pod path.
+func podsUsage(pmx *mv1beta1.PodMetricsList) map[string]v1.ResourceList {
+	uu := make(map[string]v1.ResourceList)
+	if pmx == nil {
+		return uu
+	}
+	for _, mx := range pmx.Items {
+		var rl v1.ResourceList
+		for _, co := range mx.Containers {
+			rl = addResourceList(rl, co.Usage)
+		}
+		if rl == nil {
+			rl = v1.ResourceList{}
+		}
+		uu[MetaFQN(mx.ObjectMeta)] = rl
+	}
+
+	return uu
+}
+
+// SortedKeys returns a map keys in order.
+func sortedKeys(m map[string]string) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
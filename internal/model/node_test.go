@@ -37,6 +37,10 @@ func TestNodeHydrate(t *testing.T) {
 		"n/a",
 		"n/a",
 		"n/a",
+		"n/a",
+		"n/a",
+		"n/a",
+		"n/a",
 	}, rr[0].Fields[:len(rr[0].Fields)-1])
 }
 
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -14,6 +15,9 @@ import (
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
+// TrendSamples caps the number of samples shown in a pod cpu trend.
+const trendSamples = 10
+
 // Pod represents a pod model.
 type Pod struct {
 	Resource
@@ -34,6 +38,7 @@ func (p *Pod) List(ctx context.Context) ([]runtime.Object, error) {
 	if !ok {
 		log.Warn().Msgf("expecting context PodMetricsList")
 	}
+	history, _ := ctx.Value(internal.KeyHistory).(*client.MetricsHistory)
 
 	sel, ok := ctx.Value(internal.KeyFields).(string)
 	if !ok {
@@ -52,7 +57,7 @@ func (p *Pod) List(ctx context.Context) ([]runtime.Object, error) {
 			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
 		}
 		if nodeName == "" {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx)})
+			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx), Trend: podTrend(o, history)})
 			continue
 		}
 
@@ -61,13 +66,43 @@ func (p *Pod) List(ctx context.Context) ([]runtime.Object, error) {
 			return res, fmt.Errorf("expecting interface map but got `%T", o)
 		}
 		if spec["nodeName"] == nodeName {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx)})
+			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx), Trend: podTrend(o, history)})
 		}
 	}
 
 	return res, nil
 }
 
+// Get returns a given pod or nil if it is gone or not on the model node.
+func (p *Pod) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := p.get(ctx, path)
+	if err != nil || o == nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	sel, ok := ctx.Value(internal.KeyFields).(string)
+	if !ok {
+		return o, nil
+	}
+	fsel, err := labels.ConvertSelectorToLabelsMap(sel)
+	if err != nil {
+		return nil, err
+	}
+	if nodeName := fsel["spec.nodeName"]; nodeName != "" {
+		if n, _, _ := unstructured.NestedString(u.Object, "spec", "nodeName"); n != nodeName {
+			return nil, nil
+		}
+	}
+	pmx, _ := ctx.Value(internal.KeyMetrics).(*mv1beta1.PodMetricsList)
+	history, _ := ctx.Value(internal.KeyHistory).(*client.MetricsHistory)
+
+	return &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx), Trend: podTrend(o, history)}, nil
+}
+
 // Hydrate returns pod resources as rows.
 func (p *Pod) Hydrate(oo []runtime.Object, rr render.Rows, re Renderer) error {
 	defer func(t time.Time) {
@@ -94,7 +129,27 @@ func (p *Pod) Hydrate(oo []runtime.Object, rr render.Rows, re Renderer) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// PodTrend returns a pod most recent cpu samples.
+func podTrend(o runtime.Object, h *client.MetricsHistory) []float64 {
+	if h == nil {
+		return nil
+	}
+	ss := h.Samples(client.PodSeries, extractFQN(o))
+	if len(ss) > trendSamples {
+		ss = ss[len(ss)-trendSamples:]
+	}
+	vv := make([]float64, len(ss))
+	for i, s := range ss {
+		vv[i] = float64(s.CPU)
+	}
+
+	return vv
+}
+
 func podMetricsFor(o runtime.Object, mmx *mv1beta1.PodMetricsList) *mv1beta1.PodMetrics {
+	if mmx == nil {
+		return nil
+	}
 	fqn := extractFQN(o)
 	for _, mx := range mmx.Items {
 		if MetaFQN(mx.ObjectMeta) == fqn {
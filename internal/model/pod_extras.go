@@ -0,0 +1,47 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PodExtras represents a pod additional metrics model.
+type PodExtras struct {
+	Resource
+}
+
+// List returns a collection of pod additional metrics.
+func (p *PodExtras) List(ctx context.Context) ([]runtime.Object, error) {
+	mx := client.NewMetricsServer(p.factory.Client())
+	f, ok := mx.Provider().(client.ExtrasFetcher)
+	if !ok {
+		return nil, errors.New("additional pod metrics require a prometheus metrics provider")
+	}
+
+	pmx, err := mx.FetchPodsMetrics(p.namespace)
+	if err != nil {
+		return nil, err
+	}
+	extras, err := f.FetchPodsExtras(p.namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	mmx := make(client.PodsMetrics, len(pmx.Items))
+	mx.PodsMetrics(pmx, mmx)
+	oo := make([]runtime.Object, 0, len(mmx))
+	for fqn, m := range mmx {
+		oo = append(oo, render.PodExtrasRes{
+			FQN:    fqn,
+			CPU:    m.CurrentCPU,
+			MEM:    m.CurrentMEM,
+			Extras: extras[fqn],
+		})
+	}
+
+	return oo, nil
+}
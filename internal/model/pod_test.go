@@ -27,11 +27,18 @@ func TestPodHydrate(t *testing.T) {
 		"0",
 		"n/a",
 		"n/a",
+		"200",
+		"20",
+		"200",
+		"20",
+		"n/a",
+		"n/a",
 		"n/a",
 		"n/a",
 		"10.44.0.229",
 		"gke-k9s-default-pool-0fa2fb89-lbtf",
 		"GA",
+		"n/a",
 	}, rr[0].Fields[:len(rr[0].Fields)-1])
 }
 
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PersistentVolumeClaim represents a collection of claims and their consumers.
+type PersistentVolumeClaim struct {
+	Resource
+}
+
+// List returns a collection of claims with their consuming pods and usage.
+func (p *PersistentVolumeClaim) List(ctx context.Context) ([]runtime.Object, error) {
+	oo, err := p.Resource.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cc, err := dao.ClaimConsumers(p.factory, p.namespace)
+	if err != nil {
+		log.Warn().Err(err).Msg("Claims consumers unavailable")
+	}
+
+	stats := make(map[string]map[string]dao.VolumeStats)
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		pvc := render.PersistentVolumeClaimWithUsage{Raw: u}
+		fqn := extractFQN(u)
+		var nodes []string
+		for _, po := range cc[fqn] {
+			pvc.Pods = append(pvc.Pods, po.Name)
+			if po.Spec.NodeName != "" && !inList(nodes, po.Spec.NodeName) {
+				nodes = append(nodes, po.Spec.NodeName)
+			}
+		}
+		sort.Strings(pvc.Pods)
+		sort.Strings(nodes)
+		pvc.Node = strings.Join(nodes, ",")
+		for _, n := range nodes {
+			if pvc.Usage = p.usage(stats, n, fqn); pvc.Usage != nil {
+				break
+			}
+		}
+		res = append(res, &pvc)
+	}
+
+	return res, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (p *PersistentVolumeClaim) usage(stats map[string]map[string]dao.VolumeStats, node, fqn string) *render.VolumeUsage {
+	ss, ok := stats[node]
+	if !ok {
+		var err error
+		if ss, err = dao.NodeVolumeStats(p.factory.Client(), node); err != nil {
+			log.Debug().Err(err).Msgf("No volume stats for node %q", node)
+		}
+		stats[node] = ss
+	}
+	st, ok := ss[fqn]
+	if !ok {
+		return nil
+	}
+
+	return &render.VolumeUsage{
+		Used:       st.Used,
+		Available:  st.Available,
+		Inodes:     st.Inodes,
+		InodesUsed: st.InodesUsed,
+	}
+}
+
+func inList(ll []string, s string) bool {
+	for _, l := range ll {
+		if l == s {
+			return true
+		}
+	}
+
+	return false
+}
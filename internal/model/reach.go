@@ -0,0 +1,44 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Reach represents the pods a given pod can reach or be reached from.
+type Reach struct {
+	Resource
+}
+
+// List returns a collection of reachability paths.
+func (r *Reach) List(ctx context.Context) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", r.gvr)
+	}
+
+	pp, err := dao.NewReachability(r.factory).Reach(path)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(pp))
+	for _, p := range pp {
+		ns, n := client.Namespaced(p.Peer)
+		oo = append(oo, render.ReachRes{
+			Direction: p.Direction,
+			Namespace: ns,
+			Name:      n,
+			Ports:     p.Ports,
+			Allowed:   p.Allowed,
+			Reason:    p.Reason,
+		})
+	}
+
+	return oo, nil
+}
@@ -12,6 +12,10 @@ var Registry = map[string]ResourceMeta{
 		Model:    &Container{},
 		Renderer: &render.Container{},
 	},
+	"configmapkeys": {
+		Model:    &ConfigMapKey{},
+		Renderer: &render.ConfigMapKey{},
+	},
 	"contexts": {
 		Model:    &Context{},
 		Renderer: &render.Context{},
@@ -20,6 +24,42 @@ var Registry = map[string]ResourceMeta{
 		Model:    &ScreenDump{},
 		Renderer: &render.ScreenDump{},
 	},
+	"templates": {
+		Model:    &Template{},
+		Renderer: &render.Template{},
+	},
+	"helm": {
+		Model:    &Helm{},
+		Renderer: &render.Helm{},
+	},
+	"helmhistory": {
+		Model:    &HelmHistory{},
+		Renderer: &render.HelmHistory{},
+	},
+	"timeline": {
+		Model:    &Timeline{},
+		Renderer: &render.Timeline{},
+	},
+	"lint": {
+		Model:    &Lint{},
+		Renderer: &render.Lint{},
+	},
+	"reach": {
+		Model:    &Reach{},
+		Renderer: &render.Reach{},
+	},
+	"images": {
+		Model:    &Image{},
+		Renderer: &render.Image{},
+	},
+	"nodedetails": {
+		Model:    &NodeDetail{},
+		Renderer: &render.NodeDetail{},
+	},
+	"hpadetails": {
+		Model:    &HPADetail{},
+		Renderer: &render.HPADetail{},
+	},
 	"rbac": {
 		Model:    &Rbac{},
 		Renderer: &render.Rbac{},
@@ -48,6 +88,18 @@ var Registry = map[string]ResourceMeta{
 		Model:    &Alias{},
 		Renderer: &render.Alias{},
 	},
+	"metricshistory": {
+		Model:    &MetricsHistory{},
+		Renderer: &render.MetricsHistory{},
+	},
+	"podextras": {
+		Model:    &PodExtras{},
+		Renderer: &render.PodExtras{},
+	},
+	"informers": {
+		Model:    &Informer{},
+		Renderer: &render.Informer{},
+	},
 
 	// Core...
 	"v1/configmaps": {
@@ -70,6 +122,13 @@ var Registry = map[string]ResourceMeta{
 		Model:    &Node{},
 		Renderer: &render.Node{},
 	},
+	"v1/persistentvolumeclaims": {
+		Model:    &PersistentVolumeClaim{},
+		Renderer: &render.PersistentVolumeClaim{},
+	},
+	"v1/persistentvolumes": {
+		Renderer: &render.PersistentVolume{},
+	},
 	"v1/secrets": {
 		Renderer: &render.Secret{},
 	},
@@ -107,6 +166,7 @@ var Registry = map[string]ResourceMeta{
 
 	// Batch...
 	"batch/v1beta1/cronjobs": {
+		Model:    &CronJob{},
 		Renderer: &render.CronJob{},
 	},
 	"batch/v1/jobs": {
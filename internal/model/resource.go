@@ -6,6 +6,8 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -32,6 +34,35 @@ func (r *Resource) List(ctx context.Context) ([]runtime.Object, error) {
 	return r.factory.List(r.gvr, r.namespace, true, lsel)
 }
 
+// Get returns a given resource off the informers store or nil if it was
+// deleted or no longer matches the model labels.
+func (r *Resource) get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := r.factory.Get(r.gvr, path, false, labels.Everything())
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil || o == nil {
+		return nil, err
+	}
+	strLabel, ok := ctx.Value(internal.KeyLabels).(string)
+	if !ok || strLabel == "" {
+		return o, nil
+	}
+	sel, err := labels.ConvertSelectorToLabelsMap(strLabel)
+	if err != nil {
+		return o, nil
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return nil, err
+	}
+	if !sel.AsSelector().Matches(labels.Set(m.GetLabels())) {
+		return nil, nil
+	}
+
+	return o, nil
+}
+
 // Hydrate renders all rows.
 func (r *Resource) Hydrate(oo []runtime.Object, rr render.Rows, re Renderer) error {
 	for i, o := range oo {
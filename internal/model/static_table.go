@@ -0,0 +1,55 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+)
+
+// StaticTable represents a read-only table model.
+type StaticTable struct {
+	data render.TableData
+}
+
+// NewStaticTable returns a new read-only table model.
+func NewStaticTable(data render.TableData) *StaticTable {
+	return &StaticTable{data: data}
+}
+
+// Empty return true if no model data.
+func (t *StaticTable) Empty() bool {
+	return len(t.data.RowEvents) == 0
+}
+
+// Peek returns model data.
+func (t *StaticTable) Peek() render.TableData {
+	return t.data
+}
+
+// ClusterWide checks if resource is scope for all namespaces.
+func (t *StaticTable) ClusterWide() bool {
+	return t.data.Namespace == render.AllNamespaces
+}
+
+// GetNamespace returns the model namespace.
+func (t *StaticTable) GetNamespace() string {
+	return t.data.Namespace
+}
+
+// SetNamespace is a noop for a static table.
+func (*StaticTable) SetNamespace(string) {}
+
+// InNamespace checks if current namespace matches desired namespace.
+func (t *StaticTable) InNamespace(ns string) bool {
+	return t.data.Namespace == ns
+}
+
+// Watch is a noop for a static table.
+func (*StaticTable) Watch(context.Context) {}
+
+// SetRefreshRate is a noop for a static table.
+func (*StaticTable) SetRefreshRate(time.Duration) {}
+
+// AddListener is a noop for a static table.
+func (*StaticTable) AddListener(TableListener) {}
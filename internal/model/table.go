@@ -3,10 +3,12 @@ package model
 import (
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
@@ -16,8 +18,26 @@ import (
 const (
 	refreshRate = 2 * time.Second
 	noDataCount = 2
+	// ResyncRate represents how often event driven models fully re-render.
+	resyncRate = 30 * time.Second
+	// EventBatch represents how long resource events get coalesced.
+	eventBatch = 250 * time.Millisecond
 )
 
+// Notifier represents a factory that notifies on resource changes.
+type Notifier interface {
+	// Subscribe registers a resource change callback. Returns false if the
+	// resource is not watched.
+	Subscribe(ns, gvr string, fn func(path string)) (func(), bool)
+}
+
+// Tracker represents a model derived from another resource. Its tables are
+// updated when the tracked resource changes.
+type Tracker interface {
+	// TrackedGVR returns the tracked resource.
+	TrackedGVR() string
+}
+
 // TableListener represents a table model listener.
 type TableListener interface {
 	// TableDataChanged notifies the model data changed.
@@ -36,6 +56,10 @@ type Table struct {
 	inUpdate    int32
 	refreshRate time.Duration
 	zeroCount   int32
+	dirty       map[string]struct{}
+	kick        chan struct{}
+	lastSync    time.Time
+	mx          sync.Mutex
 }
 
 // NewTable returns a new table model.
@@ -43,14 +67,18 @@ func NewTable(gvr string) *Table {
 	return &Table{
 		gvr:         gvr,
 		data:        render.NewTableData(),
-		refreshRate: 2 * time.Second,
+		refreshRate: refreshRate,
+		dirty:       make(map[string]struct{}),
+		kick:        make(chan struct{}, 1),
 	}
 }
 
-// Watch initiates model updates.
+// Watch initiates model updates. Watched resources are re-rendered as
+// change events come in, polling is used as a fallback.
 func (t *Table) Watch(ctx context.Context) {
 	t.Refresh(ctx)
-	go t.updater(ctx)
+	cancel, ok := t.subscribe(ctx)
+	go t.updater(ctx, ok, cancel)
 }
 
 // Refresh update the model now.
@@ -60,12 +88,17 @@ func (t *Table) Refresh(ctx context.Context) {
 
 // GetNamespace returns the model namespace.
 func (t *Table) GetNamespace() string {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
 	return t.namespace
 }
 
 // SetNamespace sets up model namespace.
 func (t *Table) SetNamespace(ns string) {
+	t.mx.Lock()
 	t.namespace = ns
+	t.mx.Unlock()
 	t.data.Clear()
 }
 
@@ -76,12 +109,12 @@ func (t *Table) SetRefreshRate(d time.Duration) {
 
 // ClusterWide checks if resource is scope for all namespaces.
 func (t *Table) ClusterWide() bool {
-	return t.namespace == render.AllNamespaces
+	return t.GetNamespace() == render.AllNamespaces
 }
 
 // InNamespace checks if current namespace matches desired namespace.
 func (t *Table) InNamespace(ns string) bool {
-	return t.namespace == ns
+	return t.GetNamespace() == ns
 }
 
 // Empty return true if no model data.
@@ -94,18 +127,83 @@ func (t *Table) Peek() render.TableData {
 	return *t.data
 }
 
-func (t *Table) updater(ctx context.Context) {
+func (t *Table) updater(ctx context.Context, evented bool, cancel func()) {
 	defer log.Debug().Msgf("Model canceled -- %q", t.gvr)
+	if cancel != nil {
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(t.refreshRate)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-time.After(refreshRate):
+		case <-t.kick:
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(eventBatch):
+			}
+			t.refreshDirty(ctx)
+		case <-ticker.C:
+			if evented && t.sinceSync() < resyncRate {
+				continue
+			}
 			t.refresh(ctx)
 		}
 	}
 }
 
+// Subscribe registers for resource change events if the factory supports it.
+func (t *Table) subscribe(ctx context.Context) (func(), bool) {
+	m, ok := Registry[t.gvr]
+	if !ok {
+		return nil, false
+	}
+	gvr := t.gvr
+	if tr, ok := m.Model.(Tracker); ok {
+		gvr = tr.TrackedGVR()
+	} else if meta, err := dao.MetaFor(client.NewGVR(t.gvr)); err != nil || dao.IsK9sMeta(meta) {
+		return nil, false
+	}
+	n, ok := ctx.Value(internal.KeyFactory).(Notifier)
+	if !ok {
+		return nil, false
+	}
+
+	return n.Subscribe(t.GetNamespace(), gvr, t.markDirty)
+}
+
+func (t *Table) markDirty(path string) {
+	t.mx.Lock()
+	switch t.namespace {
+	case render.AllNamespaces, render.ClusterScope:
+	default:
+		if ns, _ := client.Namespaced(path); ns != t.namespace {
+			t.mx.Unlock()
+			return
+		}
+	}
+	t.dirty[path] = struct{}{}
+	t.mx.Unlock()
+
+	select {
+	case t.kick <- struct{}{}:
+	default:
+	}
+}
+
+func (t *Table) drainDirty() map[string]struct{} {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	dirty := t.dirty
+	t.dirty = make(map[string]struct{})
+
+	return dirty
+}
+
 func (t *Table) refresh(ctx context.Context) {
 	if !atomic.CompareAndSwapInt32(&t.inUpdate, 0, 1) {
 		log.Debug().Msgf("Dropping update...")
@@ -113,11 +211,44 @@ func (t *Table) refresh(ctx context.Context) {
 	}
 	defer atomic.StoreInt32(&t.inUpdate, 0)
 
+	t.drainDirty()
 	if err := t.reconcile(ctx); err != nil {
 		log.Error().Err(err).Msg("Reconcile failed")
 		t.fireTableLoadFailed(err)
 		return
 	}
+	t.mx.Lock()
+	t.lastSync = time.Now()
+	t.mx.Unlock()
+	t.fireTableChanged(*t.data)
+}
+
+func (t *Table) sinceSync() time.Duration {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	return time.Since(t.lastSync)
+}
+
+func (t *Table) refreshDirty(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&t.inUpdate, 0, 1) {
+		select {
+		case t.kick <- struct{}{}:
+		default:
+		}
+		return
+	}
+	defer atomic.StoreInt32(&t.inUpdate, 0)
+
+	dirty := t.drainDirty()
+	if len(dirty) == 0 {
+		return
+	}
+	if err := t.patch(ctx, dirty); err != nil {
+		log.Error().Err(err).Msg("Patch failed")
+		t.fireTableLoadFailed(err)
+		return
+	}
 	t.fireTableChanged(*t.data)
 }
 
@@ -160,13 +291,21 @@ func (t *Table) fireTableLoadFailed(err error) {
 }
 
 func (t *Table) list(ctx context.Context, l Lister) ([]runtime.Object, error) {
+	if err := t.init(ctx, l); err != nil {
+		return nil, err
+	}
+
+	return l.List(ctx)
+}
+
+func (t *Table) init(ctx context.Context, l Lister) error {
 	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
 	if !ok {
-		return nil, fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
+		return fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
 	}
-	l.Init(t.namespace, t.gvr, factory)
+	l.Init(t.GetNamespace(), t.gvr, factory)
 
-	return l.List(ctx)
+	return nil
 }
 
 func (t *Table) reconcile(ctx context.Context) error {
@@ -200,7 +339,75 @@ func (t *Table) reconcile(ctx context.Context) error {
 		t.data.Clear()
 	}
 	t.data.Update(rows)
-	t.data.Namespace, t.data.Header = t.namespace, meta.Renderer.Header(t.namespace)
+	ns := t.GetNamespace()
+	t.data.Namespace, t.data.Header = ns, meta.Renderer.Header(ns)
 
 	return nil
 }
+
+// Patch re-renders the changed resources only.
+func (t *Table) patch(ctx context.Context, dirty map[string]struct{}) error {
+	meta := Registry[t.gvr]
+	if meta.Model == nil {
+		meta.Model = &Resource{}
+	}
+	changed, err := t.changed(ctx, meta.Model, dirty)
+	if err != nil {
+		return err
+	}
+	rows := make(render.Rows, len(changed))
+	if err := meta.Model.Hydrate(changed, rows, meta.Renderer); err != nil {
+		return err
+	}
+	ids := make([]string, 0, len(dirty))
+	for id := range dirty {
+		ids = append(ids, id)
+	}
+
+	t.data.Mutex.Lock()
+	defer t.data.Mutex.Unlock()
+	t.data.Patch(rows, ids)
+
+	return nil
+}
+
+// Changed returns the dirty resources still in the model. Resources are
+// fetched off the informers store when the model supports it, otherwise the
+// model is listed and filtered.
+func (t *Table) changed(ctx context.Context, m Lister, dirty map[string]struct{}) ([]runtime.Object, error) {
+	var get func(context.Context, string) (runtime.Object, error)
+	switch g := m.(type) {
+	case Getter:
+		get = g.Get
+	case *Resource:
+		get = g.get
+	default:
+		oo, err := t.list(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		var changed []runtime.Object
+		for _, o := range oo {
+			if _, ok := dirty[objectFQN(o)]; ok {
+				changed = append(changed, o)
+			}
+		}
+		return changed, nil
+	}
+
+	if err := t.init(ctx, m); err != nil {
+		return nil, err
+	}
+	changed := make([]runtime.Object, 0, len(dirty))
+	for path := range dirty {
+		o, err := get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		if o != nil {
+			changed = append(changed, o)
+		}
+	}
+
+	return changed, nil
+}
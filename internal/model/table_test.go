@@ -0,0 +1,91 @@
+package model_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestTableWatchEvents(t *testing.T) {
+	dao.RegisterMeta("v1/namespaces", metav1.APIResource{Name: "namespaces", Kind: "Namespace"})
+	f := &notifyFactory{nsFactory: nsFactory{names: []string{"fred", "blee"}}}
+	ta := model.NewTable("v1/namespaces")
+	ta.SetNamespace(render.ClusterScope)
+	l := tableListener{data: make(chan render.TableData, 2)}
+	ta.AddListener(l)
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), internal.KeyFactory, f))
+	defer cancel()
+	ta.Watch(ctx)
+	<-l.data
+	assert.NotNil(t, f.fn)
+	lists := atomic.LoadInt32(&f.lists)
+
+	f.names = []string{"fred", "zorg"}
+	f.fn("blee")
+	f.fn("zorg")
+
+	select {
+	case data := <-l.data:
+		assert.Equal(t, 2, len(data.RowEvents))
+		assert.Equal(t, "fred", data.RowEvents[0].Row.ID)
+		assert.Equal(t, render.EventUnchanged, data.RowEvents[0].Kind)
+		assert.Equal(t, "zorg", data.RowEvents[1].Row.ID)
+		assert.Equal(t, render.EventAdd, data.RowEvents[1].Kind)
+		assert.Equal(t, lists, atomic.LoadInt32(&f.lists))
+	case <-time.After(time.Second):
+		assert.Fail(t, "expected a table update")
+	}
+}
+
+// Helpers...
+
+type notifyFactory struct {
+	nsFactory
+	fn    func(string)
+	lists int32
+}
+
+func (f *notifyFactory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	atomic.AddInt32(&f.lists, 1)
+	return f.nsFactory.List(gvr, ns, wait, sel)
+}
+
+func (f *notifyFactory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
+	oo, _ := f.nsFactory.List(gvr, "", wait, sel)
+	for _, o := range oo {
+		if o.(*unstructured.Unstructured).GetName() == path {
+			return o, nil
+		}
+	}
+
+	return nil, errors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, path)
+}
+
+func (f *notifyFactory) Subscribe(ns, gvr string, fn func(path string)) (func(), bool) {
+	f.fn = fn
+	return func() {}, true
+}
+
+type tableListener struct {
+	data chan render.TableData
+}
+
+func (l tableListener) TableDataChanged(data render.TableData) {
+	l.data <- data
+}
+
+func (l tableListener) TableLoadFailed(error) {}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Template represents a collection of resource templates.
+type Template struct {
+	Resource
+}
+
+// List returns a collection of templates.
+func (t *Template) List(ctx context.Context) ([]runtime.Object, error) {
+	dir, ok := ctx.Value(internal.KeyDir).(string)
+	if !ok {
+		return nil, errors.New("no templates dir found in context")
+	}
+	if err := dao.EnsureTemplates(dir); err != nil {
+		return nil, err
+	}
+
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(ff))
+	for _, f := range ff {
+		if f.IsDir() || !dao.IsManifest(f.Name()) {
+			continue
+		}
+		res := render.TemplateRes{File: f, Dir: dir}
+		raw, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if res.Vars, err = dao.TemplateVars(raw); err != nil {
+			log.Warn().Err(err).Msgf("Invalid template %q", f.Name())
+			res.Error = err.Error()
+		}
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Timeline represents the events involving a resource and its dependents.
+type Timeline struct {
+	Resource
+}
+
+var _ Tracker = (*Timeline)(nil)
+
+// TrackedGVR returns the tracked resource.
+func (t *Timeline) TrackedGVR() string {
+	return "v1/events"
+}
+
+// List returns a collection of events, latest first.
+func (t *Timeline) List(ctx context.Context) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", t.gvr)
+	}
+	gvr, ok := ctx.Value(internal.KeyTargetGVR).(string)
+	if !ok {
+		return nil, fmt.Errorf("no target gvr for %q", t.gvr)
+	}
+
+	o, err := t.factory.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	ee, err := dao.NewTimeline(t.factory, u).Events(u.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		oo = append(oo, e)
+	}
+
+	return oo, nil
+}
@@ -66,6 +66,13 @@ type Lister interface {
 	Hydrate(oo []runtime.Object, rr render.Rows, r Renderer) error
 }
 
+// Getter represents a model that fetches a single resource so changed
+// resources can be patched without a full list.
+type Getter interface {
+	// Get returns a resource or nil if it no longer matches the model.
+	Get(ctx context.Context, path string) (runtime.Object, error)
+}
+
 // ResourceMeta represents model info about a resource.
 type ResourceMeta struct {
 	Model    Lister
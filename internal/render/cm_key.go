@@ -0,0 +1,140 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// JSONFormat represents a json document.
+	JSONFormat = "json"
+
+	// YAMLFormat represents a yaml document.
+	YAMLFormat = "yaml"
+
+	// PropsFormat represents a properties document.
+	PropsFormat = "properties"
+
+	// TextFormat represents a plain text document.
+	TextFormat = "text"
+
+	// BinaryFormat represents binary data.
+	BinaryFormat = "binary"
+)
+
+// ConfigMapKey renders a configmap key to screen.
+type ConfigMapKey struct{}
+
+// ColorerFunc colors a resource row.
+func (ConfigMapKey) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, re)
+		if strings.TrimSpace(re.Row.Fields[1]) == BinaryFormat {
+			return CompletedColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (ConfigMapKey) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAME"},
+		Header{Name: "TYPE"},
+		Header{Name: "SIZE", Align: tview.AlignRight},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ConfigMapKey) Render(o interface{}, ns string, r *Row) error {
+	k, ok := o.(ConfigMapKeyRes)
+	if !ok {
+		return fmt.Errorf("expecting ConfigMapKeyRes, but got %T", o)
+	}
+
+	r.ID = k.Key
+	r.Fields = Fields{
+		k.Key,
+		KeyFormat(k.Key, k.Value, k.Binary),
+		strconv.Itoa(len(k.Value)),
+	}
+
+	return nil
+}
+
+// KeyFormat guesses a configmap key document format.
+func KeyFormat(key string, val []byte, binary bool) string {
+	if binary {
+		return BinaryFormat
+	}
+
+	switch strings.ToLower(filepath.Ext(key)) {
+	case ".json":
+		return JSONFormat
+	case ".yaml", ".yml":
+		return YAMLFormat
+	case ".properties", ".props", ".ini", ".env", ".conf":
+		return PropsFormat
+	}
+
+	s := strings.TrimSpace(string(val))
+	if (strings.HasPrefix(s, "{") || strings.HasPrefix(s, "[")) && json.Valid([]byte(s)) {
+		return JSONFormat
+	}
+	if isProps(s) {
+		return PropsFormat
+	}
+	var m map[string]interface{}
+	if strings.Contains(s, ":") && yaml.Unmarshal([]byte(s), &m) == nil && len(m) > 0 {
+		return YAMLFormat
+	}
+
+	return TextFormat
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isProps(s string) bool {
+	var count int
+	for _, l := range strings.Split(s, "\n") {
+		l = strings.TrimSpace(l)
+		if l == "" || strings.HasPrefix(l, "#") || strings.HasPrefix(l, "!") {
+			continue
+		}
+		i := strings.Index(l, "=")
+		if i <= 0 || strings.ContainsAny(l[:i], " :") {
+			return false
+		}
+		count++
+	}
+
+	return count > 0
+}
+
+// ConfigMapKeyRes represents a configmap key and its value.
+type ConfigMapKeyRes struct {
+	Key    string
+	Value  []byte
+	Binary bool
+}
+
+// GetObjectKind returns a schema object.
+func (ConfigMapKeyRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c ConfigMapKeyRes) DeepCopyObject() runtime.Object {
+	return c
+}
@@ -0,0 +1,42 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigMapKeyRender(t *testing.T) {
+	var c render.ConfigMapKey
+
+	var r render.Row
+	assert.Nil(t, c.Render(render.ConfigMapKeyRes{Key: "app.json", Value: []byte(`{"a": 1}`)}, "", &r))
+	assert.Equal(t, "app.json", r.ID)
+	assert.Equal(t, render.Fields{"app.json", "json", "8"}, r.Fields)
+}
+
+func TestKeyFormat(t *testing.T) {
+	uu := map[string]struct {
+		k, v   string
+		binary bool
+		e      string
+	}{
+		"binary":   {"blee", "\x00\x01", true, render.BinaryFormat},
+		"jsonExt":  {"blee.json", "", false, render.JSONFormat},
+		"yamlExt":  {"blee.yml", "", false, render.YAMLFormat},
+		"propsExt": {"blee.properties", "", false, render.PropsFormat},
+		"json":     {"blee", `[1, 2]`, false, render.JSONFormat},
+		"props":    {"blee", "# comment\na.b=1\nc=2", false, render.PropsFormat},
+		"yaml":     {"blee", "a:\n  b: 1", false, render.YAMLFormat},
+		"text":     {"blee", "hello world", false, render.TextFormat},
+		"badJSON":  {"blee", `{"a"`, false, render.TextFormat},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.KeyFormat(u.k, []byte(u.v), u.binary))
+		})
+	}
+}
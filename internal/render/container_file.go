@@ -0,0 +1,86 @@
+package render
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	// FileLink represents a symbolic link entry type.
+	FileLink = "link"
+	// FileOther represents a device, socket or pipe entry type.
+	FileOther = "other"
+)
+
+// ContainerFile renders a container filesystem entry to screen.
+type ContainerFile struct{}
+
+// ColorerFunc colors a resource row.
+func (ContainerFile) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch re.Row.Fields[1] {
+		case FileDir:
+			return HighlightColor
+		case FileOther:
+			return CompletedColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (ContainerFile) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAME"},
+		Header{Name: "TYPE"},
+		Header{Name: "PERMISSIONS"},
+		Header{Name: "OWNER"},
+		Header{Name: "SIZE", Align: tview.AlignRight},
+		Header{Name: "MODIFIED"},
+	}
+}
+
+// Render renders a container file entry to screen. Directory ids carry a
+// trailing slash.
+func (ContainerFile) Render(o interface{}, ns string, r *Row) error {
+	f, ok := o.(ContainerFileRes)
+	if !ok {
+		return fmt.Errorf("expecting ContainerFileRes, but got %T", o)
+	}
+
+	r.ID = path.Join(f.Dir, f.Name)
+	if f.Type == FileDir && !strings.HasSuffix(r.ID, "/") {
+		r.ID += "/"
+	}
+	name, size := f.Name, ""
+	if f.Link != "" {
+		name += " -> " + f.Link
+	}
+	if f.Type == FileRegular || f.Type == FileLink {
+		size = strconv.FormatInt(f.Size, 10)
+	}
+	r.Fields = Fields{
+		name,
+		f.Type,
+		f.Mode,
+		f.Owner,
+		size,
+		f.Modified,
+	}
+
+	return nil
+}
+
+// ContainerFileRes represents a container filesystem entry.
+type ContainerFileRes struct {
+	Dir, Name, Type string
+	Mode, Owner     string
+	Size            int64
+	Modified, Link  string
+}
@@ -0,0 +1,55 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerFileRender(t *testing.T) {
+	uu := map[string]struct {
+		f  render.ContainerFileRes
+		id string
+		e  render.Fields
+	}{
+		"file": {
+			f: render.ContainerFileRes{
+				Dir:      "/etc",
+				Name:     "hosts",
+				Type:     render.FileRegular,
+				Mode:     "-rw-r--r--",
+				Owner:    "root:root",
+				Size:     174,
+				Modified: "Mar 3 10:12",
+			},
+			id: "/etc/hosts",
+			e:  render.Fields{"hosts", "file", "-rw-r--r--", "root:root", "174", "Mar 3 10:12"},
+		},
+		"dir": {
+			f:  render.ContainerFileRes{Dir: "/", Name: "etc", Type: render.FileDir, Size: 4096},
+			id: "/etc/",
+			e:  render.Fields{"etc", "dir", "", "", "", ""},
+		},
+		"link": {
+			f:  render.ContainerFileRes{Dir: "/", Name: "bin", Type: render.FileLink, Size: 7, Link: "usr/bin"},
+			id: "/bin",
+			e:  render.Fields{"bin -> usr/bin", "link", "", "", "7", ""},
+		},
+		"parent": {
+			f:  render.ContainerFileRes{Dir: "/etc", Name: "..", Type: render.FileDir},
+			id: "/",
+			e:  render.Fields{"..", "dir", "", "", "", ""},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, render.ContainerFile{}.Render(u.f, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
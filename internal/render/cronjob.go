@@ -3,10 +3,16 @@ package render
 import (
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/gdamore/tcell"
+	"github.com/robfig/cron/v3"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 // CronJob renders a K8s CronJob to screen.
@@ -14,7 +20,15 @@ type CronJob struct{}
 
 // ColorerFunc colors a resource row.
 func (CronJob) ColorerFunc() ColorerFunc {
-	return DefaultColorer
+	return func(ns string, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, re)
+		// Suspend is the sixth column from the end.
+		if c == StdColor && re.Row.Fields[len(re.Row.Fields)-6] == "true" {
+			return CompletedColor
+		}
+
+		return c
+	}
 }
 
 // Header returns a header row.
@@ -30,14 +44,24 @@ func (CronJob) Header(ns string) HeaderRow {
 		Header{Name: "SUSPEND"},
 		Header{Name: "ACTIVE"},
 		Header{Name: "LAST_SCHEDULE"},
+		Header{Name: "LAST_SUCCESS"},
+		Header{Name: "NEXT_SCHEDULE"},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
 
 // Render renders a K8s resource to screen.
 func (c CronJob) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
+	var (
+		raw         *unstructured.Unstructured
+		lastSuccess *metav1.Time
+	)
+	switch cj := o.(type) {
+	case *unstructured.Unstructured:
+		raw = cj
+	case *CronJobWithRuns:
+		raw, lastSuccess = cj.Raw, cj.LastSuccess
+	default:
 		return fmt.Errorf("Expected CronJob, but got %T", o)
 	}
 	var cj batchv1beta1.CronJob
@@ -46,11 +70,6 @@ func (c CronJob) Render(o interface{}, ns string, r *Row) error {
 		return err
 	}
 
-	lastScheduled := "<none>"
-	if cj.Status.LastScheduleTime != nil {
-		lastScheduled = toAgeHuman(toAge(*cj.Status.LastScheduleTime))
-	}
-
 	r.ID = MetaFQN(cj.ObjectMeta)
 	r.Fields = make(Fields, 0, len(c.Header(ns)))
 	if isAllNamespace(ns) {
@@ -61,9 +80,54 @@ func (c CronJob) Render(o interface{}, ns string, r *Row) error {
 		cj.Spec.Schedule,
 		boolPtrToStr(cj.Spec.Suspend),
 		strconv.Itoa(len(cj.Status.Active)),
-		lastScheduled,
+		toTimeAgo(cj.Status.LastScheduleTime),
+		toTimeAgo(lastSuccess),
+		toNextSchedule(&cj, time.Now()),
 		toAge(cj.ObjectMeta.CreationTimestamp),
 	)
 
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func toTimeAgo(t *metav1.Time) string {
+	if t == nil {
+		return MissingValue
+	}
+
+	return toAgeHuman(toAge(*t))
+}
+
+func toNextSchedule(cj *batchv1beta1.CronJob, now time.Time) string {
+	if cj.Spec.Suspend != nil && *cj.Spec.Suspend {
+		return MissingValue
+	}
+	s, err := cron.ParseStandard(cj.Spec.Schedule)
+	if err != nil {
+		return NAValue
+	}
+	next := s.Next(now.UTC())
+	if next.IsZero() {
+		return MissingValue
+	}
+
+	return duration.HumanDuration(next.Sub(now))
+}
+
+// CronJobWithRuns represents a cronjob and its job runs stats.
+type CronJobWithRuns struct {
+	Raw         *unstructured.Unstructured
+	LastSuccess *metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (c *CronJobWithRuns) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c *CronJobWithRuns) DeepCopyObject() runtime.Object {
+	return c
+}
@@ -0,0 +1,43 @@
+package render
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+)
+
+func TestToNextSchedule(t *testing.T) {
+	now := time.Date(2019, time.December, 31, 23, 58, 30, 0, time.UTC)
+	yes := true
+	uu := map[string]struct {
+		spec    string
+		suspend *bool
+		e       string
+	}{
+		"everyMinute": {spec: "* * * * *", e: "30s"},
+		"step":        {spec: "*/15 * * * *", e: "90s"},
+		"hourly":      {spec: "@hourly", e: "90s"},
+		"every":       {spec: "@every 1h", e: "60m"},
+		"weekdays":    {spec: "30 9 * * mon-fri", e: "9h"},
+		"list":        {spec: "0 6,18 15 feb,aug *", e: "45d"},
+		"domOrDow":    {spec: "0 0 13 * 5", e: "2d"},
+		"never":       {spec: "0 0 31 2 *", e: MissingValue},
+		"suspended":   {spec: "* * * * *", suspend: &yes, e: MissingValue},
+		"empty":       {spec: "", e: NAValue},
+		"fields":      {spec: "* * * *", e: NAValue},
+		"range":       {spec: "60 * * * *", e: NAValue},
+		"badStep":     {spec: "*/0 * * * *", e: NAValue},
+		"badDay":      {spec: "* * * * fred", e: NAValue},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var cj batchv1beta1.CronJob
+			cj.Spec.Schedule, cj.Spec.Suspend = u.spec, u.suspend
+			assert.Equal(t, u.e, toNextSchedule(&cj, now))
+		})
+	}
+}
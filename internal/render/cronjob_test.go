@@ -2,9 +2,11 @@ package render_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestCronJobRender(t *testing.T) {
@@ -14,4 +16,21 @@ func TestCronJobRender(t *testing.T) {
 
 	assert.Equal(t, "default/hello", r.ID)
 	assert.Equal(t, render.Fields{"default", "hello", "*/1 * * * *", "false", "0"}, r.Fields[:5])
+	assert.Equal(t, "<none>", r.Fields[6])
+	assert.Regexp(t, `^\d+s$`, r.Fields[7])
+}
+
+func TestCronJobWithRunsRender(t *testing.T) {
+	c := render.CronJob{}
+	last := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	o := render.CronJobWithRuns{Raw: load(t, "cj"), LastSuccess: &last}
+	o.Raw.Object["spec"].(map[string]interface{})["suspend"] = true
+
+	var r render.Row
+	assert.Nil(t, c.Render(&o, "", &r))
+
+	assert.Equal(t, "default/hello", r.ID)
+	assert.Equal(t, render.Fields{"default", "hello", "*/1 * * * *", "true", "0"}, r.Fields[:5])
+	assert.Equal(t, "2m", r.Fields[6])
+	assert.Equal(t, "<none>", r.Fields[7])
 }
@@ -0,0 +1,327 @@
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// CSVDump represents a comma separated screen dump.
+	CSVDump = "csv"
+
+	// JSONDump represents a JSON screen dump.
+	JSONDump = "json"
+
+	// YAMLDump represents a YAML screen dump.
+	YAMLDump = "yaml"
+
+	// MarkdownDump represents a markdown table screen dump.
+	MarkdownDump = "md"
+
+	// HTMLDump represents an html table screen dump.
+	HTMLDump = "html"
+)
+
+// DumpFormats lists all supported screen dump formats.
+var DumpFormats = []string{CSVDump, JSONDump, YAMLDump, MarkdownDump, HTMLDump}
+
+var (
+	htmlRowRX  = regexp.MustCompile(`<tr>(.*?)</tr>`)
+	htmlCellRX = regexp.MustCompile(`<t[hd]>(.*?)</t[hd]>`)
+)
+
+// Dump represents a screen dump document.
+type Dump struct {
+	GVR       string        `json:"gvr,omitempty"`
+	Namespace string        `json:"namespace,omitempty"`
+	Timestamp time.Time     `json:"timestamp"`
+	Header    []string      `json:"header"`
+	Rows      [][]string    `json:"rows"`
+	Objects   []interface{} `json:"objects,omitempty"`
+}
+
+// NewDump returns a new screen dump from a table.
+func NewDump(gvr string, data TableData) *Dump {
+	d := Dump{
+		GVR:       gvr,
+		Namespace: data.Namespace,
+		Timestamp: time.Now(),
+		Header:    data.Header.Columns(),
+		Rows:      make([][]string, 0, len(data.RowEvents)),
+	}
+	for _, re := range data.RowEvents {
+		d.Rows = append(d.Rows, re.Row.Fields)
+	}
+
+	return &d
+}
+
+// IsDumpFormat returns true if the format is supported.
+func IsDumpFormat(f string) bool {
+	return in(DumpFormats, f)
+}
+
+// DumpFormatFor returns a screen dump format based on a file extension.
+func DumpFormatFor(path string) string {
+	ext := strings.TrimPrefix(filepath.Ext(path), ".")
+	switch ext {
+	case "yml":
+		return YAMLDump
+	case "htm":
+		return HTMLDump
+	case "markdown":
+		return MarkdownDump
+	}
+	if IsDumpFormat(ext) {
+		return ext
+	}
+
+	return CSVDump
+}
+
+// Write serializes the screen dump in the given format.
+func (d *Dump) Write(w io.Writer, format string) error {
+	switch format {
+	case CSVDump:
+		return d.writeCSV(w)
+	case JSONDump:
+		raw, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(raw, '\n'))
+		return err
+	case YAMLDump:
+		raw, err := yaml.Marshal(d)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(raw)
+		return err
+	case MarkdownDump:
+		return d.writeMarkdown(w)
+	case HTMLDump:
+		return d.writeHTML(w)
+	default:
+		return fmt.Errorf("unsupported screen dump format %q", format)
+	}
+}
+
+// TableData returns the screen dump as table data.
+func (d *Dump) TableData() TableData {
+	data := NewTableData()
+	data.Namespace = d.Namespace
+	if data.Namespace == AllNamespaces {
+		data.Namespace = ClusterScope
+	}
+	if len(d.Header) > 0 && d.Header[0] == "NAMESPACE" {
+		data.Namespace = AllNamespaces
+	}
+
+	nameCol := -1
+	for i, h := range d.Header {
+		hd := Header{Name: h}
+		switch h {
+		case "AGE":
+			hd.Decorator = AgeDecorator
+		case "NAME":
+			nameCol = i
+		}
+		data.Header = append(data.Header, hd)
+	}
+
+	data.RowEvents = make(RowEvents, 0, len(d.Rows))
+	for i, r := range d.Rows {
+		row := Row{ID: strconv.Itoa(i), Fields: r}
+		if nameCol >= 0 && nameCol < len(r) {
+			row.ID = r[nameCol]
+			if data.Namespace == AllNamespaces {
+				row.ID = FQN(r[0], r[nameCol])
+			}
+		}
+		data.RowEvents = append(data.RowEvents, NewRowEvent(EventUnchanged, row))
+	}
+
+	return *data
+}
+
+// LoadDump reads a screen dump from disk.
+func LoadDump(path string) (*Dump, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseDump(raw, DumpFormatFor(path))
+}
+
+// ParseDump parses a screen dump in the given format.
+func ParseDump(raw []byte, format string) (*Dump, error) {
+	var d Dump
+	switch format {
+	case CSVDump:
+		rr, err := csv.NewReader(bytes.NewReader(raw)).ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		if len(rr) == 0 {
+			return nil, errors.New("empty screen dump")
+		}
+		d.Header, d.Rows = rr[0], rr[1:]
+	case JSONDump:
+		if err := json.Unmarshal(raw, &d); err != nil {
+			return nil, err
+		}
+	case YAMLDump:
+		if err := yaml.Unmarshal(raw, &d); err != nil {
+			return nil, err
+		}
+	case MarkdownDump:
+		d.parseMarkdown(raw)
+	case HTMLDump:
+		d.parseHTML(raw)
+	default:
+		return nil, fmt.Errorf("unsupported screen dump format %q", format)
+	}
+	if len(d.Header) == 0 {
+		return nil, errors.New("no table found in screen dump")
+	}
+
+	return &d, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (d *Dump) title() string {
+	ns := d.Namespace
+	if ns == AllNamespaces {
+		ns = NamespaceAll
+	}
+	if d.GVR == "" {
+		return d.Timestamp.Format(time.RFC3339)
+	}
+
+	return fmt.Sprintf("%s(%s) %s", d.GVR, ns, d.Timestamp.Format(time.RFC3339))
+}
+
+func (d *Dump) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(d.Header); err != nil {
+		return err
+	}
+	for _, r := range d.Rows {
+		if err := cw.Write(r); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func (d *Dump) writeMarkdown(w io.Writer) error {
+	var buff strings.Builder
+	fmt.Fprintf(&buff, "## %s\n\n", d.title())
+	writeMDRow(&buff, d.Header)
+	seps := make([]string, len(d.Header))
+	for i := range seps {
+		seps[i] = "---"
+	}
+	writeMDRow(&buff, seps)
+	for _, r := range d.Rows {
+		writeMDRow(&buff, r)
+	}
+	_, err := io.WriteString(w, buff.String())
+
+	return err
+}
+
+func writeMDRow(buff *strings.Builder, ff []string) {
+	buff.WriteString("|")
+	for _, f := range ff {
+		buff.WriteString(" " + strings.Replace(f, "|", `\|`, -1) + " |")
+	}
+	buff.WriteString("\n")
+}
+
+func (d *Dump) parseMarkdown(raw []byte) {
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	var lines int
+	for scanner.Scan() {
+		l := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(l, "|") {
+			continue
+		}
+		lines++
+		if lines == 2 {
+			continue
+		}
+		l = strings.TrimSuffix(strings.TrimPrefix(l, "|"), "|")
+		l = strings.Replace(l, `\|`, "\x00", -1)
+		ff := strings.Split(l, "|")
+		for i := range ff {
+			ff[i] = strings.Replace(strings.TrimSpace(ff[i]), "\x00", "|", -1)
+		}
+		if lines == 1 {
+			d.Header = ff
+			continue
+		}
+		d.Rows = append(d.Rows, ff)
+	}
+}
+
+func (d *Dump) writeHTML(w io.Writer) error {
+	var buff strings.Builder
+	title := html.EscapeString(d.title())
+	buff.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(&buff, "<title>%s</title>\n", title)
+	buff.WriteString("<style>table{border-collapse:collapse;font-family:monospace}th,td{border:1px solid #ccc;padding:2px 8px;text-align:left}th{background:#eee}</style>\n")
+	fmt.Fprintf(&buff, "</head>\n<body>\n<h2>%s</h2>\n<table>\n<thead>\n", title)
+	writeHTMLRow(&buff, "th", d.Header)
+	buff.WriteString("</thead>\n<tbody>\n")
+	for _, r := range d.Rows {
+		writeHTMLRow(&buff, "td", r)
+	}
+	buff.WriteString("</tbody>\n</table>\n</body>\n</html>\n")
+	_, err := io.WriteString(w, buff.String())
+
+	return err
+}
+
+func writeHTMLRow(buff *strings.Builder, tag string, ff []string) {
+	buff.WriteString("<tr>")
+	for _, f := range ff {
+		fmt.Fprintf(buff, "<%s>%s</%s>", tag, html.EscapeString(f), tag)
+	}
+	buff.WriteString("</tr>\n")
+}
+
+func (d *Dump) parseHTML(raw []byte) {
+	for i, r := range htmlRowRX.FindAllSubmatch(raw, -1) {
+		cc := htmlCellRX.FindAllSubmatch(r[1], -1)
+		ff := make([]string, 0, len(cc))
+		for _, c := range cc {
+			ff = append(ff, html.UnescapeString(string(c[1])))
+		}
+		if i == 0 {
+			d.Header = ff
+			continue
+		}
+		d.Rows = append(d.Rows, ff)
+	}
+}
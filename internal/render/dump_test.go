@@ -0,0 +1,71 @@
+package render_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDumpRoundTrip(t *testing.T) {
+	d := render.Dump{
+		GVR:       "v1/pods",
+		Namespace: "default",
+		Header:    []string{"NAME", "STATUS", "AGE"},
+		Rows: [][]string{
+			{"fred", "Running", "2m"},
+			{"blee|duh", "<Pending>", "1h"},
+		},
+	}
+
+	for _, f := range render.DumpFormats {
+		var buff bytes.Buffer
+		assert.Nil(t, d.Write(&buff, f), f)
+
+		o, err := render.ParseDump(buff.Bytes(), f)
+		assert.Nil(t, err, f)
+		assert.Equal(t, d.Header, o.Header, f)
+		assert.Equal(t, d.Rows, o.Rows, f)
+	}
+}
+
+func TestDumpFormatFor(t *testing.T) {
+	uu := map[string]struct {
+		path, e string
+	}{
+		"csv":      {"/tmp/fred.csv", render.CSVDump},
+		"yml":      {"/tmp/fred.yml", render.YAMLDump},
+		"htm":      {"/tmp/fred.htm", render.HTMLDump},
+		"md":       {"/tmp/fred.md", render.MarkdownDump},
+		"json":     {"/tmp/fred.json", render.JSONDump},
+		"unknown":  {"/tmp/fred.txt", render.CSVDump},
+		"noExt":    {"/tmp/fred", render.CSVDump},
+		"markdown": {"/tmp/fred.markdown", render.MarkdownDump},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.DumpFormatFor(u.path))
+		})
+	}
+}
+
+func TestDumpTableData(t *testing.T) {
+	d := render.Dump{
+		Header: []string{"NAMESPACE", "NAME", "AGE"},
+		Rows:   [][]string{{"default", "fred", "2m"}},
+	}
+
+	data := d.TableData()
+	assert.Equal(t, render.AllNamespaces, data.Namespace)
+	assert.Equal(t, 1, len(data.RowEvents))
+	assert.Equal(t, "default/fred", data.RowEvents[0].Row.ID)
+	assert.True(t, data.Header.AgeCol(2))
+}
+
+func TestParseDumpEmpty(t *testing.T) {
+	_, err := render.ParseDump([]byte("# nothing here"), render.MarkdownDump)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,63 @@
+package render
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	// FileDir represents a directory entry type.
+	FileDir = "dir"
+	// FileManifest represents a manifest entry type.
+	FileManifest = "file"
+	// FileRegular represents a regular file entry type.
+	FileRegular = "file"
+)
+
+// File renders a file picker entry to screen.
+type File struct{}
+
+// ColorerFunc colors a resource row.
+func (File) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if re.Row.Fields[1] == FileDir {
+			return HighlightColor
+		}
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (File) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAME"},
+		Header{Name: "TYPE"},
+		Header{Name: "SIZE", Align: tview.AlignRight},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a file entry to screen.
+func (File) Render(o interface{}, ns string, r *Row) error {
+	f, ok := o.(FileRes)
+	if !ok {
+		return fmt.Errorf("expecting FileRes, but got %T", o)
+	}
+
+	r.ID = filepath.Join(f.Dir, f.File.Name())
+	kind, size := FileManifest, fmt.Sprintf("%d", f.File.Size())
+	if f.File.IsDir() {
+		kind, size = FileDir, ""
+	}
+	r.Fields = Fields{
+		f.File.Name(),
+		kind,
+		size,
+		timeToAge(f.File.ModTime()),
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Helm renders a Helm release to screen.
+type Helm struct{}
+
+// ColorerFunc colors a resource row.
+func (Helm) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		col := 5
+		if !isAllNamespace(ns) {
+			col--
+		}
+		return helmColor(re.Row.Fields[col])
+	}
+}
+
+// Header returns a header row.
+func (Helm) Header(ns string) HeaderRow {
+	var h HeaderRow
+	if isAllNamespace(ns) {
+		h = append(h, Header{Name: "NAMESPACE"})
+	}
+
+	return append(h,
+		Header{Name: "NAME"},
+		Header{Name: "REVISION", Align: tview.AlignRight},
+		Header{Name: "CHART"},
+		Header{Name: "APP VERSION"},
+		Header{Name: "STATUS"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	)
+}
+
+// Render renders a Helm release to screen.
+func (Helm) Render(o interface{}, ns string, r *Row) error {
+	h, ok := o.(HelmRes)
+	if !ok {
+		return fmt.Errorf("expecting HelmRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(h.Namespace, h.Name)
+	r.Fields = make(Fields, 0, len(Helm{}.Header(ns)))
+	if isAllNamespace(ns) {
+		r.Fields = append(r.Fields, h.Namespace)
+	}
+	r.Fields = append(r.Fields,
+		h.Name,
+		strconv.Itoa(h.Revision),
+		na(h.Chart),
+		na(h.AppVersion),
+		h.Status,
+		timeToAge(h.Updated),
+	)
+
+	return nil
+}
+
+// HelmHistory renders a Helm release revisions to screen.
+type HelmHistory struct{}
+
+// ColorerFunc colors a resource row.
+func (HelmHistory) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		return helmColor(re.Row.Fields[1])
+	}
+}
+
+// Header returns a header row.
+func (HelmHistory) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "REVISION", Align: tview.AlignRight},
+		Header{Name: "STATUS"},
+		Header{Name: "CHART"},
+		Header{Name: "APP VERSION"},
+		Header{Name: "DESCRIPTION"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a Helm release revision to screen.
+func (HelmHistory) Render(o interface{}, ns string, r *Row) error {
+	h, ok := o.(HelmRes)
+	if !ok {
+		return fmt.Errorf("expecting HelmRes, but got %T", o)
+	}
+
+	r.ID = strconv.Itoa(h.Revision)
+	r.Fields = Fields{
+		strconv.Itoa(h.Revision),
+		h.Status,
+		na(h.Chart),
+		na(h.AppVersion),
+		h.Description,
+		timeToAge(h.Updated),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func helmColor(status string) tcell.Color {
+	switch status {
+	case "deployed":
+		return StdColor
+	case "superseded", "uninstalled":
+		return CompletedColor
+	case "failed":
+		return ErrColor
+	default:
+		return HighlightColor
+	}
+}
+
+// HelmRes represents a Helm release revision.
+type HelmRes struct {
+	Name, Namespace   string
+	Revision          int
+	Chart, AppVersion string
+	Status            string
+	Description       string
+	Updated           time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (HelmRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (h HelmRes) DeepCopyObject() runtime.Object {
+	return h
+}
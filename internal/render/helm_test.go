@@ -0,0 +1,33 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHelmRender(t *testing.T) {
+	h := render.HelmRes{
+		Name:        "blee",
+		Namespace:   "fred",
+		Revision:    3,
+		Chart:       "nginx-1.2.0",
+		Status:      "deployed",
+		Description: "Rollback to 1",
+		Updated:     time.Now(),
+	}
+
+	var r render.Row
+	assert.Nil(t, render.Helm{}.Render(h, "", &r))
+	assert.Equal(t, "fred/blee", r.ID)
+	assert.Equal(t, render.Fields{"fred", "blee", "3", "nginx-1.2.0", "n/a", "deployed"}, r.Fields[:6])
+
+	assert.Nil(t, render.Helm{}.Render(h, "fred", &r))
+	assert.Equal(t, render.Fields{"blee", "3", "nginx-1.2.0", "n/a", "deployed"}, r.Fields[:5])
+
+	assert.Nil(t, render.HelmHistory{}.Render(h, "", &r))
+	assert.Equal(t, "3", r.ID)
+	assert.Equal(t, render.Fields{"3", "deployed", "nginx-1.2.0", "n/a", "Rollback to 1"}, r.Fields[:5])
+}
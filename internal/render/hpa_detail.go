@@ -0,0 +1,121 @@
+package render
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// HPASectionReplicas tracks HPA replicas rows.
+	HPASectionReplicas = "REPLICAS"
+	// HPASectionTarget tracks the HPA scale target row.
+	HPASectionTarget = "TARGET"
+	// HPASectionMetric tracks HPA metrics rows.
+	HPASectionMetric = "METRIC"
+	// HPASectionCondition tracks HPA condition rows.
+	HPASectionCondition = "CONDITION"
+	// HPASectionEvent tracks HPA scaling events rows.
+	HPASectionEvent = "EVENT"
+
+	// HPAIDSep separates a HPA detail row section from its name.
+	HPAIDSep = "|"
+)
+
+var sparkBars = []rune("▁▂▃▄▅▆▇█")
+
+// HPADetail renders a HPA details to screen.
+type HPADetail struct{}
+
+// ColorerFunc colors a resource row.
+func (HPADetail) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if re.Row.Fields[4] == "" {
+			return DefaultColorer(ns, re)
+		}
+		if re.Row.Fields[0] == HPASectionCondition {
+			return ErrColor
+		}
+
+		return ModColor
+	}
+}
+
+// Header returns a header row.
+func (HPADetail) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "SECTION"},
+		Header{Name: "NAME"},
+		Header{Name: "CURRENT"},
+		Header{Name: "TARGET"},
+		Header{Name: "WARNING"},
+		Header{Name: "INFO"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a HPA detail to screen.
+func (HPADetail) Render(o interface{}, ns string, r *Row) error {
+	d, ok := o.(HPADetailRes)
+	if !ok {
+		return fmt.Errorf("expecting HPADetailRes, but got %T", o)
+	}
+
+	key := d.Name
+	if d.Key != "" {
+		key = d.Key
+	}
+	r.ID = d.Section + HPAIDSep + key
+	age := ""
+	if !d.Since.IsZero() {
+		age = timeToAge(d.Since)
+	}
+	r.Fields = Fields{d.Section, d.Name, d.Current, d.Target, d.Warning, d.Info, age}
+
+	return nil
+}
+
+// SparkLine renders values as a single line bar chart.
+func SparkLine(vv []float64) string {
+	if len(vv) == 0 {
+		return ""
+	}
+	min, max := vv[0], vv[0]
+	for _, v := range vv {
+		min, max = math.Min(min, v), math.Max(max, v)
+	}
+
+	line := make([]rune, len(vv))
+	for i, v := range vv {
+		var l int
+		if max > min {
+			l = int(math.Round((v - min) / (max - min) * float64(len(sparkBars)-1)))
+		}
+		line[i] = sparkBars[l]
+	}
+
+	return string(line)
+}
+
+// HPADetailRes represents a HPA detail row. Key overrides the row id name
+// when names are not unique within a section.
+type HPADetailRes struct {
+	Section, Name, Key string
+	Current, Target    string
+	Warning, Info      string
+	Since              time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (HPADetailRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (h HPADetailRes) DeepCopyObject() runtime.Object {
+	return h
+}
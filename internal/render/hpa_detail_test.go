@@ -0,0 +1,69 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHPADetailRender(t *testing.T) {
+	d := render.HPADetailRes{
+		Section: render.HPASectionMetric,
+		Name:    "Resource:cpu",
+		Current: "90%",
+		Target:  "80%",
+		Warning: "above target",
+		Since:   time.Now(),
+	}
+
+	var r render.Row
+	assert.Nil(t, render.HPADetail{}.Render(d, "", &r))
+	assert.Equal(t, "METRIC|Resource:cpu", r.ID)
+	assert.Equal(t, render.Fields{"METRIC", "Resource:cpu", "90%", "80%", "above target", ""}, r.Fields[:6])
+	assert.NotEmpty(t, r.Fields[6])
+
+	d = render.HPADetailRes{Section: render.HPASectionEvent, Name: "SuccessfulRescale", Key: "fred.123", Current: "Normal"}
+	assert.Nil(t, render.HPADetail{}.Render(d, "", &r))
+	assert.Equal(t, "EVENT|fred.123", r.ID)
+	assert.Equal(t, render.Fields{"EVENT", "SuccessfulRescale", "Normal", "", "", "", ""}, r.Fields)
+}
+
+func TestHPADetailColorer(t *testing.T) {
+	defer func(e, m tcell.Color) { render.ErrColor, render.ModColor = e, m }(render.ErrColor, render.ModColor)
+	render.ErrColor, render.ModColor = tcell.ColorRed, tcell.ColorOrange
+
+	uu := map[string]struct {
+		fields render.Fields
+		e      tcell.Color
+	}{
+		"ok": {
+			fields: render.Fields{"METRIC", "Resource:cpu", "40%", "80%", "", "", ""},
+			e:      render.StdColor,
+		},
+		"over": {
+			fields: render.Fields{"METRIC", "Resource:cpu", "90%", "80%", "above target", "", ""},
+			e:      tcell.ColorOrange,
+		},
+		"condition": {
+			fields: render.Fields{"CONDITION", "ScalingActive", "False", "", "FailedGetResourceMetric", "", ""},
+			e:      tcell.ColorRed,
+		},
+	}
+
+	f := render.HPADetail{}.ColorerFunc()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, f("", render.RowEvent{Row: render.Row{Fields: u.fields}}))
+		})
+	}
+}
+
+func TestSparkLine(t *testing.T) {
+	assert.Equal(t, "", render.SparkLine(nil))
+	assert.Equal(t, "▁▁▁", render.SparkLine([]float64{2, 2, 2}))
+	assert.Equal(t, "▁▅█▁", render.SparkLine([]float64{1, 2.2, 3, 1}))
+}
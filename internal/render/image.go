@@ -0,0 +1,143 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ScanDisabled indicates no image scanner is configured.
+	ScanDisabled = "disabled"
+	// ScanPending indicates an image scan is queued or running.
+	ScanPending = "pending"
+	// ScanDone indicates an image was scanned.
+	ScanDone = "done"
+	// ScanFailed indicates an image scan failed.
+	ScanFailed = "failed"
+
+	digestLen = 19
+)
+
+// Image renders a container image inventory to screen.
+type Image struct{}
+
+// ColorerFunc colors a resource row.
+func (Image) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch {
+		case hasVulns(re.Row.Fields[7]):
+			return ErrColor
+		case hasVulns(re.Row.Fields[8]):
+			return ModColor
+		case re.Row.Fields[11] == ScanFailed:
+			return ErrColor
+		}
+
+		return DefaultColorer(ns, re)
+	}
+}
+
+// Header returns a header row.
+func (Image) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "IMAGE"},
+		Header{Name: "DIGEST"},
+		Header{Name: "INIT"},
+		Header{Name: "CONTAINERS", Align: tview.AlignRight},
+		Header{Name: "PODS", Align: tview.AlignRight},
+		Header{Name: "NAMESPACES"},
+		Header{Name: "OWNERS"},
+		Header{Name: "CRITICAL", Align: tview.AlignRight},
+		Header{Name: "HIGH", Align: tview.AlignRight},
+		Header{Name: "MEDIUM", Align: tview.AlignRight},
+		Header{Name: "LOW", Align: tview.AlignRight},
+		Header{Name: "SCAN"},
+	}
+}
+
+// Render renders an image to screen.
+func (Image) Render(o interface{}, ns string, r *Row) error {
+	i, ok := o.(ImageRes)
+	if !ok {
+		return fmt.Errorf("expecting ImageRes, but got %T", o)
+	}
+
+	r.ID = i.Image
+	if i.Digest != "" {
+		r.ID += "@" + i.Digest
+	}
+	r.Fields = Fields{
+		i.Image,
+		na(shortDigest(i.Digest)),
+		boolToStr(i.Init),
+		strconv.Itoa(i.Containers),
+		strconv.Itoa(i.Pods),
+		strings.Join(i.Namespaces, ","),
+		na(strings.Join(i.Owners, ",")),
+	}
+	r.Fields = append(r.Fields, i.Vulns.fields()...)
+	r.Fields = append(r.Fields, i.ScanStatus)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func shortDigest(d string) string {
+	if len(d) <= digestLen {
+		return d
+	}
+
+	return d[:digestLen]
+}
+
+func hasVulns(s string) bool {
+	n, err := strconv.Atoi(s)
+
+	return err == nil && n > 0
+}
+
+// VulnCounts tracks an image vulnerabilities per severity.
+type VulnCounts struct {
+	Critical, High, Medium, Low int
+}
+
+func (v *VulnCounts) fields() Fields {
+	if v == nil {
+		return Fields{NAValue, NAValue, NAValue, NAValue}
+	}
+
+	return Fields{
+		strconv.Itoa(v.Critical),
+		strconv.Itoa(v.High),
+		strconv.Itoa(v.Medium),
+		strconv.Itoa(v.Low),
+	}
+}
+
+// ImageRes represents a container image and its usage.
+type ImageRes struct {
+	Image, Digest      string
+	Init               bool
+	Containers, Pods   int
+	Namespaces, Owners []string
+	Vulns              *VulnCounts
+	ScanStatus         string
+}
+
+// GetObjectKind returns a schema object.
+func (ImageRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (i ImageRes) DeepCopyObject() runtime.Object {
+	return i
+}
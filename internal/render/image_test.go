@@ -0,0 +1,58 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageRender(t *testing.T) {
+	i := render.ImageRes{
+		Image:      "nginx:1.17",
+		Digest:     "sha256:0123456789abcdef0123",
+		Containers: 3,
+		Pods:       2,
+		Namespaces: []string{"default", "fred"},
+		Owners:     []string{"ReplicaSet/fred/rs1"},
+		Vulns:      &render.VulnCounts{Critical: 1, High: 2},
+		ScanStatus: render.ScanDone,
+	}
+
+	var r render.Row
+	assert.Nil(t, render.Image{}.Render(i, "", &r))
+	assert.Equal(t, "nginx:1.17@sha256:0123456789abcdef0123", r.ID)
+	assert.Equal(t, render.Fields{
+		"nginx:1.17",
+		"sha256:0123456789ab",
+		"false",
+		"3",
+		"2",
+		"default,fred",
+		"ReplicaSet/fred/rs1",
+		"1",
+		"2",
+		"0",
+		"0",
+		"done",
+	}, r.Fields)
+
+	i = render.ImageRes{Image: "busybox", Init: true, Containers: 1, Pods: 1, ScanStatus: render.ScanDisabled}
+	assert.Nil(t, render.Image{}.Render(i, "", &r))
+	assert.Equal(t, "busybox", r.ID)
+	assert.Equal(t, render.Fields{"busybox", "n/a", "true", "1", "1", "", "n/a", "n/a", "n/a", "n/a", "n/a", "disabled"}, r.Fields)
+}
+
+func TestImageColorer(t *testing.T) {
+	defer func(e, m tcell.Color) { render.ErrColor, render.ModColor = e, m }(render.ErrColor, render.ModColor)
+	render.ErrColor, render.ModColor = tcell.ColorRed, tcell.ColorOrange
+
+	f := render.Image{}.ColorerFunc()
+	row := func(crit, high, scan string) render.RowEvent {
+		return render.RowEvent{Row: render.Row{Fields: render.Fields{"nginx", "", "false", "1", "1", "", "", crit, high, "0", "0", scan}}}
+	}
+	assert.Equal(t, tcell.ColorRed, f("", row("1", "0", "done")))
+	assert.Equal(t, tcell.ColorOrange, f("", row("0", "3", "done")))
+	assert.Equal(t, tcell.ColorRed, f("", row("n/a", "n/a", "failed")))
+}
@@ -0,0 +1,91 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Informer renders an informer diagnostics to screen.
+type Informer struct{}
+
+// ColorerFunc colors a resource row.
+func (Informer) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, re)
+		switch {
+		case re.Row.Fields[2] == watch.ListMode:
+			return HighlightColor
+		case re.Row.Fields[5] != "true":
+			return ModColor
+		case re.Row.Fields[3] == "0":
+			return CompletedColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Informer) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "RESOURCE"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "MODE"},
+		Header{Name: "REFS", Align: tview.AlignRight},
+		Header{Name: "ITEMS", Align: tview.AlignRight},
+		Header{Name: "SYNCED"},
+		Header{Name: "IDLE"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Informer) Render(o interface{}, ns string, r *Row) error {
+	i, ok := o.(InformerRes)
+	if !ok {
+		return fmt.Errorf("expecting InformerRes, but got %T", o)
+	}
+
+	st := i.Stats
+	ns = st.Namespace
+	if ns == "" {
+		ns = NamespaceAll
+	}
+	r.ID = ns + ":" + st.GVR
+	r.Fields = Fields{
+		st.GVR,
+		ns,
+		st.Mode,
+		strconv.Itoa(st.Refs),
+		strconv.Itoa(st.Items),
+		boolToStr(st.Synced),
+		toAgeHuman(st.Idle.String()),
+		st.Age.String(),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// InformerRes represents an informer diagnostics.
+type InformerRes struct {
+	Stats watch.InformerStats
+}
+
+// GetObjectKind returns a schema object.
+func (InformerRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (i InformerRes) DeepCopyObject() runtime.Object {
+	return i
+}
@@ -0,0 +1,28 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInformerRender(t *testing.T) {
+	var i render.Informer
+
+	o := render.InformerRes{Stats: watch.InformerStats{
+		GVR:    "v1/pods",
+		Mode:   watch.WatchMode,
+		Refs:   1,
+		Items:  42,
+		Synced: true,
+		Idle:   2 * time.Minute,
+		Age:    10 * time.Minute,
+	}}
+	var r render.Row
+	assert.Nil(t, i.Render(o, "", &r))
+	assert.Equal(t, "all:v1/pods", r.ID)
+	assert.Equal(t, render.Fields{"v1/pods", "all", "watch", "1", "42", "true", "2m", "10m0s"}, r.Fields)
+}
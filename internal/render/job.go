@@ -6,11 +6,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/duration"
 )
 
@@ -19,7 +21,15 @@ type Job struct{}
 
 // ColorerFunc colors a resource row.
 func (Job) ColorerFunc() ColorerFunc {
-	return DefaultColorer
+	return func(ns string, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, re)
+		// Failure is the second column from the end.
+		if c == StdColor && re.Row.Fields[len(re.Row.Fields)-2] != "" {
+			return ErrColor
+		}
+
+		return c
+	}
 }
 
 // Header returns a header row.
@@ -35,6 +45,7 @@ func (Job) Header(ns string) HeaderRow {
 		Header{Name: "DURATION"},
 		Header{Name: "CONTAINERS"},
 		Header{Name: "IMAGES"},
+		Header{Name: "FAILURE"},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
@@ -42,8 +53,16 @@ func (Job) Header(ns string) HeaderRow {
 // Render renders a K8s resource to screen.
 func (j Job) Render(o interface{}, ns string, r *Row) error {
 	log.Debug().Msgf("JOB RENDER %q", ns)
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
+	var (
+		raw     *unstructured.Unstructured
+		failure string
+	)
+	switch j := o.(type) {
+	case *unstructured.Unstructured:
+		raw = j
+	case *JobWithFailure:
+		raw, failure = j.Raw, j.Failure
+	default:
 		return fmt.Errorf("Expected Job, but got %T", o)
 	}
 	var job batchv1.Job
@@ -64,12 +83,29 @@ func (j Job) Render(o interface{}, ns string, r *Row) error {
 		toDuration(job.Status),
 		cc,
 		ii,
+		failure,
 		toAge(job.ObjectMeta.CreationTimestamp),
 	)
 
 	return nil
 }
 
+// JobWithFailure represents a job and the reason it failed if any.
+type JobWithFailure struct {
+	Raw     *unstructured.Unstructured
+	Failure string
+}
+
+// GetObjectKind returns a schema object.
+func (j *JobWithFailure) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (j *JobWithFailure) DeepCopyObject() runtime.Object {
+	return j
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,3 +16,24 @@ func TestJobRender(t *testing.T) {
 	assert.Equal(t, "default/hello-1567179180", r.ID)
 	assert.Equal(t, render.Fields{"default", "hello-1567179180", "1/1", "8s", "c1", "blang/busybox-bash"}, r.Fields[:6])
 }
+
+func TestJobWithFailureRender(t *testing.T) {
+	c := render.Job{}
+	var r render.Row
+	assert.Nil(t, c.Render(&render.JobWithFailure{Raw: load(t, "job"), Failure: "fred/c1: Error (exit 1)"}, "", &r))
+
+	assert.Equal(t, "default/hello-1567179180", r.ID)
+	assert.Equal(t, "fred/c1: Error (exit 1)", r.Fields[6])
+}
+
+func TestJobColorer(t *testing.T) {
+	defer func(c tcell.Color) { render.ErrColor = c }(render.ErrColor)
+	render.ErrColor = tcell.ColorRed
+
+	f := render.Job{}.ColorerFunc()
+	ok := render.RowEvent{Row: render.Row{Fields: render.Fields{"fred", "1/1", "", "2m"}}}
+	ko := render.RowEvent{Row: render.Row{Fields: render.Fields{"fred", "0/1", "fred/c1: Error (exit 1)", "2m"}}}
+
+	assert.Equal(t, render.StdColor, f("", ok))
+	assert.Equal(t, tcell.ColorRed, f("", ko))
+}
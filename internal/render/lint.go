@@ -0,0 +1,100 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// LintIDSep separates a lint finding identifier parts.
+const LintIDSep = "|"
+
+// Lint renders lint findings to screen.
+type Lint struct{}
+
+// ColorerFunc colors a resource row.
+func (Lint) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		col := 1
+		if !isAllNamespace(ns) {
+			col--
+		}
+		switch re.Row.Fields[col] {
+		case "ERROR":
+			return ErrColor
+		case "WARN":
+			return ModColor
+		default:
+			return DefaultColorer(ns, re)
+		}
+	}
+}
+
+// Header returns a header row.
+func (Lint) Header(ns string) HeaderRow {
+	var h HeaderRow
+	if isAllNamespace(ns) {
+		h = append(h, Header{Name: "NAMESPACE"})
+	}
+
+	return append(h,
+		Header{Name: "SEVERITY"},
+		Header{Name: "SCORE", Align: tview.AlignRight},
+		Header{Name: "RULE"},
+		Header{Name: "RESOURCE"},
+		Header{Name: "NAME"},
+		Header{Name: "MESSAGE"},
+	)
+}
+
+// Render renders a lint finding to screen.
+func (Lint) Render(o interface{}, ns string, r *Row) error {
+	l, ok := o.(LintRes)
+	if !ok {
+		return fmt.Errorf("expecting LintRes, but got %T", o)
+	}
+
+	r.ID = strings.Join([]string{l.GVR, client.FQN(l.Namespace, l.Name), l.Rule, l.Message}, LintIDSep)
+	r.Fields = make(Fields, 0, len(Lint{}.Header(ns)))
+	if isAllNamespace(ns) {
+		r.Fields = append(r.Fields, na(l.Namespace))
+	}
+	r.Fields = append(r.Fields,
+		l.Severity,
+		strconv.Itoa(l.Score),
+		l.Rule,
+		l.GVR,
+		l.Name,
+		l.Message,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// LintRes represents a lint finding.
+type LintRes struct {
+	Namespace, Name string
+	GVR             string
+	Rule, Severity  string
+	Message         string
+	Score           int
+}
+
+// GetObjectKind returns a schema object.
+func (LintRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (l LintRes) DeepCopyObject() runtime.Object {
+	return l
+}
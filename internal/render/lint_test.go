@@ -0,0 +1,51 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintRender(t *testing.T) {
+	l := render.LintRes{
+		Namespace: "fred",
+		Name:      "blee",
+		GVR:       "v1/pods",
+		Rule:      "pod-probes",
+		Severity:  "WARN",
+		Message:   "no probes",
+		Score:     80,
+	}
+
+	var r render.Row
+	assert.Nil(t, render.Lint{}.Render(l, "", &r))
+	assert.Equal(t, "v1/pods|fred/blee|pod-probes|no probes", r.ID)
+	assert.Equal(t, render.Fields{"fred", "WARN", "80", "pod-probes", "v1/pods", "blee", "no probes"}, r.Fields)
+
+	assert.Nil(t, render.Lint{}.Render(l, "fred", &r))
+	assert.Equal(t, render.Fields{"WARN", "80", "pod-probes", "v1/pods", "blee", "no probes"}, r.Fields)
+}
+
+func TestLintColorer(t *testing.T) {
+	defer func(e, m tcell.Color) { render.ErrColor, render.ModColor = e, m }(render.ErrColor, render.ModColor)
+	render.ErrColor, render.ModColor = tcell.ColorRed, tcell.ColorYellow
+
+	uu := map[string]struct {
+		ns     string
+		fields render.Fields
+		e      tcell.Color
+	}{
+		"error": {ns: "fred", fields: render.Fields{"ERROR"}, e: tcell.ColorRed},
+		"warn":  {ns: "", fields: render.Fields{"fred", "WARN"}, e: tcell.ColorYellow},
+	}
+
+	f := render.Lint{}.ColorerFunc()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, f(u.ns, render.RowEvent{Row: render.Row{Fields: u.fields}}))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package render
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ManifestErr prefixes failed manifests results.
+const ManifestErr = "error: "
+
+// Manifest renders a local manifest resource to screen.
+type Manifest struct{}
+
+// ColorerFunc colors a resource row.
+func (Manifest) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		res := re.Row.Fields[4]
+		switch {
+		case strings.HasPrefix(res, ManifestErr):
+			return ErrColor
+		case strings.HasPrefix(res, "created"):
+			return AddColor
+		case strings.HasPrefix(res, "configured"):
+			return ModColor
+		case re.Row.Fields[3] == "create":
+			return HighlightColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Manifest) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "KIND"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "ACTION"},
+		Header{Name: "RESULT"},
+		Header{Name: "FILE"},
+	}
+}
+
+// Render renders a manifest resource to screen.
+func (Manifest) Render(o interface{}, ns string, r *Row) error {
+	m, ok := o.(ManifestRes)
+	if !ok {
+		return fmt.Errorf("expecting ManifestRes, but got %T", o)
+	}
+
+	r.ID = m.ID
+	r.Fields = Fields{
+		m.Kind,
+		na(m.Namespace),
+		m.Name,
+		m.Action,
+		m.Result,
+		filepath.Base(m.Path),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ManifestRes represents a local manifest resource.
+type ManifestRes struct {
+	ID, Kind, Namespace, Name string
+	Path, Action, Result      string
+}
+
+// GetObjectKind returns a schema object.
+func (ManifestRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (m ManifestRes) DeepCopyObject() runtime.Object {
+	return m
+}
@@ -0,0 +1,66 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestRender(t *testing.T) {
+	var (
+		m render.Manifest
+		r render.Row
+	)
+	o := render.ManifestRes{
+		ID:     "deployment/default/fred",
+		Kind:   "Deployment",
+		Name:   "fred",
+		Path:   "/tmp/k8s/dp.yaml",
+		Action: "create",
+	}
+
+	assert.Nil(t, m.Render(o, "", &r))
+	assert.Equal(t, "deployment/default/fred", r.ID)
+	assert.Equal(t, render.Fields{"Deployment", "n/a", "fred", "create", "", "dp.yaml"}, r.Fields)
+}
+
+func TestManifestColorer(t *testing.T) {
+	defer func(std, hi, add, mod, err tcell.Color) {
+		render.StdColor, render.HighlightColor, render.AddColor, render.ModColor, render.ErrColor = std, hi, add, mod, err
+	}(render.StdColor, render.HighlightColor, render.AddColor, render.ModColor, render.ErrColor)
+	render.StdColor, render.HighlightColor = tcell.ColorWhite, tcell.ColorAqua
+	render.AddColor, render.ModColor, render.ErrColor = tcell.ColorBlue, tcell.ColorGreen, tcell.ColorRed
+
+	uu := map[string]struct {
+		action, result string
+		e              tcell.Color
+	}{
+		"create":  {"create", "", tcell.ColorAqua},
+		"update":  {"update", "", tcell.ColorWhite},
+		"created": {"create", "created", tcell.ColorBlue},
+		"updated": {"update", "configured (dry run)", tcell.ColorGreen},
+		"failed":  {"update", render.ManifestErr + "boom", tcell.ColorRed},
+	}
+
+	var m render.Manifest
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			re := render.RowEvent{Row: render.Row{Fields: render.Fields{"Pod", "", "p1", u.action, u.result, ""}}}
+			assert.Equal(t, u.e, m.ColorerFunc()("", re))
+		})
+	}
+}
+
+func TestFileRender(t *testing.T) {
+	var (
+		f render.File
+		r render.Row
+	)
+
+	assert.Nil(t, f.Render(render.FileRes{File: fileInfo{}, Dir: "/tmp"}, "", &r))
+	assert.Equal(t, "/tmp/bob", r.ID)
+	assert.Equal(t, render.Fields{"bob", render.FileManifest, "100"}, r.Fields[:3])
+}
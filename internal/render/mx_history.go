@@ -0,0 +1,88 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// MetricsHistory renders a metrics history series to screen.
+type MetricsHistory struct{}
+
+// ColorerFunc colors a resource row.
+func (MetricsHistory) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if re.Row.Fields[1] == client.NodeSeries {
+			return HighlightColor
+		}
+
+		return DefaultColorer(ns, re)
+	}
+}
+
+// Header returns a header row.
+func (MetricsHistory) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAME"},
+		Header{Name: "KIND"},
+		Header{Name: "SAMPLES", Align: tview.AlignRight},
+		Header{Name: "CPU-MIN", Align: tview.AlignRight},
+		Header{Name: "CPU-AVG", Align: tview.AlignRight},
+		Header{Name: "CPU-MAX", Align: tview.AlignRight},
+		Header{Name: "CPU-P95", Align: tview.AlignRight},
+		Header{Name: "MEM-MIN", Align: tview.AlignRight},
+		Header{Name: "MEM-AVG", Align: tview.AlignRight},
+		Header{Name: "MEM-MAX", Align: tview.AlignRight},
+		Header{Name: "MEM-P95", Align: tview.AlignRight},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (MetricsHistory) Render(o interface{}, ns string, r *Row) error {
+	h, ok := o.(MetricsHistoryRes)
+	if !ok {
+		return fmt.Errorf("expecting MetricsHistoryRes, but got %T", o)
+	}
+
+	cpu, mem := h.Stats.CPU, h.Stats.MEM
+	r.ID = h.FQN
+	r.Fields = Fields{
+		h.FQN,
+		h.Kind,
+		strconv.Itoa(h.Stats.Count),
+		ToMillicore(int64(cpu.Min)),
+		ToMillicore(int64(cpu.Avg)),
+		ToMillicore(int64(cpu.Max)),
+		ToMillicore(int64(cpu.P95)),
+		ToMi(mem.Min),
+		ToMi(mem.Avg),
+		ToMi(mem.Max),
+		ToMi(mem.P95),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// MetricsHistoryRes represents a metrics series summary.
+type MetricsHistoryRes struct {
+	Kind, FQN string
+	Stats     client.SeriesStats
+}
+
+// GetObjectKind returns a schema object.
+func (MetricsHistoryRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (m MetricsHistoryRes) DeepCopyObject() runtime.Object {
+	return m
+}
@@ -0,0 +1,27 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsHistoryRender(t *testing.T) {
+	var m render.MetricsHistory
+
+	o := render.MetricsHistoryRes{
+		Kind: client.PodSeries,
+		FQN:  "default/fred",
+		Stats: client.SeriesStats{
+			Count: 10,
+			CPU:   client.Stats{Min: 1, Avg: 5.5, Max: 10, P95: 10},
+			MEM:   client.Stats{Min: 20, Avg: 25, Max: 30, P95: 29.8},
+		},
+	}
+	var r render.Row
+	assert.Nil(t, m.Render(o, "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, render.Fields{"default/fred", "pod", "10", "1", "5", "10", "10", "20", "25", "30", "29"}, r.Fields)
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -22,7 +23,9 @@ type Node struct{}
 
 // ColorerFunc colors a resource row.
 func (Node) ColorerFunc() ColorerFunc {
-	return DefaultColorer
+	return func(ns string, re RowEvent) tcell.Color {
+		return thresholdColor(re.Row.Fields, []int{9, 13, 15}, []int{10, 14, 16}, DefaultColorer(ns, re))
+	}
 }
 
 // Header returns a header row.
@@ -41,6 +44,10 @@ func (Node) Header(_ string) HeaderRow {
 		Header{Name: "%MEM", Align: tview.AlignRight},
 		Header{Name: "ACPU", Align: tview.AlignRight},
 		Header{Name: "AMEM", Align: tview.AlignRight},
+		Header{Name: "%CPU/R", Align: tview.AlignRight},
+		Header{Name: "%MEM/R", Align: tview.AlignRight},
+		Header{Name: "%CPU/L", Align: tview.AlignRight},
+		Header{Name: "%MEM/L", Align: tview.AlignRight},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	}
 }
@@ -67,6 +74,7 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 	iIP, eIP = missing(iIP), missing(eIP)
 
 	c, a, p := gatherNodeMX(&no, oo.MX)
+	preq, plim := gatherNodeAlloc(&no, oo.Requests, oo.Limits)
 
 	sta := make([]string, 10)
 	status(no.Status, no.Spec.Unschedulable, sta)
@@ -89,6 +97,10 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 		p.mem,
 		a.cpu,
 		a.mem,
+		preq.cpu,
+		preq.mem,
+		plim.cpu,
+		plim.mem,
 		toAge(no.ObjectMeta.CreationTimestamp),
 	)
 
@@ -102,6 +114,8 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 type NodeWithMetrics struct {
 	Raw *unstructured.Unstructured
 	MX  *mv1beta1.NodeMetrics
+	// Requests and Limits track the node pods allocated resources.
+	Requests, Limits v1.ResourceList
 }
 
 // GetObjectKind returns a schema object.
@@ -142,6 +156,27 @@ func gatherNodeMX(no *v1.Node, mx *mv1beta1.NodeMetrics) (c metric, a metric, p
 	return
 }
 
+// GatherNodeAlloc computes allocated requests/limits vs allocatable percentages.
+func gatherNodeAlloc(no *v1.Node, req, lim v1.ResourceList) (r metric, l metric) {
+	r, l = noMetric(), noMetric()
+	if req == nil && lim == nil {
+		return
+	}
+
+	acpu := float64(no.Status.Allocatable.Cpu().MilliValue())
+	amem := ToMB(no.Status.Allocatable.Memory().Value())
+	r = metric{
+		cpu: percOf(float64(req.Cpu().MilliValue()), acpu),
+		mem: percOf(ToMB(req.Memory().Value()), amem),
+	}
+	l = metric{
+		cpu: percOf(float64(lim.Cpu().MilliValue()), acpu),
+		mem: percOf(ToMB(lim.Memory().Value()), amem),
+	}
+
+	return
+}
+
 func nodeRoles(node *v1.Node, res []string) {
 	index := 0
 	for k, v := range node.Labels {
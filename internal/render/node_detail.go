@@ -0,0 +1,141 @@
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// NodeSectionCapacity tracks node capacity rows.
+	NodeSectionCapacity = "CAPACITY"
+	// NodeSectionCondition tracks node condition rows.
+	NodeSectionCondition = "CONDITION"
+	// NodeSectionTaint tracks node taint rows.
+	NodeSectionTaint = "TAINT"
+	// NodeSectionLabel tracks node label rows.
+	NodeSectionLabel = "LABEL"
+	// NodeSectionPod tracks rows for pods scheduled on the node.
+	NodeSectionPod = "POD"
+
+	// NodeIDSep separates a node detail row section from its name.
+	NodeIDSep = "|"
+)
+
+// NodeDetail renders a node details to screen.
+type NodeDetail struct{}
+
+// ColorerFunc colors a resource row.
+func (NodeDetail) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch re.Row.Fields[0] {
+		case NodeSectionCondition:
+			if conditionFailing(v1.NodeConditionType(re.Row.Fields[1]), re.Row.Fields[2]) {
+				return ErrColor
+			}
+		case NodeSectionCapacity:
+			if re.Row.Fields[9] != "" {
+				return ErrColor
+			}
+		case NodeSectionTaint:
+			return ModColor
+		}
+
+		return DefaultColorer(ns, re)
+	}
+}
+
+// Header returns a header row.
+func (NodeDetail) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "SECTION"},
+		Header{Name: "NAME"},
+		Header{Name: "VALUE"},
+		Header{Name: "CPU/R", Align: tview.AlignRight},
+		Header{Name: "CPU/L", Align: tview.AlignRight},
+		Header{Name: "CPU", Align: tview.AlignRight},
+		Header{Name: "MEM/R", Align: tview.AlignRight},
+		Header{Name: "MEM/L", Align: tview.AlignRight},
+		Header{Name: "MEM", Align: tview.AlignRight},
+		Header{Name: "WARNING"},
+		Header{Name: "INFO"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a node detail to screen.
+func (NodeDetail) Render(o interface{}, ns string, r *Row) error {
+	d, ok := o.(NodeDetailRes)
+	if !ok {
+		return fmt.Errorf("expecting NodeDetailRes, but got %T", o)
+	}
+
+	r.ID = d.Section + NodeIDSep + d.Name
+	r.Fields = append(Fields{d.Section, d.Name, d.Value}, d.Alloc.fields()...)
+	age := ""
+	if !d.Since.IsZero() {
+		age = timeToAge(d.Since)
+	}
+	r.Fields = append(r.Fields, d.Warning, d.Info, age)
+
+	return nil
+}
+
+func conditionFailing(t v1.NodeConditionType, status string) bool {
+	if t == v1.NodeReady {
+		return status != string(v1.ConditionTrue)
+	}
+
+	return status == string(v1.ConditionTrue)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NodeAlloc represents resources allocations on a node. Usage is nil when
+// metrics are not available.
+type NodeAlloc struct {
+	Requests, Limits, Usage v1.ResourceList
+}
+
+func (a *NodeAlloc) fields() Fields {
+	if a == nil {
+		return Fields{"", "", "", "", "", ""}
+	}
+	cpu, mem := NAValue, NAValue
+	if a.Usage != nil {
+		cpu, mem = ToMillicore(a.Usage.Cpu().MilliValue()), ToMi(ToMB(a.Usage.Memory().Value()))
+	}
+
+	return Fields{
+		ToMillicore(a.Requests.Cpu().MilliValue()),
+		ToMillicore(a.Limits.Cpu().MilliValue()),
+		cpu,
+		ToMi(ToMB(a.Requests.Memory().Value())),
+		ToMi(ToMB(a.Limits.Memory().Value())),
+		mem,
+	}
+}
+
+// NodeDetailRes represents a node detail row.
+type NodeDetailRes struct {
+	Section, Name, Value string
+	Alloc                *NodeAlloc
+	Warning, Info        string
+	Since                time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (NodeDetailRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n NodeDetailRes) DeepCopyObject() runtime.Object {
+	return n
+}
@@ -0,0 +1,76 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestNodeDetailRender(t *testing.T) {
+	d := render.NodeDetailRes{
+		Section: render.NodeSectionPod,
+		Name:    "fred/blee",
+		Value:   "Running",
+		Alloc: &render.NodeAlloc{
+			Requests: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("100m"),
+				v1.ResourceMemory: resource.MustParse("20Mi"),
+			},
+			Limits: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("200m"),
+				v1.ResourceMemory: resource.MustParse("40Mi"),
+			},
+		},
+		Info:  "qos Burstable",
+		Since: time.Now(),
+	}
+
+	var r render.Row
+	assert.Nil(t, render.NodeDetail{}.Render(d, "", &r))
+	assert.Equal(t, "POD|fred/blee", r.ID)
+	assert.Equal(t, render.Fields{"POD", "fred/blee", "Running", "100", "200", "n/a", "20", "40", "n/a", "", "qos Burstable"}, r.Fields[:11])
+	assert.NotEmpty(t, r.Fields[11])
+
+	d = render.NodeDetailRes{Section: render.NodeSectionLabel, Name: "zone", Value: "us-east-1"}
+	assert.Nil(t, render.NodeDetail{}.Render(d, "", &r))
+	assert.Equal(t, render.Fields{"LABEL", "zone", "us-east-1", "", "", "", "", "", "", "", "", ""}, r.Fields)
+}
+
+func TestNodeDetailColorer(t *testing.T) {
+	defer func(c tcell.Color) { render.ErrColor = c }(render.ErrColor)
+	render.ErrColor = tcell.ColorRed
+
+	uu := map[string]struct {
+		fields render.Fields
+		e      tcell.Color
+	}{
+		"notReady": {
+			fields: render.Fields{"CONDITION", "Ready", "False", "", "", "", "", "", "", "", "", ""},
+			e:      tcell.ColorRed,
+		},
+		"pressure": {
+			fields: render.Fields{"CONDITION", "MemoryPressure", "True", "", "", "", "", "", "", "", "", ""},
+			e:      tcell.ColorRed,
+		},
+		"maxPods": {
+			fields: render.Fields{"CAPACITY", "pods", "110/110", "", "", "", "", "", "", "max pods reached", "", ""},
+			e:      tcell.ColorRed,
+		},
+		"ok": {
+			fields: render.Fields{"CONDITION", "DiskPressure", "False", "", "", "", "", "", "", "", "", ""},
+		},
+	}
+
+	f := render.NodeDetail{}.ColorerFunc()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, f("", render.RowEvent{Row: render.Row{Fields: u.fields}}))
+		})
+	}
+}
@@ -25,6 +25,39 @@ func TestNodeRender(t *testing.T) {
 	assert.Equal(t, e, r.Fields[:13])
 }
 
+func TestNodeRenderAllocations(t *testing.T) {
+	pom := render.NodeWithMetrics{
+		Raw:      load(t, "no"),
+		MX:       makeNodeMX("n1", "10m", "10Mi"),
+		Requests: makeRes("1", "3937Mi"),
+		Limits:   makeRes("6", "7874Mi"),
+	}
+
+	var no render.Node
+	r := render.NewRow(18)
+	assert.Nil(t, no.Render(&pom, "", &r))
+	assert.Equal(t, render.Fields{"25", "49", "150", "99"}, r.Fields[13:17])
+}
+
+func TestNodeColorer(t *testing.T) {
+	var (
+		ok   = render.Row{Fields: render.Fields{"n1", "Ready", "", "", "", "", "", "", "", "10", "10", "", "", "10", "10", "10", "10"}}
+		warn = render.Row{Fields: render.Fields{"n1", "Ready", "", "", "", "", "", "", "", "10", "10", "", "", "75", "10", "10", "10"}}
+		crit = render.Row{Fields: render.Fields{"n1", "Ready", "", "", "", "", "", "", "", "10", "10", "", "", "10", "10", "10", "150"}}
+	)
+
+	uu := colorerUCs{
+		{"", render.RowEvent{Kind: render.EventAdd, Row: ok}, render.AddColor},
+		{"", render.RowEvent{Kind: render.EventUpdate, Row: warn}, render.HighlightColor},
+		{"", render.RowEvent{Kind: render.EventUpdate, Row: crit}, render.ErrColor},
+	}
+
+	f := render.Node{}.ColorerFunc()
+	for _, u := range uu {
+		assert.Equal(t, u.e, f(u.ns, u.r))
+	}
+}
+
 func BenchmarkNodeRender(b *testing.B) {
 	pom := render.NodeWithMetrics{
 		Raw: load(b, "no"),
@@ -0,0 +1,74 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Owned renders resources owned by another resource to screen.
+type Owned struct{}
+
+// ColorerFunc colors a resource row.
+func (Owned) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if re.Row.Fields[4] == "true" {
+			return HighlightColor
+		}
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Owned) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "KIND"},
+		Header{Name: "GROUP"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "CONTROLLER"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders an owned resource to screen.
+func (Owned) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(OwnedRes)
+	if !ok {
+		return fmt.Errorf("expecting OwnedRes, but got %T", o)
+	}
+
+	r.ID = string(res.Object.GetUID())
+	r.Fields = Fields{
+		res.Object.GetKind(),
+		na(res.Object.GroupVersionKind().Group),
+		na(res.Object.GetNamespace()),
+		res.Object.GetName(),
+		boolToStr(res.Controller),
+		toAge(res.Object.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// OwnedRes represents a resource owned by another resource.
+type OwnedRes struct {
+	Object     *unstructured.Unstructured
+	Controller bool
+}
+
+// GetObjectKind returns a schema object.
+func (OwnedRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (o OwnedRes) DeepCopyObject() runtime.Object {
+	return o
+}
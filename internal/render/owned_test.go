@@ -0,0 +1,26 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestOwnedRender(t *testing.T) {
+	var (
+		o render.Owned
+		r render.Row
+	)
+	u := unstructured.Unstructured{}
+	u.SetAPIVersion("apps/v1")
+	u.SetKind("ReplicaSet")
+	u.SetNamespace("fred")
+	u.SetName("rs1")
+	u.SetUID("u1")
+
+	assert.Nil(t, o.Render(render.OwnedRes{Object: &u, Controller: true}, "", &r))
+	assert.Equal(t, "u1", r.ID)
+	assert.Equal(t, render.Fields{"ReplicaSet", "apps", "fred", "rs1", "true"}, r.Fields[:5])
+}
@@ -41,6 +41,8 @@ func (p Pod) ColorerFunc() ColorerFunc {
 		case Completed:
 			c = CompletedColor
 		case Running:
+			percCol := readyCol + 9
+			c = thresholdColor(re.Row.Fields, []int{percCol, percCol + 2}, []int{percCol + 1, percCol + 3}, c)
 		case Terminating:
 			c = KillColor
 		default:
@@ -77,11 +79,18 @@ func (Pod) Header(ns string) HeaderRow {
 		Header{Name: "RS", Align: tview.AlignRight},
 		Header{Name: "CPU", Align: tview.AlignRight},
 		Header{Name: "MEM", Align: tview.AlignRight},
-		Header{Name: "%CPU", Align: tview.AlignRight},
-		Header{Name: "%MEM", Align: tview.AlignRight},
+		Header{Name: "CPU/R", Align: tview.AlignRight},
+		Header{Name: "MEM/R", Align: tview.AlignRight},
+		Header{Name: "CPU/L", Align: tview.AlignRight},
+		Header{Name: "MEM/L", Align: tview.AlignRight},
+		Header{Name: "%CPU/R", Align: tview.AlignRight},
+		Header{Name: "%MEM/R", Align: tview.AlignRight},
+		Header{Name: "%CPU/L", Align: tview.AlignRight},
+		Header{Name: "%MEM/L", Align: tview.AlignRight},
 		Header{Name: "IP"},
 		Header{Name: "NODE"},
 		Header{Name: "QOS"},
+		Header{Name: "TREND"},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
@@ -101,7 +110,7 @@ func (p Pod) Render(o interface{}, ns string, r *Row) error {
 
 	ss := po.Status.ContainerStatuses
 	cr, _, rc := p.statuses(ss)
-	c, perc := p.gatherPodMX(&po, oo.MX)
+	mx := p.gatherPodMX(&po, oo.MX)
 
 	r.ID = MetaFQN(po.ObjectMeta)
 	r.Fields = make(Fields, 0, len(p.Header(ns)))
@@ -113,13 +122,20 @@ func (p Pod) Render(o interface{}, ns string, r *Row) error {
 		strconv.Itoa(cr)+"/"+strconv.Itoa(len(ss)),
 		p.phase(&po),
 		strconv.Itoa(rc),
-		c.cpu,
-		c.mem,
-		perc.cpu,
-		perc.mem,
+		mx.usage.cpu,
+		mx.usage.mem,
+		mx.req.cpu,
+		mx.req.mem,
+		mx.lim.cpu,
+		mx.lim.mem,
+		mx.percReq.cpu,
+		mx.percReq.mem,
+		mx.percLim.cpu,
+		mx.percLim.mem,
 		na(po.Status.PodIP),
 		na(po.Spec.NodeName),
 		p.mapQOS(po.Status.QOSClass),
+		na(SparkLine(oo.Trend)),
 		toAge(po.ObjectMeta.CreationTimestamp),
 	)
 
@@ -129,10 +145,12 @@ func (p Pod) Render(o interface{}, ns string, r *Row) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
-// PodWithMetrics represents a pod and its metrics.
+// PodWithMetrics represents a pod and its metrics. Trend holds the pod
+// recent cpu samples if any.
 type PodWithMetrics struct {
-	Raw *unstructured.Unstructured
-	MX  *mv1beta1.PodMetrics
+	Raw   *unstructured.Unstructured
+	MX    *mv1beta1.PodMetrics
+	Trend []float64
 }
 
 // GetObjectKind returns a schema object.
@@ -145,27 +163,89 @@ func (p *PodWithMetrics) DeepCopyObject() runtime.Object {
 	return p
 }
 
-func (*Pod) gatherPodMX(pod *v1.Pod, mx *mv1beta1.PodMetrics) (c, p metric) {
-	c, p = noMetric(), noMetric()
+// PodMX tracks a pod usage, requests and limits.
+type podMX struct {
+	usage, req, lim, percReq, percLim metric
+}
+
+func (*Pod) gatherPodMX(pod *v1.Pod, mx *mv1beta1.PodMetrics) podMX {
+	rr, ll := PodResources(pod)
+	rc, rm := rr.Cpu(), rr.Memory()
+	lc, lm := ll.Cpu(), ll.Memory()
+	pmx := podMX{
+		usage:   noMetric(),
+		req:     resourcesMetric(rr),
+		lim:     resourcesMetric(ll),
+		percReq: noMetric(),
+		percLim: noMetric(),
+	}
 	if mx == nil {
-		return
+		return pmx
 	}
 
 	cpu, mem := currentRes(mx)
-	c = metric{
+	pmx.usage = metric{
 		cpu: ToMillicore(cpu.MilliValue()),
 		mem: ToMi(ToMB(mem.Value())),
 	}
+	pmx.percReq = metric{
+		cpu: percOf(float64(cpu.MilliValue()), float64(rc.MilliValue())),
+		mem: percOf(ToMB(mem.Value()), ToMB(rm.Value())),
+	}
+	pmx.percLim = metric{
+		cpu: percOf(float64(cpu.MilliValue()), float64(lc.MilliValue())),
+		mem: percOf(ToMB(mem.Value()), ToMB(lm.Value())),
+	}
+
+	return pmx
+}
 
-	rc, rm := requestedRes(pod)
-	p = metric{
-		cpu: AsPerc(toPerc(float64(cpu.MilliValue()), float64(rc.MilliValue()))),
-		mem: AsPerc(toPerc(ToMB(mem.Value()), ToMB(rm.Value()))),
+// PodResources returns a pod total cpu/mem requests and limits.
+func PodResources(po *v1.Pod) (req, lim v1.ResourceList) {
+	req, lim = make(v1.ResourceList), make(v1.ResourceList)
+	for _, co := range po.Spec.Containers {
+		addResources(req, co.Resources.Requests)
+		addResources(lim, co.Resources.Limits)
 	}
 
 	return
 }
 
+func addResources(acc, rl v1.ResourceList) {
+	for _, n := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+		q, ok := rl[n]
+		if !ok {
+			continue
+		}
+		if v, ok := acc[n]; ok {
+			q.Add(v)
+		}
+		acc[n] = q
+	}
+}
+
+// ResourcesMetric renders cpu/mem quantities or n/a when not set.
+func resourcesMetric(rl v1.ResourceList) metric {
+	m := noMetric()
+	if q, ok := rl[v1.ResourceCPU]; ok {
+		m.cpu = ToMillicore(q.MilliValue())
+	}
+	if q, ok := rl[v1.ResourceMemory]; ok {
+		m.mem = ToMi(ToMB(q.Value()))
+	}
+
+	return m
+}
+
+// PercOf returns a percentage or n/a if the base is not set.
+func percOf(v, base float64) string {
+	if base == 0 {
+		return NAValue
+	}
+
+	return AsPerc(toPerc(v, base))
+}
+
 func containerResources(co v1.Container) (cpu, mem *resource.Quantity) {
 	req, limit := co.Resources.Requests, co.Resources.Limits
 
@@ -179,19 +259,6 @@ func containerResources(co v1.Container) (cpu, mem *resource.Quantity) {
 	return
 }
 
-func requestedRes(po *v1.Pod) (cpu, mem resource.Quantity) {
-	for _, co := range po.Spec.Containers {
-		c, m := containerResources(co)
-		if c != nil {
-			cpu.Add(*c)
-		}
-		if m != nil {
-			mem.Add(*m)
-		}
-	}
-	return
-}
-
 func currentRes(mx *mv1beta1.PodMetrics) (cpu, mem resource.Quantity) {
 	for _, co := range mx.Containers {
 		c, m := co.Usage.Cpu(), co.Usage.Memory()
@@ -0,0 +1,97 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PodExtras renders additional pod metrics to screen.
+type PodExtras struct{}
+
+// ColorerFunc colors a resource row.
+func (PodExtras) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, re)
+		col := 3
+		if isAllNamespace(ns) {
+			col++
+		}
+		if len(re.Row.Fields) <= col {
+			return c
+		}
+		if rate, err := strconv.ParseFloat(re.Row.Fields[col], 64); err == nil && rate > 0 {
+			c = ErrColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (PodExtras) Header(ns string) HeaderRow {
+	var h HeaderRow
+	if isAllNamespace(ns) {
+		h = append(h, Header{Name: "NAMESPACE"})
+	}
+
+	return append(h,
+		Header{Name: "NAME"},
+		Header{Name: "CPU", Align: tview.AlignRight},
+		Header{Name: "MEM", Align: tview.AlignRight},
+		Header{Name: "RESTARTS/H", Align: tview.AlignRight},
+		Header{Name: "NET-RX(KiB/s)", Align: tview.AlignRight},
+		Header{Name: "NET-TX(KiB/s)", Align: tview.AlignRight},
+	)
+}
+
+// Render renders a K8s resource to screen.
+func (p PodExtras) Render(o interface{}, ns string, r *Row) error {
+	e, ok := o.(PodExtrasRes)
+	if !ok {
+		return fmt.Errorf("expecting PodExtrasRes, but got %T", o)
+	}
+
+	pns, n := Namespaced(e.FQN)
+	r.ID = e.FQN
+	r.Fields = make(Fields, 0, len(p.Header(ns)))
+	if isAllNamespace(ns) {
+		r.Fields = append(r.Fields, pns)
+	}
+	r.Fields = append(r.Fields,
+		n,
+		ToMillicore(e.CPU),
+		ToMi(e.MEM),
+		strconv.FormatFloat(e.Extras.RestartRate, 'f', 1, 64),
+		strconv.FormatFloat(e.Extras.NetRX/1024, 'f', 1, 64),
+		strconv.FormatFloat(e.Extras.NetTX/1024, 'f', 1, 64),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PodExtrasRes represents additional pod metrics.
+type PodExtrasRes struct {
+	FQN    string
+	CPU    int64
+	MEM    float64
+	Extras client.PodExtras
+}
+
+// GetObjectKind returns a schema object.
+func (PodExtrasRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PodExtrasRes) DeepCopyObject() runtime.Object {
+	return p
+}
@@ -0,0 +1,27 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodExtrasRender(t *testing.T) {
+	var p render.PodExtras
+
+	o := render.PodExtrasRes{
+		FQN:    "default/fred",
+		CPU:    250,
+		MEM:    64,
+		Extras: client.PodExtras{RestartRate: 2, NetRX: 2048, NetTX: 512},
+	}
+	var r render.Row
+	assert.Nil(t, p.Render(o, "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, render.Fields{"default", "fred", "250", "64", "2.0", "2.0", "0.5"}, r.Fields)
+
+	assert.Nil(t, p.Render(o, "default", &r))
+	assert.Equal(t, render.Fields{"fred", "250", "64", "2.0", "2.0", "0.5"}, r.Fields)
+}
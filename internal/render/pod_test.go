@@ -30,6 +30,8 @@ func TestPodColorer(t *testing.T) {
 		row        = render.Row{Fields: render.Fields{"fred", "1/1", "Running"}}
 		toast      = render.Row{Fields: render.Fields{"fred", "1/1", "Boom"}}
 		notReady   = render.Row{Fields: render.Fields{"fred", "0/1", "Boom"}}
+		hot        = render.Row{Fields: render.Fields{"fred", "1/1", "Running", "0", "", "", "", "", "", "", "95", "10", "n/a", "n/a"}}
+		warm       = render.Row{Fields: render.Fields{"fred", "1/1", "Running", "0", "", "", "", "", "", "", "10", "10", "n/a", "80"}}
 	)
 
 	uu := colorerUCs{
@@ -49,6 +51,10 @@ func TestPodColorer(t *testing.T) {
 		{"", render.RowEvent{Kind: render.EventUpdate, Row: notReadyNS}, render.ErrColor},
 		// NotReady Namespaced
 		{"blee", render.RowEvent{Kind: render.EventUpdate, Row: notReady}, render.ErrColor},
+		// Over critical threshold
+		{"blee", render.RowEvent{Kind: render.EventUpdate, Row: hot}, render.ErrColor},
+		// Over warn threshold
+		{"blee", render.RowEvent{Kind: render.EventUpdate, Row: warm}, render.HighlightColor},
 	}
 
 	var p render.Pod
@@ -70,8 +76,21 @@ func TestPodRender(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := render.Fields{"default", "nginx", "1/1", "Running", "0", "10", "10", "10", "14", "172.17.0.6", "minikube", "BE"}
-	assert.Equal(t, e, r.Fields[:12])
+	e := render.Fields{"default", "nginx", "1/1", "Running", "0", "10", "10", "100", "70", "n/a", "170", "10", "14", "n/a", "5", "172.17.0.6", "minikube", "BE"}
+	assert.Equal(t, e, r.Fields[:18])
+	assert.Equal(t, "n/a", r.Fields[18])
+}
+
+func TestPodRenderTrend(t *testing.T) {
+	pom := render.PodWithMetrics{
+		Raw:   load(t, "po"),
+		Trend: []float64{10, 20, 30},
+	}
+
+	var po render.Pod
+	r := render.NewRow(12)
+	assert.Nil(t, po.Render(&pom, "", &r))
+	assert.Equal(t, "▁▅█", r.Fields[18])
 }
 
 func BenchmarkPodRender(b *testing.B) {
@@ -101,8 +120,8 @@ func TestPodInitRender(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := render.Fields{"default", "nginx", "1/1", "Init:0/1", "0", "10", "10", "10", "14", "172.17.0.6", "minikube", "BE"}
-	assert.Equal(t, e, r.Fields[:12])
+	e := render.Fields{"default", "nginx", "1/1", "Init:0/1", "0", "10", "10", "100", "70", "n/a", "170", "10", "14", "n/a", "5", "172.17.0.6", "minikube", "BE"}
+	assert.Equal(t, e, r.Fields[:18])
 }
 
 // ----------------------------------------------------------------------------
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // PersistentVolumeClaim renders a K8s PersistentVolumeClaim to screen.
@@ -49,16 +51,27 @@ func (PersistentVolumeClaim) Header(ns string) HeaderRow {
 		Header{Name: "CAPACITY"},
 		Header{Name: "ACCESS MODES"},
 		Header{Name: "STORAGECLASS"},
+		Header{Name: "PODS"},
+		Header{Name: "NODE"},
+		Header{Name: "USED(Mi)", Align: tview.AlignRight},
+		Header{Name: "AVAIL(Mi)", Align: tview.AlignRight},
+		Header{Name: "%INODES", Align: tview.AlignRight},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	)
 }
 
 // Render renders a K8s resource to screen.
 func (p PersistentVolumeClaim) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
+	var extras PersistentVolumeClaimWithUsage
+	switch v := o.(type) {
+	case *unstructured.Unstructured:
+		extras.Raw = v
+	case *PersistentVolumeClaimWithUsage:
+		extras = *v
+	default:
 		return fmt.Errorf("Expected PersistentVolumeClaim, but got %T", o)
 	}
+	raw := extras.Raw
 	var pvc v1.PersistentVolumeClaim
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &pvc)
 	if err != nil {
@@ -96,8 +109,55 @@ func (p PersistentVolumeClaim) Render(o interface{}, ns string, r *Row) error {
 		capacity,
 		accessModes,
 		class,
-		toAge(pvc.ObjectMeta.CreationTimestamp),
+		strings.Join(extras.Pods, ","),
+		extras.Node,
 	)
+	r.Fields = append(r.Fields, extras.Usage.fields()...)
+	r.Fields = append(r.Fields, toAge(pvc.ObjectMeta.CreationTimestamp))
 
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PersistentVolumeClaimWithUsage represents a claim, its consumers and usage.
+type PersistentVolumeClaimWithUsage struct {
+	Raw   *unstructured.Unstructured
+	Pods  []string
+	Node  string
+	Usage *VolumeUsage
+}
+
+// GetObjectKind returns a schema object.
+func (p *PersistentVolumeClaimWithUsage) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p *PersistentVolumeClaimWithUsage) DeepCopyObject() runtime.Object {
+	return p
+}
+
+// VolumeUsage tracks a volume usage as reported by the kubelet.
+type VolumeUsage struct {
+	Used, Available    int64
+	Inodes, InodesUsed int64
+}
+
+func (u *VolumeUsage) fields() Fields {
+	if u == nil {
+		return Fields{NAValue, NAValue, NAValue}
+	}
+
+	inodes := NAValue
+	if u.Inodes > 0 {
+		inodes = AsPerc(toPerc(float64(u.InodesUsed), float64(u.Inodes)))
+	}
+
+	return Fields{
+		ToMi(ToMB(u.Used)),
+		ToMi(ToMB(u.Available)),
+		inodes,
+	}
+}
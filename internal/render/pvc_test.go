@@ -15,3 +15,26 @@ func TestPersistentVolumeClaimRender(t *testing.T) {
 	assert.Equal(t, "default/www-nginx-sts-0", r.ID)
 	assert.Equal(t, render.Fields{"default", "www-nginx-sts-0", "Bound", "pvc-fbabd470-8725-11e9-a8e8-42010a80015b", "1Gi", "RWO", "standard"}, r.Fields[:7])
 }
+
+func TestPersistentVolumeClaimWithUsageRender(t *testing.T) {
+	c := render.PersistentVolumeClaim{}
+	r := render.NewRow(13)
+	pvc := render.PersistentVolumeClaimWithUsage{
+		Raw:   load(t, "pvc"),
+		Pods:  []string{"nginx-sts-0"},
+		Node:  "n1",
+		Usage: &render.VolumeUsage{Used: 100 * 1024 * 1024, Available: 924 * 1024 * 1024, Inodes: 1000, InodesUsed: 250},
+	}
+	assert.Nil(t, c.Render(&pvc, "", &r))
+
+	assert.Equal(t, "default/www-nginx-sts-0", r.ID)
+	assert.Equal(t, render.Fields{"nginx-sts-0", "n1", "100", "924", "25"}, r.Fields[7:12])
+
+	pvc.Usage = &render.VolumeUsage{Used: 100 * 1024 * 1024, Available: 924 * 1024 * 1024}
+	assert.Nil(t, c.Render(&pvc, "", &r))
+	assert.Equal(t, render.Fields{"100", "924", "n/a"}, r.Fields[9:12])
+
+	pvc.Usage = nil
+	assert.Nil(t, c.Render(&pvc, "", &r))
+	assert.Equal(t, render.Fields{"n/a", "n/a", "n/a"}, r.Fields[9:12])
+}
@@ -0,0 +1,89 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ReachAllowed indicates pods can connect.
+	ReachAllowed = "ALLOWED"
+	// ReachBlocked indicates policies prevent pods from connecting.
+	ReachBlocked = "BLOCKED"
+)
+
+// Reach renders a pod network reachability to screen.
+type Reach struct{}
+
+// ColorerFunc colors a resource row.
+func (Reach) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if re.Row.Fields[4] == ReachBlocked {
+			return ErrColor
+		}
+
+		return DefaultColorer(ns, re)
+	}
+}
+
+// Header returns a header row.
+func (Reach) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "DIRECTION"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "PORTS"},
+		Header{Name: "VERDICT"},
+		Header{Name: "REASON"},
+	}
+}
+
+// Render renders a reachability path to screen.
+func (Reach) Render(o interface{}, ns string, r *Row) error {
+	p, ok := o.(ReachRes)
+	if !ok {
+		return fmt.Errorf("expecting ReachRes, but got %T", o)
+	}
+
+	verdict := ReachBlocked
+	if p.Allowed {
+		verdict = ReachAllowed
+	}
+	r.ID = p.Direction + ":" + client.FQN(p.Namespace, p.Name)
+	r.Fields = Fields{
+		p.Direction,
+		p.Namespace,
+		p.Name,
+		p.Ports,
+		verdict,
+		p.Reason,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ReachRes represents the reachability between a pod and a peer.
+type ReachRes struct {
+	Direction       string
+	Namespace, Name string
+	Ports           string
+	Allowed         bool
+	Reason          string
+}
+
+// GetObjectKind returns a schema object.
+func (ReachRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r ReachRes) DeepCopyObject() runtime.Object {
+	return r
+}
@@ -0,0 +1,38 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReachRender(t *testing.T) {
+	p := render.ReachRes{
+		Direction: "OUT",
+		Namespace: "fred",
+		Name:      "blee",
+		Ports:     "TCP/80",
+		Allowed:   true,
+		Reason:    "ingress allowed by p1",
+	}
+
+	var r render.Row
+	assert.Nil(t, render.Reach{}.Render(p, "", &r))
+	assert.Equal(t, "OUT:fred/blee", r.ID)
+	assert.Equal(t, render.Fields{"OUT", "fred", "blee", "TCP/80", "ALLOWED", "ingress allowed by p1"}, r.Fields)
+
+	p.Allowed = false
+	assert.Nil(t, render.Reach{}.Render(p, "", &r))
+	assert.Equal(t, "BLOCKED", r.Fields[4])
+}
+
+func TestReachColorer(t *testing.T) {
+	defer func(c tcell.Color) { render.ErrColor = c }(render.ErrColor)
+	render.ErrColor = tcell.ColorRed
+
+	f := render.Reach{}.ColorerFunc()
+	re := render.RowEvent{Row: render.Row{Fields: render.Fields{"IN", "fred", "blee", "none", "BLOCKED", ""}}}
+	assert.Equal(t, tcell.ColorRed, f("", re))
+}
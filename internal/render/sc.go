@@ -3,6 +3,7 @@ package render
 import (
 	"fmt"
 
+	v1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -21,6 +22,9 @@ func (StorageClass) Header(ns string) HeaderRow {
 	return HeaderRow{
 		Header{Name: "NAME"},
 		Header{Name: "PROVISIONER"},
+		Header{Name: "RECLAIMPOLICY"},
+		Header{Name: "VOLUMEBINDINGMODE"},
+		Header{Name: "ALLOWVOLUMEEXPANSION"},
 		Header{Name: "AGE", Decorator: AgeDecorator},
 	}
 }
@@ -41,8 +45,30 @@ func (StorageClass) Render(o interface{}, ns string, r *Row) error {
 	r.Fields = Fields{
 		sc.Name,
 		string(sc.Provisioner),
+		reclaimPolicy(sc.ReclaimPolicy),
+		bindingMode(sc.VolumeBindingMode),
+		boolPtrToStr(sc.AllowVolumeExpansion),
 		toAge(sc.ObjectMeta.CreationTimestamp),
 	}
 
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func reclaimPolicy(p *v1.PersistentVolumeReclaimPolicy) string {
+	if p == nil {
+		return string(v1.PersistentVolumeReclaimDelete)
+	}
+
+	return string(*p)
+}
+
+func bindingMode(m *storagev1.VolumeBindingMode) string {
+	if m == nil {
+		return string(storagev1.VolumeBindingImmediate)
+	}
+
+	return string(*m)
+}
@@ -63,6 +63,41 @@ func (t *TableData) Update(rows Rows) {
 	}
 }
 
+// Patch updates the given rows only. Ids not present in rows are deleted
+// and all other rows are marked unchanged.
+func (t *TableData) Patch(rows Rows, ids []string) {
+	index := make(map[string]int, len(t.RowEvents))
+	for i, re := range t.RowEvents {
+		index[re.Row.ID] = i
+		t.RowEvents[i].Kind, t.RowEvents[i].Deltas = EventUnchanged, DeltaRow{}
+	}
+
+	patched := make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		patched[row.ID] = struct{}{}
+		i, ok := index[row.ID]
+		if !ok {
+			t.RowEvents = append(t.RowEvents, NewRowEvent(EventAdd, row))
+			continue
+		}
+		delta := NewDeltaRow(t.RowEvents[i].Row, row, t.Header.HasAge())
+		if delta.IsBlank() {
+			t.RowEvents[i].Row = row
+			continue
+		}
+		t.RowEvents[i] = NewDeltaRowEvent(row, delta)
+	}
+
+	for _, id := range ids {
+		if _, ok := patched[id]; ok {
+			continue
+		}
+		if _, ok := index[id]; ok {
+			t.RowEvents = t.RowEvents.Delete(id)
+		}
+	}
+}
+
 // Delete delete items in cache that are no longer valid.
 func (t *TableData) Delete(newKeys []string) {
 	var victims []string
@@ -49,5 +49,27 @@ func TestTableDataDelete(t *testing.T) {
 			assert.Equal(t, u.e, table.RowEvents)
 		})
 	}
+}
+
+func TestTableDataPatch(t *testing.T) {
+	table := render.TableData{
+		RowEvents: render.RowEvents{
+			{Kind: render.EventAdd, Row: render.Row{ID: "A", Fields: render.Fields{"1", "2"}}},
+			{Kind: render.EventAdd, Row: render.Row{ID: "B", Fields: render.Fields{"0", "2"}}},
+			{Kind: render.EventAdd, Row: render.Row{ID: "C", Fields: render.Fields{"10", "2"}}},
+		},
+	}
+	table.Patch(render.Rows{
+		{ID: "B", Fields: render.Fields{"0", "3"}},
+		{ID: "D", Fields: render.Fields{"4", "4"}},
+	}, []string{"B", "C", "D"})
 
+	assert.Equal(t, 3, len(table.RowEvents))
+	assert.Equal(t, "A", table.RowEvents[0].Row.ID)
+	assert.Equal(t, render.EventUnchanged, table.RowEvents[0].Kind)
+	assert.Equal(t, "B", table.RowEvents[1].Row.ID)
+	assert.Equal(t, render.EventUpdate, table.RowEvents[1].Kind)
+	assert.Equal(t, render.Fields{"0", "3"}, table.RowEvents[1].Row.Fields)
+	assert.Equal(t, "D", table.RowEvents[2].Row.ID)
+	assert.Equal(t, render.EventAdd, table.RowEvents[2].Kind)
 }
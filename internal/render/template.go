@@ -0,0 +1,71 @@
+package render
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Template renders a resource template to screen.
+type Template struct{}
+
+// ColorerFunc colors a resource row.
+func (Template) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if re.Row.Fields[2] == "false" {
+			return ErrColor
+		}
+		return tcell.ColorNavajoWhite
+	}
+}
+
+// Header returns a header row.
+func (Template) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAME"},
+		Header{Name: "VARIABLES"},
+		Header{Name: "VALID"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Template) Render(o interface{}, ns string, r *Row) error {
+	t, ok := o.(TemplateRes)
+	if !ok {
+		return fmt.Errorf("expecting TemplateRes, but got %T", o)
+	}
+
+	r.ID = filepath.Join(t.Dir, t.File.Name())
+	r.Fields = Fields{
+		t.File.Name(),
+		na(strings.Join(t.Vars, ",")),
+		boolToStr(t.Error == ""),
+		timeToAge(t.File.ModTime()),
+	}
+
+	return nil
+}
+
+// TemplateRes represents a template file resource.
+type TemplateRes struct {
+	File  os.FileInfo
+	Dir   string
+	Vars  []string
+	Error string
+}
+
+// GetObjectKind returns a schema object.
+func (TemplateRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (t TemplateRes) DeepCopyObject() runtime.Object {
+	return t
+}
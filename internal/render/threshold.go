@@ -0,0 +1,68 @@
+package render
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell"
+)
+
+// Threshold tracks a resource utilization severity levels in percent.
+type Threshold struct {
+	Warn, Critical int
+}
+
+var (
+	// CPUThreshold tracks cpu utilization levels.
+	CPUThreshold = Threshold{Warn: 70, Critical: 90}
+	// MEMThreshold tracks memory utilization levels.
+	MEMThreshold = Threshold{Warn: 70, Critical: 90}
+)
+
+// Severity returns the severity level of a given percentage.
+// Returns 0 when under the warn level, 1 when warn and 2 when critical.
+func (t Threshold) Severity(perc string) int {
+	v, err := strconv.Atoi(strings.TrimSpace(perc))
+	if err != nil {
+		return 0
+	}
+
+	switch {
+	case v >= t.Critical:
+		return 2
+	case v >= t.Warn:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ThresholdColor colors a row based on its cpu and memory percentage columns.
+func thresholdColor(ff Fields, cpuCols, memCols []int, c tcell.Color) tcell.Color {
+	if c == ErrColor || c == KillColor {
+		return c
+	}
+
+	var sev int
+	check := func(t Threshold, cols []int) {
+		for _, col := range cols {
+			if col >= len(ff) {
+				continue
+			}
+			if s := t.Severity(ff[col]); s > sev {
+				sev = s
+			}
+		}
+	}
+	check(CPUThreshold, cpuCols)
+	check(MEMThreshold, memCols)
+
+	switch sev {
+	case 2:
+		return ErrColor
+	case 1:
+		return HighlightColor
+	default:
+		return c
+	}
+}
@@ -0,0 +1,29 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThresholdSeverity(t *testing.T) {
+	uu := map[string]struct {
+		perc string
+		e    int
+	}{
+		"na":       {perc: render.NAValue, e: 0},
+		"ok":       {perc: "10", e: 0},
+		"warn":     {perc: "70", e: 1},
+		"critical": {perc: "90", e: 2},
+		"over":     {perc: "250", e: 2},
+	}
+
+	th := render.Threshold{Warn: 70, Critical: 90}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, th.Severity(u.perc))
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Timeline renders a resource events timeline to screen.
+type Timeline struct{}
+
+// ColorerFunc colors a resource row.
+func (Timeline) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if re.Row.Fields[1] == v1.EventTypeWarning {
+			return ErrColor
+		}
+
+		return DefaultColorer(ns, re)
+	}
+}
+
+// Header returns a header row.
+func (Timeline) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "LAST SEEN", Decorator: AgeDecorator},
+		Header{Name: "TYPE"},
+		Header{Name: "REASON"},
+		Header{Name: "OBJECT"},
+		Header{Name: "COUNT", Align: tview.AlignRight},
+		Header{Name: "FIRST SEEN", Decorator: AgeDecorator},
+		Header{Name: "MESSAGE"},
+	}
+}
+
+// Render renders an event to screen.
+func (Timeline) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting Event, but got %T", o)
+	}
+	var ev v1.Event
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &ev); err != nil {
+		return err
+	}
+
+	count := ev.Count
+	if ev.Series != nil {
+		count = ev.Series.Count
+	}
+	r.ID = MetaFQN(ev.ObjectMeta)
+	r.Fields = Fields{
+		timeToAge(EventLastSeen(ev)),
+		ev.Type,
+		ev.Reason,
+		asRef(ev.InvolvedObject),
+		strconv.Itoa(int(max32(count, 1))),
+		timeToAge(EventFirstSeen(ev)),
+		ev.Message,
+	}
+
+	return nil
+}
+
+// EventLastSeen returns the last time an event was observed.
+func EventLastSeen(ev v1.Event) time.Time {
+	switch {
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.Time
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	default:
+		return ev.CreationTimestamp.Time
+	}
+}
+
+// EventFirstSeen returns the first time an event was observed.
+func EventFirstSeen(ev v1.Event) time.Time {
+	switch {
+	case !ev.FirstTimestamp.IsZero():
+		return ev.FirstTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	default:
+		return ev.CreationTimestamp.Time
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func max32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
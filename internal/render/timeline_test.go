@@ -0,0 +1,37 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestTimelineRender(t *testing.T) {
+	now := time.Now().UTC()
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion":     "v1",
+		"kind":           "Event",
+		"metadata":       map[string]interface{}{"name": "e1", "namespace": "fred"},
+		"type":           "Warning",
+		"reason":         "BackOff",
+		"message":        "Back-off restarting failed container",
+		"count":          int64(5),
+		"involvedObject": map[string]interface{}{"kind": "Pod", "name": "p1"},
+		"firstTimestamp": now.Add(-time.Hour).Format(time.RFC3339),
+		"lastTimestamp":  now.Format(time.RFC3339),
+	}}
+
+	var r render.Row
+	assert.Nil(t, render.Timeline{}.Render(&o, "fred", &r))
+	assert.Equal(t, "fred/e1", r.ID)
+	assert.Equal(t, render.Fields{"Warning", "BackOff", "pod:p1", "5"}, r.Fields[1:5])
+	assert.Equal(t, "Back-off restarting failed container", r.Fields[6])
+
+	defer func(c tcell.Color) { render.ErrColor = c }(render.ErrColor)
+	render.ErrColor = tcell.ColorRed
+	assert.Equal(t, tcell.ColorRed, render.Timeline{}.ColorerFunc()("fred", render.RowEvent{Row: r}))
+}
@@ -0,0 +1,212 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+const (
+	manifestFile = "manifest.json"
+	discoveryDir = "discovery"
+	resourcesDir = "resources"
+	jsonExt      = ".json"
+)
+
+// Manifest describes a captured cluster state.
+type Manifest struct {
+	Context   string       `json:"context"`
+	Cluster   string       `json:"cluster"`
+	CreatedAt time.Time    `json:"createdAt"`
+	Version   version.Info `json:"version"`
+}
+
+// Archive represents a captured cluster state.
+type Archive struct {
+	Manifest Manifest
+
+	// Discovery tracks raw discovery documents by api path.
+	Discovery map[string][]byte
+
+	// Resources tracks resource collections by gvr.
+	Resources map[string]*unstructured.UnstructuredList
+}
+
+// NewArchive returns a new empty archive.
+func NewArchive() *Archive {
+	return &Archive{
+		Discovery: make(map[string][]byte),
+		Resources: make(map[string]*unstructured.UnstructuredList),
+	}
+}
+
+// Count returns the number of captured resources.
+func (a *Archive) Count() int {
+	var count int
+	for _, l := range a.Resources {
+		count += len(l.Items)
+	}
+
+	return count
+}
+
+// GVRs returns the captured resources gvrs.
+func (a *Archive) GVRs() []string {
+	gg := make([]string, 0, len(a.Resources))
+	for gvr := range a.Resources {
+		gg = append(gg, gvr)
+	}
+	sort.Strings(gg)
+
+	return gg
+}
+
+// Save writes the archive to a given file.
+func (a *Archive) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := a.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// Write writes the archive as a gzipped tarball.
+func (a *Archive) Write(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	raw, err := json.Marshal(a.Manifest)
+	if err != nil {
+		return err
+	}
+	if err := writeEntry(tw, manifestFile, raw, a.Manifest.CreatedAt); err != nil {
+		return err
+	}
+	for _, p := range sortedKeys(a.Discovery) {
+		name := path.Join(discoveryDir, strings.TrimPrefix(p, "/")) + jsonExt
+		if err := writeEntry(tw, name, a.Discovery[p], a.Manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+	for _, gvr := range a.GVRs() {
+		raw, err := a.Resources[gvr].MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("unable to marshal %q: %v", gvr, err)
+		}
+		name := path.Join(resourcesDir, gvr) + jsonExt
+		if err := writeEntry(tw, name, raw, a.Manifest.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+// Load reads an archive from a given file.
+func Load(path string) (*Archive, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Read(f)
+}
+
+// Read reads an archive from a gzipped tarball.
+func Read(r io.Reader) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	a, tr := NewArchive(), tar.NewReader(gz)
+	var hasManifest bool
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		raw, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.TrimSuffix(h.Name, jsonExt)
+		switch {
+		case h.Name == manifestFile:
+			if err := json.Unmarshal(raw, &a.Manifest); err != nil {
+				return nil, fmt.Errorf("invalid snapshot manifest: %v", err)
+			}
+			hasManifest = true
+		case strings.HasPrefix(name, discoveryDir+"/"):
+			a.Discovery["/"+strings.TrimPrefix(name, discoveryDir+"/")] = raw
+		case strings.HasPrefix(name, resourcesDir+"/"):
+			var l unstructured.UnstructuredList
+			if err := l.UnmarshalJSON(raw); err != nil {
+				return nil, fmt.Errorf("invalid snapshot resource %q: %v", h.Name, err)
+			}
+			a.Resources[strings.TrimPrefix(name, resourcesDir+"/")] = &l
+		}
+	}
+	if !hasManifest {
+		return nil, fmt.Errorf("not a k9s snapshot. Missing %s", manifestFile)
+	}
+
+	return a, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func writeEntry(tw *tar.Writer, name string, raw []byte, t time.Time) error {
+	h := tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(raw)),
+		ModTime: t,
+	}
+	if err := tw.WriteHeader(&h); err != nil {
+		return err
+	}
+	_, err := tw.Write(raw)
+
+	return err
+}
+
+func sortedKeys(m map[string][]byte) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
@@ -0,0 +1,83 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	a := makeArchive()
+
+	var buff bytes.Buffer
+	assert.Nil(t, a.Write(&buff))
+	b, err := snapshot.Read(&buff)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", b.Manifest.Context)
+	assert.Equal(t, "v1.16.3", b.Manifest.Version.GitVersion)
+	assert.Equal(t, []string{"apps/v1/deployments", "v1/pods"}, b.GVRs())
+	assert.Equal(t, 3, b.Count())
+	assert.Equal(t, a.Discovery["/api/v1"], b.Discovery["/api/v1"])
+	assert.Equal(t, "p2", b.Resources["v1/pods"].Items[1].GetName())
+}
+
+func TestArchiveReadInvalid(t *testing.T) {
+	_, err := snapshot.Read(bytes.NewBufferString("blee"))
+
+	assert.NotNil(t, err)
+}
+
+// Helpers...
+
+func makeArchive() *snapshot.Archive {
+	a := snapshot.NewArchive()
+	a.Manifest = snapshot.Manifest{
+		Context:   "fred",
+		Cluster:   "blee",
+		CreatedAt: time.Now(),
+		Version:   version.Info{GitVersion: "v1.16.3"},
+	}
+	a.Discovery["/api"] = []byte(`{"kind":"APIVersions","versions":["v1"]}`)
+	a.Discovery["/api/v1"] = []byte(`{"kind":"APIResourceList","groupVersion":"v1","resources":[{"name":"pods","namespaced":true,"kind":"Pod","verbs":["get","list","watch"]}]}`)
+	a.Resources["v1/pods"] = makeList("v1", "PodList", "Pod",
+		makeObj("v1", "Pod", "default", "p1", map[string]interface{}{"app": "fred"}),
+		makeObj("v1", "Pod", "kube-system", "p2", nil),
+	)
+	a.Resources["apps/v1/deployments"] = makeList("apps/v1", "DeploymentList", "Deployment",
+		makeObj("apps/v1", "Deployment", "default", "d1", nil),
+	)
+
+	return a
+}
+
+func makeList(apiVersion, kind, itemKind string, oo ...unstructured.Unstructured) *unstructured.UnstructuredList {
+	l := unstructured.UnstructuredList{Items: oo}
+	l.SetAPIVersion(apiVersion)
+	l.SetKind(kind)
+
+	return &l
+}
+
+func makeObj(apiVersion, kind, ns, n string, ll map[string]interface{}) unstructured.Unstructured {
+	meta := map[string]interface{}{
+		"name":              n,
+		"namespace":         ns,
+		"creationTimestamp": "2020-01-01T00:00:00Z",
+	}
+	if ll != nil {
+		meta["labels"] = ll
+	}
+
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   meta,
+		"spec":       map[string]interface{}{"nodeName": "n1"},
+	}}
+}
@@ -0,0 +1,170 @@
+package snapshot
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	pageSize   = 500
+	secretsGVR = "v1/secrets"
+	redacted   = "<redacted>"
+
+	lastAppliedKey = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// Capture dumps all listable resources reachable via a given factory.
+// Secrets values are redacted unless secrets is set.
+func Capture(f dao.Factory, secrets bool) (*Archive, error) {
+	a := NewArchive()
+	a.Manifest.CreatedAt = time.Now()
+
+	conn := f.Client()
+	info, err := conn.ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+	a.Manifest.Version = *info
+	if a.Manifest.Context, err = conn.Config().CurrentContextName(); err != nil {
+		return nil, err
+	}
+	if a.Manifest.Cluster, err = conn.Config().CurrentClusterName(); err != nil {
+		return nil, err
+	}
+
+	if err := captureDiscovery(conn, a); err != nil {
+		return nil, err
+	}
+	if err := dao.LoadResources(f); err != nil {
+		return nil, err
+	}
+	for _, gvr := range dao.AllGVRs() {
+		meta, err := dao.MetaFor(gvr)
+		if err != nil || dao.IsK9sMeta(meta) || !canList(meta) {
+			continue
+		}
+		l, err := listAll(conn, gvr)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Snapshot skipping %q", gvr)
+			continue
+		}
+		if gvr.String() == secretsGVR && !secrets {
+			redact(l)
+		}
+		a.Resources[gvr.String()] = l
+	}
+
+	return a, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func captureDiscovery(conn client.Connection, a *Archive) error {
+	rest := conn.DialOrDie().Discovery().RESTClient()
+	get := func(p string) ([]byte, error) {
+		raw, err := rest.Get().AbsPath(p).DoRaw()
+		if err != nil {
+			return nil, err
+		}
+		a.Discovery[p] = raw
+		return raw, nil
+	}
+
+	raw, err := get("/api")
+	if err != nil {
+		return err
+	}
+	var vv metav1.APIVersions
+	if err := json.Unmarshal(raw, &vv); err != nil {
+		return err
+	}
+	for _, v := range vv.Versions {
+		if _, err := get("/api/" + v); err != nil {
+			return err
+		}
+	}
+
+	if raw, err = get("/apis"); err != nil {
+		return err
+	}
+	var gg metav1.APIGroupList
+	if err := json.Unmarshal(raw, &gg); err != nil {
+		return err
+	}
+	for _, g := range gg.Groups {
+		for _, v := range g.Versions {
+			if _, err := get("/apis/" + v.GroupVersion); err != nil {
+				log.Warn().Err(err).Msgf("Snapshot skipping discovery for %q", v.GroupVersion)
+			}
+		}
+	}
+
+	return nil
+}
+
+func listAll(conn client.Connection, gvr client.GVR) (*unstructured.UnstructuredList, error) {
+	dial := conn.DynDialOrDie().Resource(gvr.AsGVR())
+	opts := metav1.ListOptions{Limit: pageSize}
+	var res *unstructured.UnstructuredList
+	for {
+		l, err := dial.List(opts)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			res = l
+		} else {
+			res.Items = append(res.Items, l.Items...)
+		}
+		if opts.Continue = l.GetContinue(); opts.Continue == "" {
+			break
+		}
+	}
+	res.SetContinue("")
+
+	return res, nil
+}
+
+func canList(meta metav1.APIResource) bool {
+	if strings.Contains(meta.Name, "/") {
+		return false
+	}
+	for _, v := range meta.Verbs {
+		if v == "list" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Redact blanks out secrets values. Last applied configurations and managed
+// fields are dropped too as they may carry a copy of the values.
+func redact(l *unstructured.UnstructuredList) {
+	for _, o := range l.Items {
+		for _, f := range []string{"data", "stringData"} {
+			m, ok := o.Object[f].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for k := range m {
+				if f == "data" {
+					m[k] = base64.StdEncoding.EncodeToString([]byte(redacted))
+				} else {
+					m[k] = redacted
+				}
+			}
+		}
+		unstructured.RemoveNestedField(o.Object, "metadata", "annotations", lastAppliedKey)
+		unstructured.RemoveNestedField(o.Object, "metadata", "managedFields")
+	}
+}
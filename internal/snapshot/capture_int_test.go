@@ -0,0 +1,44 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRedactApplied(t *testing.T) {
+	const secret = "s3cr3t"
+	applied := `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"s1","namespace":"default"},"stringData":{"password":"` + secret + `"}}`
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":      "s1",
+			"namespace": "default",
+			"annotations": map[string]interface{}{
+				lastAppliedKey: applied,
+				"fred":         "blee",
+			},
+			"managedFields": []interface{}{
+				map[string]interface{}{"manager": "kubectl", "fieldsV1": map[string]interface{}{"f:data": map[string]interface{}{"f:password": map[string]interface{}{}}}},
+			},
+		},
+		"data":       map[string]interface{}{"password": "czNjcjN0"},
+		"stringData": map[string]interface{}{"password": secret},
+	}}
+	l := unstructured.UnstructuredList{Items: []unstructured.Unstructured{o}}
+
+	redact(&l)
+
+	raw, err := json.Marshal(l.Items[0].Object)
+	assert.Nil(t, err)
+	assert.False(t, strings.Contains(string(raw), secret))
+	assert.False(t, strings.Contains(string(raw), "czNjcjN0"))
+	assert.Equal(t, map[string]string{"fred": "blee"}, l.Items[0].GetAnnotations())
+	_, ok := l.Items[0].Object["metadata"].(map[string]interface{})["managedFields"]
+	assert.False(t, ok)
+	assert.Equal(t, redacted, l.Items[0].Object["stringData"].(map[string]interface{})["password"])
+}
@@ -0,0 +1,370 @@
+package snapshot
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// NamePrefix prefixes snapshot contexts and clusters names.
+	NamePrefix = "snapshot-"
+
+	readOnlyMsg    = "snapshot is read-only"
+	defaultWatchTO = 5 * time.Minute
+	localAddr      = "127.0.0.1:0"
+	tableAccept    = "as=Table"
+	tokenSize      = 32
+	bearerPrefix   = "Bearer "
+)
+
+// Server serves a captured cluster state as a read-only api server. Requests
+// must carry the server bearer token so other local users can't read it.
+type Server struct {
+	archive *Archive
+	srv     *http.Server
+	url     string
+	token   string
+}
+
+// NewServer returns a new snapshot server.
+func NewServer(a *Archive) (*Server, error) {
+	tok, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	s := Server{archive: a, token: tok}
+	s.srv = &http.Server{Handler: &s}
+
+	return &s, nil
+}
+
+// Start serves the snapshot on a local port.
+func (s *Server) Start() error {
+	l, err := net.Listen("tcp", localAddr)
+	if err != nil {
+		return err
+	}
+	s.url = "http://" + l.Addr().String()
+	go func() {
+		if err := s.srv.Serve(l); err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msg("Snapshot server failed")
+		}
+	}()
+	log.Info().Msgf("Serving snapshot %q on %s", s.archive.Manifest.Context, s.url)
+
+	return nil
+}
+
+// Stop terminates the server.
+func (s *Server) Stop() {
+	if err := s.srv.Close(); err != nil {
+		log.Error().Err(err).Msg("Snapshot server close")
+	}
+}
+
+// URL returns the server url.
+func (s *Server) URL() string {
+	return s.url
+}
+
+// Token returns the server bearer token.
+func (s *Server) Token() string {
+	return s.token
+}
+
+// ContextName returns the snapshot context name.
+func (s *Server) ContextName() string {
+	return NamePrefix + s.archive.Manifest.Context
+}
+
+// WriteKubeConfig writes a kubeconfig targeting the server to a given file.
+func (s *Server) WriteKubeConfig(path string) error {
+	n, cl := s.ContextName(), NamePrefix+s.archive.Manifest.Cluster
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[cl] = &clientcmdapi.Cluster{Server: s.url}
+	cfg.AuthInfos[n] = &clientcmdapi.AuthInfo{Token: s.token}
+	cfg.Contexts[n] = &clientcmdapi.Context{Cluster: cl, AuthInfo: n}
+	cfg.CurrentContext = n
+
+	return clientcmd.WriteToFile(*cfg, path)
+}
+
+// ServeHTTP serves api requests from the archive.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log.Debug().Msgf("SNAPSHOT %s %s", r.Method, r.URL)
+	if !s.authorized(r) {
+		writeStatus(w, http.StatusUnauthorized, metav1.StatusReasonUnauthorized, "invalid snapshot token")
+		return
+	}
+	p := strings.TrimSuffix(r.URL.Path, "/")
+	if r.Method == http.MethodPost && strings.HasSuffix(p, "/selfsubjectaccessreviews") {
+		s.review(w, r)
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeStatus(w, http.StatusMethodNotAllowed, metav1.StatusReasonMethodNotAllowed, readOnlyMsg)
+		return
+	}
+	if p == "/version" {
+		writeJSON(w, http.StatusOK, s.archive.Manifest.Version)
+		return
+	}
+	if raw, ok := s.archive.Discovery[p]; ok {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(raw)
+		return
+	}
+
+	req, ok := parsePath(p)
+	if !ok {
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("%s not found in snapshot", p))
+		return
+	}
+	q := r.URL.Query()
+	switch {
+	case req.name != "":
+		s.get(w, req)
+	case q.Get("watch") == "true" || q.Get("watch") == "1":
+		s.watch(w, r)
+	default:
+		s.list(w, r, req)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newToken() (string, error) {
+	b := make([]byte, tokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("snapshot token generation failed: %v", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	h := r.Header.Get("Authorization")
+	if !strings.HasPrefix(h, bearerPrefix) {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(h, bearerPrefix)), []byte(s.token)) == 1
+}
+
+type request struct {
+	gvr, ns, name string
+}
+
+// ParsePath extracts the resource coordinates from an api path.
+func parsePath(p string) (request, bool) {
+	var (
+		gv   string
+		segs = strings.Split(strings.Trim(p, "/"), "/")
+	)
+	switch {
+	case len(segs) >= 3 && segs[0] == "api":
+		gv, segs = segs[1], segs[2:]
+	case len(segs) >= 4 && segs[0] == "apis":
+		gv, segs = segs[1]+"/"+segs[2], segs[3:]
+	default:
+		return request{}, false
+	}
+
+	var req request
+	switch {
+	case len(segs) == 1:
+		req.gvr = segs[0]
+	case len(segs) == 2:
+		req.gvr, req.name = segs[0], segs[1]
+	case len(segs) == 3 && segs[0] == "namespaces":
+		req.ns, req.gvr = segs[1], segs[2]
+	case len(segs) == 4 && segs[0] == "namespaces":
+		req.ns, req.gvr, req.name = segs[1], segs[2], segs[3]
+	default:
+		return request{}, false
+	}
+	req.gvr = gv + "/" + req.gvr
+
+	return req, true
+}
+
+func (s *Server) get(w http.ResponseWriter, req request) {
+	if l, ok := s.archive.Resources[req.gvr]; ok {
+		for _, o := range l.Items {
+			if o.GetName() == req.name && o.GetNamespace() == req.ns {
+				writeJSON(w, http.StatusOK, o.Object)
+				return
+			}
+		}
+	}
+	writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("%s %q not found in snapshot", req.gvr, req.name))
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, req request) {
+	q := r.URL.Query()
+	lsel, err := labels.Parse(q.Get("labelSelector"))
+	if err != nil {
+		writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest, err.Error())
+		return
+	}
+	fsel, err := fields.ParseSelector(q.Get("fieldSelector"))
+	if err != nil {
+		writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest, err.Error())
+		return
+	}
+
+	var res unstructured.UnstructuredList
+	res.SetAPIVersion("v1")
+	res.SetKind("List")
+	if l, ok := s.archive.Resources[req.gvr]; ok {
+		res.SetAPIVersion(l.GetAPIVersion())
+		res.SetKind(l.GetKind())
+		res.SetResourceVersion(l.GetResourceVersion())
+		for _, o := range l.Items {
+			if req.ns != "" && o.GetNamespace() != req.ns {
+				continue
+			}
+			if !lsel.Matches(labels.Set(o.GetLabels())) || !fsel.Matches(fieldsFor(o, fsel)) {
+				continue
+			}
+			res.Items = append(res.Items, o)
+		}
+	}
+	if res.GetResourceVersion() == "" {
+		res.SetResourceVersion("1")
+	}
+
+	if strings.Contains(r.Header.Get("Accept"), tableAccept) {
+		writeJSON(w, http.StatusOK, s.toTable(&res))
+		return
+	}
+	raw, err := res.MarshalJSON()
+	if err != nil {
+		writeStatus(w, http.StatusInternalServerError, metav1.StatusReasonInternalError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(raw)
+}
+
+// Watch holds the connection open as nothing ever changes in a snapshot.
+func (s *Server) watch(w http.ResponseWriter, r *http.Request) {
+	to := defaultWatchTO
+	if secs, err := strconv.Atoi(r.URL.Query().Get("timeoutSeconds")); err == nil && secs > 0 {
+		to = time.Duration(secs) * time.Second
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	select {
+	case <-r.Context().Done():
+	case <-time.After(to):
+	}
+}
+
+// Review grants read access only.
+func (s *Server) review(w http.ResponseWriter, r *http.Request) {
+	var sar authorizationv1.SelfSubjectAccessReview
+	if err := json.NewDecoder(r.Body).Decode(&sar); err != nil {
+		writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest, err.Error())
+		return
+	}
+	sar.APIVersion, sar.Kind = authorizationv1.SchemeGroupVersion.String(), "SelfSubjectAccessReview"
+	if attrs := sar.Spec.ResourceAttributes; attrs != nil {
+		switch attrs.Verb {
+		case "get", "list", "watch":
+			sar.Status.Allowed = true
+		default:
+			sar.Status.Reason = readOnlyMsg
+		}
+	}
+	writeJSON(w, http.StatusCreated, sar)
+}
+
+func (s *Server) toTable(l *unstructured.UnstructuredList) *metav1beta1.Table {
+	t := metav1beta1.Table{
+		TypeMeta: metav1.TypeMeta{APIVersion: metav1beta1.SchemeGroupVersion.String(), Kind: "Table"},
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Age", Type: "string"},
+		},
+		Rows: make([]metav1beta1.TableRow, 0, len(l.Items)),
+	}
+	for i := range l.Items {
+		o := l.Items[i]
+		raw, err := o.MarshalJSON()
+		if err != nil {
+			log.Error().Err(err).Msgf("Snapshot unable to marshal %q", o.GetName())
+			continue
+		}
+		t.Rows = append(t.Rows, metav1beta1.TableRow{
+			Cells:  []interface{}{o.GetName(), duration.HumanDuration(time.Since(o.GetCreationTimestamp().Time))},
+			Object: runtime.RawExtension{Raw: raw},
+		})
+	}
+
+	return &t
+}
+
+// FieldsFor extracts the selector fields values from a resource.
+func fieldsFor(o unstructured.Unstructured, sel fields.Selector) fields.Set {
+	set := make(fields.Set)
+	for _, r := range sel.Requirements() {
+		v, ok, err := unstructured.NestedFieldNoCopy(o.Object, strings.Split(r.Field, ".")...)
+		if err != nil || !ok {
+			continue
+		}
+		set[r.Field] = fmt.Sprintf("%v", v)
+	}
+
+	return set
+}
+
+func writeJSON(w http.ResponseWriter, code int, o interface{}) {
+	raw, err := json.Marshal(o)
+	if err != nil {
+		writeStatus(w, http.StatusInternalServerError, metav1.StatusReasonInternalError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(raw)
+}
+
+func writeStatus(w http.ResponseWriter, code int, reason metav1.StatusReason, msg string) {
+	st := metav1.Status{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"},
+		Status:   metav1.StatusFailure,
+		Message:  msg,
+		Reason:   reason,
+		Code:     int32(code),
+	}
+	raw, _ := json.Marshal(st)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(raw)
+}
@@ -0,0 +1,120 @@
+package snapshot_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestServerList(t *testing.T) {
+	ts, cfg := makeServer(t)
+	defer ts.Close()
+	dial, err := dynamic.NewForConfig(cfg)
+	assert.Nil(t, err)
+	pods := dial.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"})
+
+	uu := map[string]struct {
+		ns   string
+		opts metav1.ListOptions
+		e    int
+	}{
+		"all":       {e: 2},
+		"namespace": {ns: "kube-system", e: 1},
+		"labels":    {opts: metav1.ListOptions{LabelSelector: "app=fred"}, e: 1},
+		"fields":    {opts: metav1.ListOptions{FieldSelector: "spec.nodeName=n2"}, e: 0},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, err := pods.Namespace(u.ns).List(u.opts)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, len(l.Items))
+		})
+	}
+}
+
+func TestServerGet(t *testing.T) {
+	ts, cfg := makeServer(t)
+	defer ts.Close()
+	dial, err := dynamic.NewForConfig(cfg)
+	assert.Nil(t, err)
+	dps := dial.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"})
+
+	o, err := dps.Namespace("default").Get("d1", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "d1", o.GetName())
+
+	_, err = dps.Namespace("default").Get("d2", metav1.GetOptions{})
+	assert.NotNil(t, err)
+}
+
+func TestServerReadOnly(t *testing.T) {
+	ts, cfg := makeServer(t)
+	defer ts.Close()
+	c, err := kubernetes.NewForConfig(cfg)
+	assert.Nil(t, err)
+
+	for verb, e := range map[string]bool{"list": true, "delete": false} {
+		sar := authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{Resource: "pods", Verb: verb},
+			},
+		}
+		resp, err := c.AuthorizationV1().SelfSubjectAccessReviews().Create(&sar)
+		assert.Nil(t, err)
+		assert.Equal(t, e, resp.Status.Allowed)
+	}
+
+	err = c.CoreV1().Pods("default").Delete("p1", nil)
+	assert.NotNil(t, err)
+
+	_, err = c.Discovery().ServerVersion()
+	assert.Nil(t, err)
+}
+
+func TestServerToken(t *testing.T) {
+	ts, cfg := makeServer(t)
+	defer ts.Close()
+
+	uu := map[string]struct {
+		token string
+		e     int
+	}{
+		"none":  {e: http.StatusUnauthorized},
+		"bad":   {token: "Bearer fred", e: http.StatusUnauthorized},
+		"valid": {token: "Bearer " + cfg.BearerToken, e: http.StatusOK},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, ts.URL+"/version", nil)
+			assert.Nil(t, err)
+			if u.token != "" {
+				req.Header.Set("Authorization", u.token)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, resp.StatusCode)
+			resp.Body.Close()
+		})
+	}
+}
+
+func makeServer(t *testing.T) (*httptest.Server, *rest.Config) {
+	s, err := snapshot.NewServer(makeArchive())
+	assert.Nil(t, err)
+	ts := httptest.NewServer(s)
+
+	return ts, &rest.Config{Host: ts.URL, BearerToken: s.Token()}
+}
@@ -0,0 +1,57 @@
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const promptKey = "prompt"
+
+// Field represents a prompt input field.
+type Field struct {
+	Label, Value string
+}
+
+// PromptFunc receives the prompt field values in order.
+type PromptFunc func(vals []string)
+
+// ShowPrompt pops a dialog collecting user inputs.
+func ShowPrompt(p *ui.Pages, title string, ff []Field, okFn PromptFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	vals := make([]string, len(ff))
+	for i, fd := range ff {
+		i := i
+		vals[i] = fd.Value
+		f.AddInputField(fd.Label+":", fd.Value, 40, nil, func(v string) {
+			vals[i] = v
+		})
+	}
+
+	f.AddButton("OK", func() {
+		DismissPrompt(p)
+		okFn(vals)
+	})
+	f.AddButton("Cancel", func() {
+		DismissPrompt(p)
+	})
+
+	modal := tview.NewModalForm("<"+title+">", f)
+	modal.SetDoneFunc(func(int, string) {
+		DismissPrompt(p)
+	})
+	p.AddPage(promptKey, modal, false, false)
+	p.ShowPage(promptKey)
+}
+
+// DismissPrompt dismiss the prompt dialog.
+func DismissPrompt(p *ui.Pages) {
+	p.RemovePage(promptKey)
+}
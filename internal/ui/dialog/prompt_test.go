@@ -0,0 +1,24 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromptDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	okFunc := func(vals []string) {
+		assert.Equal(t, []string{"fred", "blee"}, vals)
+	}
+	ShowPrompt(p, "Yo", []Field{{Label: "Name", Value: "fred"}, {Label: "Path", Value: "blee"}}, okFunc)
+
+	d := p.GetPrimitive(promptKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	DismissPrompt(p)
+	assert.Nil(t, p.GetPrimitive(promptKey))
+}
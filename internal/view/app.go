@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
@@ -28,12 +29,16 @@ const (
 type App struct {
 	*ui.App
 
-	Content    *PageStack
-	command    *Command
-	factory    *watch.Factory
-	version    string
-	showHeader bool
-	cancelFn   context.CancelFunc
+	Content     *PageStack
+	command     *Command
+	factory     *watch.Factory
+	pool        *watch.Pool
+	history     *client.MetricsHistory
+	version     string
+	showHeader  bool
+	cancelFn    context.CancelFunc
+	sampler     *MetricsSampler
+	snapshotter *Snapshotter
 }
 
 // NewApp returns a K9s app instance.
@@ -43,6 +48,7 @@ func NewApp(cfg *config.Config) *App {
 		Content: NewPageStack(),
 	}
 	a.Config = cfg
+	a.snapshotter = NewSnapshotter(&a)
 	a.InitBench(cfg.K9s.CurrentCluster)
 
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App, a.Styles)
@@ -64,6 +70,7 @@ func (a *App) Init(version string, rate int) error {
 
 	a.App.Init()
 	a.bindKeys()
+	a.initThresholds()
 	if a.Conn() == nil {
 		return errors.New("No client connection detected")
 	}
@@ -74,6 +81,13 @@ func (a *App) Init(version string, rate int) error {
 
 	a.factory = watch.NewFactory(a.Conn())
 	a.initFactory(ns)
+	a.pool = watch.NewPool(a.Conn().Config(), a.informerOpts())
+	a.initMetricsProvider()
+
+	mx := a.Config.K9s.Metrics
+	a.history = client.NewMetricsHistory(mx.RetentionDuration(), mx.MaxSamples, mx.MaxSeries)
+	a.sampler = NewMetricsSampler(a)
+	a.Content.Stack.AddListener(a.sampler)
 
 	a.command = NewCommand(a)
 	if err := a.command.Init(); err != nil {
@@ -160,6 +174,26 @@ func (a *App) Resume() {
 	}
 }
 
+func (a *App) sampleMetrics(ns string) {
+	if !a.Conn().HasMetrics() {
+		return
+	}
+	if err := a.history.Sample(client.NewMetricsServer(a.factory.Client()), ns); err != nil {
+		log.Warn().Err(err).Msg("Metrics sampling failed")
+	}
+}
+
+func (a *App) sampleHPA(path string) {
+	hpa, err := dao.FetchHPAInsight(a.factory, path)
+	if err != nil {
+		log.Warn().Err(err).Msgf("HPA sampling failed for %s", path)
+		return
+	}
+	now := time.Now()
+	a.history.Record(client.HPASeries, path, client.Sample{Time: now, Replicas: hpa.Current})
+	a.history.Prune(now)
+}
+
 func (a *App) clusterUpdater(ctx context.Context) {
 	for {
 		select {
@@ -226,7 +260,6 @@ func (a *App) switchNS(ns string) bool {
 		log.Error().Err(err).Msg("Config Set NS failed!")
 		return false
 	}
-	a.factory.SetActiveNS(ns)
 
 	return true
 }
@@ -241,7 +274,9 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 		if err != nil {
 			log.Warn().Msg("No namespace specified in context. Using K9s config")
 		}
+		a.snapshotter.Stop()
 		a.initFactory(ns)
+		a.history.Clear()
 
 		if err := a.command.Reset(); err != nil {
 			return err
@@ -250,6 +285,7 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 		if err := a.Config.Save(); err != nil {
 			log.Error().Err(err).Msg("Config save failed!")
 		}
+		a.initMetricsProvider()
 		a.Flash().Infof("Switching context to %s", name)
 		if err := a.gotoResource("pods", true); loadPods && err != nil {
 			a.Flash().Err(err)
@@ -263,11 +299,64 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 
 func (a *App) initFactory(ns string) {
 	a.factory.Terminate()
+	a.factory.SetOptions(a.informerOpts())
 	a.factory.Start(ns)
 }
 
+func (a *App) informerOpts() watch.Options {
+	opts := watch.DefaultOptions()
+	i := a.Config.K9s.Informers
+	if i == nil {
+		return opts
+	}
+	opts.IdleTimeout, opts.MaxGVRs = i.IdleDuration(), i.MaxGVRs
+	opts.ListPageSize, opts.ListThreshold = i.ListPageSize, i.ListThreshold
+
+	return opts
+}
+
+func (a *App) initThresholds() {
+	t := a.Config.K9s.Thresholds
+	if t == nil {
+		return
+	}
+	render.CPUThreshold = render.Threshold{Warn: t.CPU.Warn, Critical: t.CPU.Critical}
+	render.MEMThreshold = render.Threshold{Warn: t.Memory.Warn, Critical: t.Memory.Critical}
+}
+
+// InitMetricsProvider sources metrics from Prometheus when configured for the active cluster.
+func (a *App) initMetricsProvider() {
+	conn, ok := a.Conn().(interface {
+		SetMetricsProvider(client.MetricsProvider)
+	})
+	if !ok {
+		return
+	}
+
+	prom := a.Config.K9s.ActiveCluster().Prometheus
+	if !prom.IsEnabled() {
+		conn.SetMetricsProvider(nil)
+		return
+	}
+	p, err := client.NewPrometheus(prom.Opts())
+	if err != nil {
+		log.Error().Err(err).Msg("Prometheus metrics provider init failed")
+		conn.SetMetricsProvider(nil)
+		return
+	}
+	log.Info().Msgf("Sourcing metrics from Prometheus %s", prom.URL)
+	conn.SetMetricsProvider(p)
+}
+
 // BailOut exists the application.
 func (a *App) BailOut() {
+	if a.sampler != nil {
+		a.sampler.Stop()
+	}
+	a.snapshotter.Stop()
+	if a.pool != nil {
+		a.pool.Terminate()
+	}
 	a.factory.Terminate()
 	a.App.BailOut()
 }
@@ -411,6 +500,42 @@ func (a *App) aliasCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// IsReadOnly checks if cluster mutations are disabled.
+func (a *App) IsReadOnly() bool {
+	return a.Config.K9s.IsReadOnly()
+}
+
+// addMutations adds actions that mutate the cluster unless k9s runs in
+// read-only mode. Actions are checked again when they fire.
+func (a *App) addMutations(aa, mm ui.KeyActions) {
+	if a.IsReadOnly() {
+		return
+	}
+	for k, m := range mm {
+		m.Action = a.guardMutation(m.Action)
+		aa[k] = m
+	}
+}
+
+func (a *App) guardMutation(h ui.ActionHandler) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if !a.canMutate() {
+			return nil
+		}
+		return h(evt)
+	}
+}
+
+// CanMutate checks if a cluster mutation may proceed and warns otherwise.
+func (a *App) canMutate() bool {
+	if !a.IsReadOnly() {
+		return true
+	}
+	a.Flash().Warn("Cluster mutations are disabled in read-only mode")
+
+	return false
+}
+
 func (a *App) gotoResource(res string, clearStack bool) error {
 	return a.command.run(res, clearStack)
 }
@@ -0,0 +1,61 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppAddMutations(t *testing.T) {
+	uu := map[string]struct {
+		ro bool
+		e  int
+	}{
+		"writable": {e: 1},
+		"readOnly": {ro: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := makeApp()
+			a.Config.K9s.OverrideReadOnly(u.ro)
+			var fired bool
+			aa := make(ui.KeyActions)
+			a.addMutations(aa, ui.KeyActions{
+				ui.KeyR: ui.NewKeyAction("Mutate", func(*tcell.EventKey) *tcell.EventKey {
+					fired = true
+					return nil
+				}, true),
+			})
+
+			assert.Equal(t, u.e, len(aa))
+			if u.ro {
+				return
+			}
+			aa[ui.KeyR].Action(nil)
+			assert.True(t, fired)
+		})
+	}
+}
+
+func TestAppGuardMutation(t *testing.T) {
+	a := makeApp()
+	var fired bool
+	h := a.guardMutation(func(*tcell.EventKey) *tcell.EventKey {
+		fired = true
+		return nil
+	})
+
+	a.Config.K9s.OverrideReadOnly(true)
+	h(nil)
+	assert.False(t, fired)
+	assert.False(t, a.canMutate())
+
+	a.Config.K9s.OverrideReadOnly(false)
+	h(nil)
+	assert.True(t, fired)
+	assert.True(t, a.canMutate())
+}
@@ -0,0 +1,240 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Apply presents a preview of local manifests to be applied.
+type Apply struct {
+	*Table
+
+	path      string
+	manifests []dao.Manifest
+	actions   map[string]string
+	results   map[string]string
+	conflicts map[string]bool
+	applier   *dao.Applier
+	dryRun    bool
+}
+
+// NewApply returns a new manifests apply viewer.
+func NewApply(path string) *Apply {
+	return &Apply{
+		Table:     NewTable(client.NewGVR("apply")),
+		path:      path,
+		actions:   make(map[string]string),
+		results:   make(map[string]string),
+		conflicts: make(map[string]bool),
+	}
+}
+
+// Init initializes the component.
+func (a *Apply) Init(ctx context.Context) error {
+	if err := a.Table.Init(ctx); err != nil {
+		return err
+	}
+	// Read-only mode only ever previews changes.
+	a.dryRun = a.app.IsReadOnly()
+
+	var err error
+	if a.manifests, err = dao.LoadManifests(a.path); err != nil {
+		return err
+	}
+	if a.applier, err = dao.NewApplier(a.app.Conn()); err != nil {
+		return err
+	}
+	if ns := a.app.Config.ActiveNamespace(); ns != render.NamespaceAll {
+		a.applier.SetNamespace(ns)
+	}
+	for _, m := range a.manifests {
+		action, err := a.applier.Plan(m)
+		if err != nil {
+			a.results[m.ID()] = render.ManifestErr + err.Error()
+			continue
+		}
+		a.actions[m.ID()] = action
+	}
+
+	a.SetColorerFn(render.Manifest{}.ColorerFunc())
+	a.bindKeys()
+	a.refresh()
+	a.Select(1, 0)
+
+	return nil
+}
+
+// Name returns the component name.
+func (a *Apply) Name() string { return "apply" }
+
+func (a *Apply) bindKeys() {
+	a.Actions().Delete(tcell.KeyCtrlS, ui.KeyShiftA)
+	a.Actions().Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", a.resetCmd, false),
+		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", a.filterCmd, false),
+		ui.KeyA:         ui.NewKeyAction("Apply", a.applyCmd, true),
+		ui.KeyY:         ui.NewKeyAction("YAML", a.yamlCmd, true),
+		ui.KeyShiftK:    ui.NewKeyAction("Sort Kind", a.SortColCmd(0, true), false),
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", a.SortColCmd(2, true), false),
+	})
+	a.app.addMutations(a.Actions(), ui.KeyActions{
+		ui.KeyR:      ui.NewKeyAction("Toggle Dry-Run", a.toggleDryRunCmd, true),
+		ui.KeyShiftF: ui.NewKeyAction("Force Conflicts", a.forceCmd, true),
+	})
+}
+
+func (a *Apply) refresh() {
+	a.BaseTitle = filepath.Base(a.path)
+	if a.dryRun {
+		a.BaseTitle += " [dry-run]"
+	}
+
+	var (
+		re   render.Manifest
+		data = render.TableData{Header: re.Header(render.AllNamespaces), Namespace: render.AllNamespaces}
+	)
+	for _, m := range a.manifests {
+		var row render.Row
+		err := re.Render(render.ManifestRes{
+			ID:        m.ID(),
+			Kind:      m.Object.GetKind(),
+			Namespace: m.Object.GetNamespace(),
+			Name:      m.Object.GetName(),
+			Path:      m.Path,
+			Action:    a.actions[m.ID()],
+			Result:    a.results[m.ID()],
+		}, render.AllNamespaces, &row)
+		if err != nil {
+			log.Error().Err(err).Msg("Manifest render failed")
+			continue
+		}
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, row))
+	}
+	a.SetModel(model.NewStaticTable(data))
+	a.Refresh()
+}
+
+func (a *Apply) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !a.dryRun && !a.app.canMutate() {
+		return nil
+	}
+	msg := fmt.Sprintf("Apply %d resource(s) from %s?", len(a.manifests), filepath.Base(a.path))
+	if a.dryRun {
+		msg = fmt.Sprintf("Dry-run %d resource(s) from %s?", len(a.manifests), filepath.Base(a.path))
+	}
+	dialog.ShowConfirm(a.app.Content.Pages, "Confirm Apply", msg, func() {
+		a.apply(a.manifests, false)
+	}, func() {})
+
+	return nil
+}
+
+// ForceCmd re-applies conflicting resources taking over fields owned by
+// other managers.
+func (a *Apply) forceCmd(evt *tcell.EventKey) *tcell.EventKey {
+	var mm []dao.Manifest
+	for _, m := range a.manifests {
+		if a.conflicts[m.ID()] {
+			mm = append(mm, m)
+		}
+	}
+	if len(mm) == 0 {
+		a.app.Flash().Info("No field ownership conflicts to force")
+		return nil
+	}
+	msg := fmt.Sprintf("Force apply %d conflicting resource(s)? Fields managed by others will be taken over.", len(mm))
+	dialog.ShowConfirm(a.app.Content.Pages, "Confirm Force Apply", msg, func() {
+		a.apply(mm, true)
+	}, func() {})
+
+	return nil
+}
+
+func (a *Apply) apply(mm []dao.Manifest, force bool) {
+	var failed, conflicts int
+	for _, m := range mm {
+		res, err := a.applier.Apply(m, a.dryRun, force)
+		a.conflicts[m.ID()] = errors.IsConflict(err)
+		switch {
+		case err != nil:
+			failed++
+			if a.conflicts[m.ID()] {
+				conflicts++
+			}
+			res = render.ManifestErr + err.Error()
+		case !a.dryRun:
+			a.actions[m.ID()] = dao.ApplyUpdate
+		}
+		a.results[m.ID()] = res
+	}
+	a.refresh()
+	switch {
+	case conflicts > 0:
+		a.app.Flash().Errf("Apply failed for %d of %d resource(s). %d conflict(s), use Shift-F to force", failed, len(mm), conflicts)
+	case failed > 0:
+		a.app.Flash().Errf("Apply failed for %d of %d resource(s)", failed, len(mm))
+	default:
+		a.app.Flash().Infof("Applied %d resource(s)", len(mm))
+	}
+}
+
+func (a *Apply) toggleDryRunCmd(evt *tcell.EventKey) *tcell.EventKey {
+	a.dryRun = !a.dryRun
+	a.refresh()
+	a.app.Flash().Infof("Dry-run %t", a.dryRun)
+
+	return nil
+}
+
+func (a *Apply) yamlCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := a.GetSelectedItem()
+	for _, m := range a.manifests {
+		if m.ID() != id {
+			continue
+		}
+		raw, err := toYAML(m.Object)
+		if err != nil {
+			a.app.Flash().Errf("Unable to marshal resource %s", err)
+			return nil
+		}
+		details := NewDetails(a.app, "YAML", id).Update(raw)
+		if err := a.app.inject(details); err != nil {
+			a.app.Flash().Err(err)
+		}
+		return nil
+	}
+
+	return evt
+}
+
+func (a *Apply) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !a.SearchBuff().IsActive() {
+		return evt
+	}
+	a.SearchBuff().SetActive(false)
+	a.Refresh()
+
+	return nil
+}
+
+func (a *Apply) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !a.SearchBuff().InCmdMode() {
+		a.SearchBuff().Reset()
+		return a.app.PrevCmd(evt)
+	}
+	a.SearchBuff().Reset()
+	a.Refresh()
+
+	return nil
+}
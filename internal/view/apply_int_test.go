@@ -0,0 +1,44 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyReadOnly(t *testing.T) {
+	uu := map[string]struct {
+		ro, e bool
+	}{
+		"writable": {e: true},
+		"readOnly": {ro: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := NewApply("fred.yaml")
+			assert.Nil(t, a.Table.Init(makeContext()))
+			a.app.Config.K9s.OverrideReadOnly(u.ro)
+			a.bindKeys()
+
+			_, ok := a.Actions()[ui.KeyR]
+			assert.Equal(t, u.e, ok)
+			_, ok = a.Actions()[ui.KeyShiftF]
+			assert.Equal(t, u.e, ok)
+			a.dryRun = false
+			a.applyCmd(nil)
+			assert.Equal(t, u.e, a.app.Content.Pages.HasPage("confirm"))
+		})
+	}
+}
+
+func TestApplyForceNoConflicts(t *testing.T) {
+	a := NewApply("fred.yaml")
+	assert.Nil(t, a.Table.Init(makeContext()))
+	a.bindKeys()
+
+	a.forceCmd(nil)
+	assert.False(t, a.app.Content.Pages.HasPage("confirm"))
+}
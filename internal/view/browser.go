@@ -28,6 +28,8 @@ type Browser struct {
 	accessor   dao.Accessor
 	contextFn  ContextFunc
 	cancelFn   context.CancelFunc
+	// RefNS tracks the namespace the factory informer was acquired for.
+	refNS string
 }
 
 // NewBrowser returns a new browser.
@@ -88,6 +90,8 @@ func (b *Browser) Start() {
 	b.Stop()
 
 	b.Table.Start()
+	b.refNS = b.GetModel().GetNamespace()
+	b.app.factory.Acquire(b.refNS, b.GVR())
 	ctx := b.defaultContext()
 	ctx, b.cancelFn = context.WithCancel(ctx)
 	if b.contextFn != nil {
@@ -105,6 +109,7 @@ func (b *Browser) Stop() {
 		return
 	}
 	b.Table.Stop()
+	b.app.factory.Release(b.refNS, b.GVR())
 	log.Debug().Msgf("BROWSER <STOP> %q", b.gvr)
 	b.cancelFn()
 	b.cancelFn = nil
@@ -242,6 +247,83 @@ func (b *Browser) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) ownerCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	u, err := getUnstructured(b.app, b.gvr, path)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	gvr, owner, err := dao.OwnerOf(u)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	ns, n := client.Namespaced(owner)
+	if err := gotoGVR(b.app, gvr, ns, n); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (b *Browser) ownedCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := b.app.inject(NewOwned(b.gvr, path)); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (b *Browser) eventsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showTimeline(b.app, b.gvr, path)
+
+	return nil
+}
+
+func (b *Browser) duplicateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	u, err := getUnstructured(b.app, b.gvr, path)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	_, n := client.Namespaced(path)
+	ff := []dialog.Field{{Label: "Name", Value: n + "-copy"}}
+	dialog.ShowPrompt(b.app.Content.Pages, "Duplicate "+path, ff, func(vals []string) {
+		if vals[0] == "" || vals[0] == n {
+			b.app.Flash().Warn("Duplicate requires a new name")
+			return
+		}
+		raw, err := toYAML(dao.Duplicate(u, vals[0]))
+		if err != nil {
+			b.app.Flash().Err(err)
+			return
+		}
+		b.Stop()
+		defer b.Start()
+		editAndCreate(b.app, []byte(raw))
+	})
+
+	return nil
+}
+
 func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := b.GetSelectedItem()
 	if path == "" {
@@ -314,6 +396,12 @@ func (b *Browser) setNamespace(ns string) {
 		ns = render.AllNamespaces
 	}
 	b.GetModel().SetNamespace(ns)
+	// Move the informer reference along if the browser is running.
+	if b.cancelFn != nil {
+		b.app.factory.Release(b.refNS, b.GVR())
+		b.refNS = ns
+		b.app.factory.Acquire(b.refNS, b.GVR())
+	}
 }
 
 func (b *Browser) defaultContext() context.Context {
@@ -359,17 +447,26 @@ func (b *Browser) refreshActions() {
 	}
 	b.namespaceActions(aa)
 
+	mm := make(ui.KeyActions)
 	if client.Can(b.meta.Verbs, "edit") {
-		aa[ui.KeyE] = ui.NewKeyAction("Edit", b.editCmd, true)
+		mm[ui.KeyE] = ui.NewKeyAction("Edit", b.editCmd, true)
 	}
 	if client.Can(b.meta.Verbs, "delete") {
-		aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", b.deleteCmd, true)
+		mm[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", b.deleteCmd, true)
 	}
 
 	if !dao.IsK9sMeta(b.meta) {
 		aa[ui.KeyY] = ui.NewKeyAction("YAML", b.viewCmd, true)
 		aa[ui.KeyD] = ui.NewKeyAction("Describe", b.describeCmd, true)
+		aa[ui.KeyX] = ui.NewKeyAction("Diff", b.diffCmd, true)
+		aa[ui.KeyO] = ui.NewKeyAction("Owner", b.ownerCmd, true)
+		aa[tcell.KeyCtrlO] = ui.NewKeyAction("Owned", b.ownedCmd, true)
+		aa[ui.KeyShiftE] = ui.NewKeyAction("Events", b.eventsCmd, true)
+	}
+	if !dao.IsK9sMeta(b.meta) && client.Can(b.meta.Verbs, "create") {
+		mm[tcell.KeyCtrlN] = ui.NewKeyAction("Duplicate", b.duplicateCmd, true)
 	}
+	b.app.addMutations(aa, mm)
 
 	pluginActions(b, aa)
 	hotKeyActions(b, aa)
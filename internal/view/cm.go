@@ -0,0 +1,97 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	"sigs.k8s.io/yaml"
+)
+
+// ConfigMap represents a configmap viewer.
+type ConfigMap struct {
+	ResourceViewer
+}
+
+// NewConfigMap returns a new viewer.
+func NewConfigMap(gvr client.GVR) ResourceViewer {
+	c := ConfigMap{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.SetBindKeysFn(c.bindKeys)
+	c.GetTable().SetEnterFn(c.showKeys)
+
+	return &c
+}
+
+func (c *ConfigMap) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyU: ui.NewKeyAction("UsedBy", c.usedByCmd, true),
+	})
+}
+
+func (c *ConfigMap) showKeys(app *App, ns, gvr, path string) {
+	log.Debug().Msgf("Showing Keys %q:%q -- %q", ns, gvr, path)
+	v := NewConfigMapKey(client.NewGVR("configmapkeys"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (c *ConfigMap) usedByCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	cm, err := configMapFor(c.App())
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	refs, err := cm.UsedBy(path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if len(refs) == 0 {
+		c.App().Flash().Infof("No pods reference configmap %s", path)
+		return nil
+	}
+
+	raw, err := yaml.Marshal(refs)
+	if err != nil {
+		c.App().Flash().Errf("Unable to marshal references %s", err)
+		return nil
+	}
+	details := NewDetails(c.App(), "UsedBy", path).Update(string(raw))
+	if err := c.App().inject(details); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func configMapFor(app *App) (*dao.ConfigMap, error) {
+	res, err := dao.AccessorFor(app.factory, client.NewGVR("v1/configmaps"))
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := res.(*dao.ConfigMap)
+	if !ok {
+		return nil, fmt.Errorf("expecting a configmap accessor but got %T", res)
+	}
+
+	return cm, nil
+}
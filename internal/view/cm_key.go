@@ -0,0 +1,217 @@
+package view
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	jsonKeyValRX = regexp.MustCompile(`\A(\s*)("(?:[^"\\]|\\.)*")(:\s*)(.*)\z`)
+	propKeyValRX = regexp.MustCompile(`\A(\s*)([^#!=:\s][^=:]*?)(\s*[=:]\s*)(.*)\z`)
+)
+
+// ConfigMapKey represents a configmap keys viewer.
+type ConfigMapKey struct {
+	ResourceViewer
+}
+
+// NewConfigMapKey returns a new viewer.
+func NewConfigMapKey(gvr client.GVR) ResourceViewer {
+	c := ConfigMapKey{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.SetBindKeysFn(c.bindKeys)
+	c.GetTable().SetEnterFn(c.viewKey)
+	c.GetTable().SetColorerFn(render.ConfigMapKey{}.ColorerFunc())
+
+	return &c
+}
+
+func (c *ConfigMapKey) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyX: ui.NewKeyAction("Export", c.exportCmd, true),
+	})
+	c.App().addMutations(aa, ui.KeyActions{
+		ui.KeyI: ui.NewKeyAction("Import", c.importCmd, true),
+	})
+}
+
+func (c *ConfigMapKey) viewKey(app *App, _, _, key string) {
+	cm, err := configMapFor(app)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	raw, binary, err := cm.Value(c.GetTable().Path, key)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	format := render.KeyFormat(key, raw, binary)
+	details := NewDetails(app, "Key", c.GetTable().Path+":"+key)
+	details.colorizer = keyColorizer(format)
+	details.Update(prettyKey(format, raw))
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (c *ConfigMapKey) exportCmd(evt *tcell.EventKey) *tcell.EventKey {
+	keys := c.GetTable().GetSelectedItems()
+	if len(keys) == 0 {
+		return evt
+	}
+
+	path := c.GetTable().Path
+	dir := filepath.Join(config.K9sDumpDir, c.App().Config.K9s.CurrentCluster, strings.Replace(path, "/", "-", -1))
+	dialog.ShowPrompt(c.App().Content.Pages, "Export", []dialog.Field{{Label: "Directory", Value: dir}}, func(vals []string) {
+		cm, err := configMapFor(c.App())
+		if err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		ff, err := cm.Export(path, keys, vals[0])
+		if err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().Flash().Infof("Exported %d key(s) to %s", len(ff), vals[0])
+	})
+
+	return nil
+}
+
+func (c *ConfigMapKey) importCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().Path
+	ff := []dialog.Field{
+		{Label: "File"},
+		{Label: "Key"},
+	}
+	dialog.ShowPrompt(c.App().Content.Pages, "Import", ff, func(vals []string) {
+		file, key := vals[0], dao.ImportKey(vals[1], vals[0])
+		cm, err := configMapFor(c.App())
+		if err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		if _, _, err := cm.Value(path, key); err != nil {
+			c.importKey(cm, path, key, file, false)
+			return
+		}
+		msg := fmt.Sprintf("Key %s already exists in configmap %s. Overwrite it?", key, path)
+		dialog.ShowConfirm(c.App().Content.Pages, "<Confirm Overwrite>", msg, func() {
+			c.importKey(cm, path, key, file, true)
+		}, func() {})
+	})
+
+	return nil
+}
+
+func (c *ConfigMapKey) importKey(cm *dao.ConfigMap, path, key, file string, overwrite bool) {
+	if err := cm.Import(path, key, file, overwrite); err != nil {
+		c.App().Flash().Errf("Import failed %s", err)
+		return
+	}
+	c.App().Flash().Infof("Imported %s into configmap %s key %s", file, path, key)
+	c.Refresh()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func prettyKey(format string, raw []byte) string {
+	switch format {
+	case render.BinaryFormat:
+		return base64.StdEncoding.EncodeToString(raw)
+	case render.JSONFormat:
+		var buff bytes.Buffer
+		if err := json.Indent(&buff, raw, "", "  "); err == nil {
+			return buff.String()
+		}
+	case render.YAMLFormat:
+		if s, ok := indentYAML(raw); ok {
+			return s
+		}
+	}
+
+	return string(raw)
+}
+
+// indentYAML reformats a yaml document preserving its keys order. Documents
+// with comments or several parts are left as is since they would not
+// survive the round trip.
+func indentYAML(raw []byte) (string, bool) {
+	for _, l := range strings.Split(string(raw), "\n") {
+		if l = strings.TrimSpace(l); strings.HasPrefix(l, "#") || strings.Contains(l, " #") || l == "---" {
+			return "", false
+		}
+	}
+	var m yaml.MapSlice
+	if err := yaml.Unmarshal(raw, &m); err != nil {
+		return "", false
+	}
+	out, err := yaml.Marshal(m)
+	if err != nil {
+		return "", false
+	}
+
+	return strings.TrimSuffix(string(out), "\n"), true
+}
+
+// keyColorizer returns a colorizer matching a key document format.
+func keyColorizer(format string) func(config.Yaml, string) string {
+	switch format {
+	case render.YAMLFormat:
+		return colorizeYAML
+	case render.JSONFormat:
+		return colorizeKeyVals(jsonKeyValRX)
+	case render.PropsFormat:
+		return colorizeKeyVals(propKeyValRX)
+	default:
+		return colorizeText
+	}
+}
+
+// colorizeKeyVals colors key/value lines matched by the given expression
+// using the yaml skin colors.
+func colorizeKeyVals(rx *regexp.Regexp) func(config.Yaml, string) string {
+	return func(style config.Yaml, raw string) string {
+		lines := strings.Split(raw, "\n")
+		buff := make([]string, 0, len(lines))
+		for _, l := range lines {
+			res := rx.FindStringSubmatch(l)
+			if len(res) != 5 {
+				buff = append(buff, fmt.Sprintf("[%s::]%s", style.ValueColor, tview.Escape(l)))
+				continue
+			}
+			buff = append(buff, fmt.Sprintf("%s[%s::b]%s[%s::-]%s[%s::]%s",
+				res[1],
+				style.KeyColor, tview.Escape(res[2]),
+				style.ColonColor, tview.Escape(res[3]),
+				style.ValueColor, tview.Escape(res[4]),
+			))
+		}
+
+		return strings.Join(buff, "\n")
+	}
+}
+
+func colorizeText(style config.Yaml, raw string) string {
+	return fmt.Sprintf("[%s::]%s", style.ValueColor, tview.Escape(raw))
+}
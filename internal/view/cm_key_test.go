@@ -0,0 +1,66 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrettyKey(t *testing.T) {
+	uu := map[string]struct {
+		format, raw, e string
+	}{
+		"json":        {render.JSONFormat, `{"a":1}`, "{\n  \"a\": 1\n}"},
+		"badJSON":     {render.JSONFormat, `{"a"`, `{"a"`},
+		"binary":      {render.BinaryFormat, "blee", "YmxlZQ=="},
+		"text":        {render.TextFormat, "blee", "blee"},
+		"yaml":        {render.YAMLFormat, "b: 1\na:   {c: [1,   2]}", "b: 1\na:\n  c:\n  - 1\n  - 2"},
+		"yamlComment": {render.YAMLFormat, "b: 1 # fred\na:   2", "b: 1 # fred\na:   2"},
+		"yamlMulti":   {render.YAMLFormat, "a: 1\n---\nb: 2", "a: 1\n---\nb: 2"},
+		"badYAML":     {render.YAMLFormat, "a: [", "a: ["},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, prettyKey(u.format, []byte(u.raw)))
+		})
+	}
+}
+
+func TestKeyColorizer(t *testing.T) {
+	uu := map[string]struct {
+		format, raw, e string
+	}{
+		"json": {
+			render.JSONFormat,
+			"{\n  \"a\": 1\n}",
+			"[green::]{\n  [blue::b]\"a\"[red::-]: [green::]1\n[green::]}",
+		},
+		"props": {
+			render.PropsFormat,
+			"# fred\na.b = [1]",
+			"[green::]# fred\n[blue::b]a.b[red::-] = [green::][1[]",
+		},
+		"yaml": {
+			render.YAMLFormat,
+			"a: 1",
+			"[blue::b]a[red::-]: [green::]1",
+		},
+		"text": {
+			render.TextFormat,
+			"a=[1]",
+			"[green::]a=[1[]",
+		},
+	}
+
+	s := config.Yaml{KeyColor: "blue", ColonColor: "red", ValueColor: "green"}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, keyColorizer(u.format)(s, u.raw))
+		})
+	}
+}
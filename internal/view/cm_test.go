@@ -0,0 +1,52 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.RegisterMeta("v1/configmaps", metav1.APIResource{
+		Name:         "configmaps",
+		SingularName: "configmap",
+		Namespaced:   true,
+		Kind:         "ConfigMaps",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.RegisterMeta("configmapkeys", metav1.APIResource{
+		Name:         "configmapkeys",
+		SingularName: "configmapkey",
+		Kind:         "ConfigMapKeys",
+		Categories:   []string{"k9s"},
+	})
+}
+
+func TestConfigMapNew(t *testing.T) {
+	c := view.NewConfigMap(client.NewGVR("v1/configmaps"))
+
+	assert.Nil(t, c.Init(makeCtx()))
+	assert.Equal(t, "ConfigMaps", c.Name())
+	assert.Equal(t, 4, len(c.Hints()))
+}
+
+func TestConfigMapKeyNew(t *testing.T) {
+	c := view.NewConfigMapKey(client.NewGVR("configmapkeys"))
+
+	assert.Nil(t, c.Init(makeCtx()))
+	assert.Equal(t, "ConfigMapKeys", c.Name())
+	assert.Equal(t, 5, len(c.Hints()))
+}
+
+func TestConfigMapKeyReadOnly(t *testing.T) {
+	c := view.NewConfigMapKey(client.NewGVR("configmapkeys"))
+
+	assert.Nil(t, c.Init(makeReadOnlyCtx()))
+	assert.False(t, hasHint(c.Hints(), "Import"))
+	assert.True(t, hasHint(c.Hints(), "Export"))
+}
@@ -1,9 +1,12 @@
 package view
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
@@ -49,6 +52,9 @@ func (c *Command) run(cmd string, clearStack bool) error {
 	}
 
 	cmds := strings.Split(cmd, " ")
+	if ctxs, rest := contextsFor(cmds); len(ctxs) > 0 {
+		return c.multiCtxCmd(rest, ctxs)
+	}
 	gvr, v, err := c.viewMetaFor(cmds[0])
 	if err != nil {
 		return err
@@ -99,6 +105,26 @@ func (c *Command) specialCmd(cmd string) bool {
 	case "a", "alias":
 		c.app.aliasCmd(nil)
 		return true
+	case "split":
+		if err := c.splitCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "apply":
+		if err := c.applyCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "diff":
+		if err := c.diffCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "snapshot", "snap":
+		if err := c.snapshotCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
 	default:
 		if !canRX.MatchString(cmd) {
 			return false
@@ -115,6 +141,115 @@ func (c *Command) specialCmd(cmd string) bool {
 	return false
 }
 
+// MultiCtxCmd handles `res [ns] @ctx1,ctx2`.
+func (c *Command) multiCtxCmd(cmds, ctxs []string) error {
+	if len(cmds) == 0 {
+		return errors.New("missing resource for contexts " + strings.Join(ctxs, ","))
+	}
+	gvr, ok := c.alias.Get(cmds[0])
+	if !ok {
+		return fmt.Errorf("Huh? `%s` Command not found", cmds[0])
+	}
+
+	return c.app.inject(NewMultiContext(client.NewGVR(gvr), cmdNamespace(cmds), ctxs))
+}
+
+// SplitCmd handles `split res [ns] @ctx1,ctx2`.
+func (c *Command) splitCmd(args []string) error {
+	ctxs, cmds := contextsFor(args)
+	if len(ctxs) != 2 || len(cmds) == 0 {
+		return errors.New("usage: split resource [namespace] @context1,context2")
+	}
+	gvr, ok := c.alias.Get(cmds[0])
+	if !ok {
+		return fmt.Errorf("Huh? `%s` Command not found", cmds[0])
+	}
+	ns := cmdNamespace(cmds)
+
+	return c.app.inject(NewSplit(
+		NewMultiContext(client.NewGVR(gvr), ns, ctxs[:1]),
+		NewMultiContext(client.NewGVR(gvr), ns, ctxs[1:]),
+	))
+}
+
+// ApplyCmd handles `apply [path]`. A file picker is shown when no path is given.
+func (c *Command) applyCmd(args []string) error {
+	if len(args) == 0 {
+		dir, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		return c.app.inject(NewFilePicker(dir))
+	}
+	if _, err := os.Stat(args[0]); err != nil {
+		return err
+	}
+
+	return c.app.inject(NewApply(args[0]))
+}
+
+// DiffCmd handles `diff -f manifest.yaml`.
+func (c *Command) diffCmd(args []string) error {
+	if len(args) != 2 || args[0] != "-f" {
+		return errors.New("usage: diff -f manifest.yaml")
+	}
+	path := args[1]
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+
+	return c.app.inject(NewDiff(c.app, path, func(full bool) (string, error) {
+		return dao.DryRunDiff(c.app.Conn(), path, full)
+	}))
+}
+
+// SnapshotCmd handles `snapshot [stop|res1,res2 [interval]]`.
+func (c *Command) snapshotCmd(args []string) error {
+	snap := c.app.snapshotter
+	if len(args) == 0 {
+		if snap.IsActive() {
+			c.app.Flash().Info(snap.Status())
+			return nil
+		}
+		gvr, ok := c.alias.Get(c.app.Config.ActiveView())
+		if !ok {
+			return fmt.Errorf("no resource found for view %q", c.app.Config.ActiveView())
+		}
+		ff, err := snap.Capture(client.NewGVR(gvr))
+		if err != nil {
+			return err
+		}
+		c.app.Flash().Infof("Snapshot %s saved successfully!", strings.Join(ff, ","))
+		return nil
+	}
+	if args[0] == "stop" {
+		snap.Stop()
+		c.app.Flash().Info("Snapshots stopped")
+		return nil
+	}
+
+	interval := defaultSnapshotInterval
+	if len(args) > 1 {
+		d, err := time.ParseDuration(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid snapshot interval %q", args[1])
+		}
+		interval = d
+	}
+	var gvrs []client.GVR
+	for _, a := range strings.Split(args[0], ",") {
+		gvr, ok := c.alias.Get(strings.TrimSpace(a))
+		if !ok {
+			return fmt.Errorf("Huh? `%s` resource not found", a)
+		}
+		gvrs = append(gvrs, client.NewGVR(gvr))
+	}
+	snap.Start(gvrs, interval)
+	c.app.Flash().Info(snap.Status())
+
+	return nil
+}
+
 func (c *Command) viewMetaFor(cmd string) (string, *MetaViewer, error) {
 	gvr, ok := c.alias.Get(cmd)
 	if !ok {
@@ -165,3 +300,35 @@ func (c *Command) exec(gvr string, comp model.Component, clearStack bool) error
 
 	return c.app.inject(comp)
 }
+
+// ContextsFor extracts `@ctx1,ctx2` contexts from a command.
+func contextsFor(cmds []string) ([]string, []string) {
+	var (
+		ctxs []string
+		rest = make([]string, 0, len(cmds))
+	)
+	for _, c := range cmds {
+		if !strings.HasPrefix(c, model.ContextSep) {
+			if c != "" {
+				rest = append(rest, c)
+			}
+			continue
+		}
+		for _, ctx := range strings.Split(strings.TrimPrefix(c, model.ContextSep), ",") {
+			if ctx = strings.TrimSpace(ctx); ctx != "" {
+				ctxs = append(ctxs, ctx)
+			}
+		}
+	}
+
+	return ctxs, rest
+}
+
+// CmdNamespace returns the optional namespace of a `res [ns]` command.
+func cmdNamespace(cmds []string) string {
+	if len(cmds) < 2 {
+		return ""
+	}
+
+	return cmds[1]
+}
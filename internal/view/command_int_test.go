@@ -0,0 +1,48 @@
+package view
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextsFor(t *testing.T) {
+	uu := map[string]struct {
+		cmd        string
+		ctxs, rest []string
+	}{
+		"none":     {"dp fred", nil, []string{"dp", "fred"}},
+		"single":   {"dp @prod", []string{"prod"}, []string{"dp"}},
+		"multi":    {"dp @prod,staging", []string{"prod", "staging"}, []string{"dp"}},
+		"ns":       {"po kube-system @prod,staging", []string{"prod", "staging"}, []string{"po", "kube-system"}},
+		"user":     {"po @admin@prod", []string{"admin@prod"}, []string{"po"}},
+		"trailing": {"po @prod, ", []string{"prod"}, []string{"po"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctxs, rest := contextsFor(strings.Split(u.cmd, " "))
+			assert.Equal(t, u.ctxs, ctxs)
+			assert.Equal(t, u.rest, rest)
+		})
+	}
+}
+
+func TestCmdNamespace(t *testing.T) {
+	uu := map[string]struct {
+		cmds []string
+		e    string
+	}{
+		"none": {[]string{"po"}, ""},
+		"ns":   {[]string{"po", "kube-system"}, "kube-system"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, cmdNamespace(u.cmds))
+		})
+	}
+}
@@ -42,11 +42,16 @@ func (c *Container) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftF: ui.NewKeyAction("PortForward", c.portFwdCmd, true),
 		ui.KeyS:      ui.NewKeyAction("Shell", c.shellCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Copy From", c.fsCmd(copyFrom), true),
+		ui.KeyF:      ui.NewKeyAction("Files", c.fsCmd(browseFS), true),
 		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", c.GetTable().SortColCmd(6, false), false),
 		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", c.GetTable().SortColCmd(7, false), false),
 		ui.KeyShiftX: ui.NewKeyAction("Sort CPU%", c.GetTable().SortColCmd(8, false), false),
 		ui.KeyShiftZ: ui.NewKeyAction("Sort MEM%", c.GetTable().SortColCmd(9, false), false),
 	})
+	c.App().addMutations(aa, ui.KeyActions{
+		ui.KeyShiftU: ui.NewKeyAction("Copy To", c.fsCmd(copyTo), true),
+	})
 }
 
 func (c *Container) k9sEnv() K9sEnv {
@@ -87,6 +92,22 @@ func (c *Container) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (c *Container) fsCmd(fn func(*App, string, string)) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		sel := c.GetTable().GetSelectedItem()
+		if sel == "" {
+			return evt
+		}
+		if state := c.GetTable().GetSelectedCell(3); state != "Running" {
+			c.App().Flash().Errf("Container %s is not running", sel)
+			return nil
+		}
+		fn(c.App(), c.GetTable().Path, c.selectedContainer())
+
+		return nil
+	}
+}
+
 func (c *Container) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := c.GetTable().GetSelectedItem()
 	if path == "" {
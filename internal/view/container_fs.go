@@ -0,0 +1,271 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const fsExecTimeout = 10 * time.Second
+
+// ContainerFS browses a container filesystem. When a select function is
+// set, it picks a file or directory instead of viewing files.
+type ContainerFS struct {
+	*Table
+
+	pod      *dao.Pod
+	path, co string
+	dir      string
+	files    map[string]dao.ContainerFile
+	dirOnly  bool
+	selectFn func(string)
+}
+
+// NewContainerFS returns a new container filesystem browser rooted at a
+// given directory.
+func NewContainerFS(pod *dao.Pod, path, co, dir string) *ContainerFS {
+	return &ContainerFS{
+		Table: NewTable(client.NewGVR("containerfiles")),
+		pod:   pod,
+		path:  path,
+		co:    co,
+		dir:   dir,
+	}
+}
+
+// Init initializes the component.
+func (f *ContainerFS) Init(ctx context.Context) error {
+	if err := f.Table.Init(ctx); err != nil {
+		return err
+	}
+	f.SetColorerFn(render.ContainerFile{}.ColorerFunc())
+	f.bindKeys()
+	f.open(f.dir)
+
+	return nil
+}
+
+// Name returns the component name.
+func (f *ContainerFS) Name() string { return "containerfiles" }
+
+func (f *ContainerFS) bindKeys() {
+	f.Actions().Delete(tcell.KeyCtrlS, ui.KeySpace, tcell.KeyCtrlSpace)
+	f.Actions().Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", f.resetCmd, false),
+	})
+	if f.selectFn != nil {
+		f.Actions().Add(ui.KeyActions{
+			tcell.KeyEnter: ui.NewKeyAction("Open/Select", f.openCmd, true),
+		})
+		return
+	}
+	f.Actions().Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Open", f.openCmd, true),
+		ui.KeyShiftD:   ui.NewKeyAction("Copy From", f.copyCmd, true),
+	})
+}
+
+// Load lists a directory off the UI thread. Fail is called on the UI thread
+// when the directory can't be listed.
+func (f *ContainerFS) load(dir string, fail func(error)) {
+	f.app.Flash().Infof("Listing %s...", dir)
+	go func() {
+		var ff []dao.ContainerFile
+		err := execWithTimeout(func() error {
+			var err error
+			ff, err = f.pod.ListFiles(f.path, f.co, dir)
+			return err
+		})
+		f.app.QueueUpdateDraw(func() {
+			if err != nil {
+				fail(err)
+				return
+			}
+			f.app.Flash().Clear()
+			f.update(dir, ff)
+		})
+	}()
+}
+
+func (f *ContainerFS) update(dir string, ff []dao.ContainerFile) {
+	var (
+		re   render.ContainerFile
+		data = render.TableData{Header: re.Header(render.AllNamespaces), Namespace: render.AllNamespaces}
+	)
+	if f.selectFn != nil {
+		data.RowEvents = append(data.RowEvents, f.rowFor(re, render.ContainerFileRes{Dir: dir, Name: ".", Type: render.FileDir}))
+	}
+	if dir != "/" {
+		data.RowEvents = append(data.RowEvents, f.rowFor(re, render.ContainerFileRes{Dir: dir, Name: parentDir, Type: render.FileDir}))
+	}
+	f.files = make(map[string]dao.ContainerFile, len(ff))
+	for _, file := range ff {
+		if f.dirOnly && file.Type != dao.FileTypeDir && file.Type != dao.FileTypeLink {
+			continue
+		}
+		row := f.rowFor(re, render.ContainerFileRes{
+			Dir:      dir,
+			Name:     file.Name,
+			Type:     file.Type,
+			Mode:     file.Mode,
+			Owner:    file.Owner,
+			Size:     file.Size,
+			Modified: file.Modified,
+			Link:     file.Link,
+		})
+		f.files[row.Row.ID] = file
+		data.RowEvents = append(data.RowEvents, row)
+	}
+
+	f.dir, f.BaseTitle = dir, f.path+":"+f.co+" "+dir
+	f.SetModel(model.NewStaticTable(data))
+	f.Refresh()
+	f.Select(1, 0)
+}
+
+func (f *ContainerFS) rowFor(re render.ContainerFile, res render.ContainerFileRes) render.RowEvent {
+	var row render.Row
+	_ = re.Render(res, render.AllNamespaces, &row)
+
+	return render.NewRowEvent(render.EventUnchanged, row)
+}
+
+func (f *ContainerFS) openCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if f.SearchBuff().IsActive() {
+		f.SearchBuff().SetActive(false)
+		f.Refresh()
+		return nil
+	}
+	sel := f.GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	switch {
+	case strings.HasSuffix(sel, "/"):
+		if dir := path.Clean(sel); dir != f.dir {
+			f.open(dir)
+			return nil
+		}
+		if f.selectFn != nil {
+			f.selectFn(f.dir)
+		}
+	case f.files[sel].Type == dao.FileTypeLink:
+		// Links may point to directories. Open the link as a file otherwise.
+		f.load(sel, func(error) { f.pick(sel) })
+	default:
+		f.pick(sel)
+	}
+
+	return nil
+}
+
+func (f *ContainerFS) open(dir string) {
+	f.load(dir, func(err error) { f.app.Flash().Err(err) })
+}
+
+func (f *ContainerFS) pick(file string) {
+	if f.selectFn != nil {
+		if f.dirOnly {
+			f.app.Flash().Warnf("%s is not a directory", file)
+			return
+		}
+		f.selectFn(file)
+		return
+	}
+	if f.files[file].Type == dao.FileTypeOther {
+		f.app.Flash().Warnf("%s is not a regular file", file)
+		return
+	}
+
+	f.app.Flash().Infof("Reading %s...", file)
+	go func() {
+		var (
+			content   string
+			truncated bool
+		)
+		err := execWithTimeout(func() error {
+			var err error
+			content, truncated, err = f.pod.ReadFile(f.path, f.co, file)
+			return err
+		})
+		f.app.QueueUpdateDraw(func() {
+			if err != nil {
+				f.app.Flash().Err(err)
+				return
+			}
+			f.app.Flash().Clear()
+			if truncated {
+				f.app.Flash().Warnf("%s is larger than %d bytes. Showing the head only", file, dao.MaxFileSize)
+			}
+			details := NewDetails(f.app, "File", f.co+":"+file).Update(content)
+			if err := f.app.inject(details); err != nil {
+				f.app.Flash().Err(err)
+			}
+		})
+	}()
+}
+
+func (f *ContainerFS) copyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := f.GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	src := path.Clean(sel)
+	if src == "/" {
+		f.app.Flash().Warn("Copying the container root is not supported")
+		return nil
+	}
+	promptCopyFrom(f.app, f.pod, f.path, f.co, src)
+
+	return nil
+}
+
+func (f *ContainerFS) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !f.SearchBuff().InCmdMode() {
+		f.SearchBuff().Reset()
+		return f.app.PrevCmd(evt)
+	}
+	f.SearchBuff().Reset()
+	f.Refresh()
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ExecWithTimeout runs a container command, bailing out if it hangs.
+func execWithTimeout(fn func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(fsExecTimeout):
+		return fmt.Errorf("container command timed out after %v", fsExecTimeout)
+	}
+}
+
+func browseFS(app *App, path, co string) {
+	po, err := podFor(app)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if err := app.inject(NewContainerFS(po, path, co, "/")); err != nil {
+		app.Flash().Err(err)
+	}
+}
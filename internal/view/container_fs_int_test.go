@@ -0,0 +1,40 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerFSKeys(t *testing.T) {
+	uu := map[string]struct {
+		picker bool
+		enter  string
+		copy   bool
+	}{
+		"browser": {enter: "Open", copy: true},
+		"picker":  {picker: true, enter: "Open/Select"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := NewContainerFS(nil, "fred/p1", "c1", "/")
+			if u.picker {
+				f.selectFn = func(string) {}
+			}
+			f.bindKeys()
+			assert.Equal(t, u.enter, f.Actions()[tcell.KeyEnter].Description)
+			_, ok := f.Actions()[ui.KeyShiftD]
+			assert.Equal(t, u.copy, ok)
+		})
+	}
+}
+
+func TestExecWithTimeout(t *testing.T) {
+	assert.Nil(t, execWithTimeout(func() error { return nil }))
+	assert.Equal(t, "boom", execWithTimeout(func() error { return errors.New("boom") }).Error())
+}
@@ -13,5 +13,13 @@ func TestContainerNew(t *testing.T) {
 
 	assert.Nil(t, c.Init(makeCtx()))
 	assert.Equal(t, "Containers", c.Name())
-	assert.Equal(t, 11, len(c.Hints()))
+	assert.Equal(t, 14, len(c.Hints()))
+}
+
+func TestContainerReadOnly(t *testing.T) {
+	c := view.NewContainer(client.NewGVR("containers"))
+
+	assert.Nil(t, c.Init(makeReadOnlyCtx()))
+	assert.False(t, hasHint(c.Hints(), "Copy To"))
+	assert.True(t, hasHint(c.Hints(), "Copy From"))
 }
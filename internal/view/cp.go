@@ -0,0 +1,129 @@
+package view
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+)
+
+const (
+	cpProgressRate = 250 * time.Millisecond
+	cpToDir        = "/tmp"
+)
+
+func podFor(app *App) (*dao.Pod, error) {
+	res, err := dao.AccessorFor(app.factory, client.NewGVR("v1/pods"))
+	if err != nil {
+		return nil, err
+	}
+	po, ok := res.(*dao.Pod)
+	if !ok {
+		return nil, fmt.Errorf("expecting a pod accessor but got %T", res)
+	}
+
+	return po, nil
+}
+
+func copyFrom(app *App, path, co string) {
+	po, err := podFor(app)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	picker := NewContainerFS(po, path, co, "/")
+	picker.selectFn = func(src string) {
+		app.Content.Pop()
+		promptCopyFrom(app, po, path, co, src)
+	}
+	if err := app.inject(picker); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func promptCopyFrom(app *App, po *dao.Pod, path, co, src string) {
+	dst, err := os.Getwd()
+	if err != nil {
+		dst = os.TempDir()
+	}
+	ff := []dialog.Field{{Label: "Local Dir", Value: dst}}
+	dialog.ShowPrompt(app.Content.Pages, "Copy "+src, ff, func(vals []string) {
+		desc := fmt.Sprintf("Copying %s:%s to %s", co, src, vals[0])
+		go transfer(app, desc, func(pf dao.ProgressFunc) (int64, error) {
+			return po.CopyFrom(path, co, src, vals[0], pf)
+		})
+	})
+}
+
+func copyTo(app *App, path, co string) {
+	po, err := podFor(app)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	picker := NewContainerFS(po, path, co, cpToDir)
+	picker.dirOnly = true
+	picker.selectFn = func(dst string) {
+		app.Content.Pop()
+		ff := []dialog.Field{{Label: "Local Path"}}
+		dialog.ShowPrompt(app.Content.Pages, "Copy to "+co+":"+dst, ff, func(vals []string) {
+			desc := fmt.Sprintf("Copying %s to %s:%s", vals[0], co, dst)
+			go transfer(app, desc, func(pf dao.ProgressFunc) (int64, error) {
+				return po.CopyTo(path, co, vals[0], dst, pf)
+			})
+		})
+	}
+	if err := app.inject(picker); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// Transfer runs a copy and reports its progress.
+func transfer(app *App, desc string, cp func(dao.ProgressFunc) (int64, error)) {
+	var last time.Time
+	n, err := cp(func(done, total int64) {
+		if time.Since(last) < cpProgressRate {
+			return
+		}
+		last = time.Now()
+		app.QueueUpdateDraw(func() {
+			app.Flash().Infof("%s... %s", desc, cpProgress(done, total))
+		})
+	})
+	app.QueueUpdateDraw(func() {
+		if err != nil {
+			app.Flash().Errf("%s failed: %s", desc, err)
+			return
+		}
+		app.Flash().Infof("%s done (%s)", desc, toHumanBytes(n))
+	})
+}
+
+func cpProgress(done, total int64) string {
+	if total <= 0 {
+		return toHumanBytes(done)
+	}
+	perc := done * 100 / total
+	if perc > 100 {
+		perc = 100
+	}
+
+	return fmt.Sprintf("%s/%s (%d%%)", toHumanBytes(done), toHumanBytes(total), perc)
+}
+
+func toHumanBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
@@ -63,10 +64,66 @@ func jobCtx(path, uid string) ContextFunc {
 
 func (c *CronJob) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
+		ui.KeyL: ui.NewKeyAction("Last Run Logs", c.lastRunLogsCmd, true),
+	})
+	c.App().addMutations(aa, ui.KeyActions{
 		tcell.KeyCtrlT: ui.NewKeyAction("Trigger", c.trigger, true),
+		ui.KeyS:        ui.NewKeyAction("Suspend/Resume", c.toggleSuspendCmd, true),
 	})
 }
 
+func (c *CronJob) toggleSuspendCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	cj, err := cronJobFor(c.App())
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	msg := fmt.Sprintf("Toggle suspend on cronjob %s?", sel)
+	dialog.ShowConfirm(c.App().Content.Pages, "Confirm Suspend/Resume", msg, func() {
+		suspend, err := cj.ToggleSuspend(sel)
+		if err != nil {
+			c.App().Flash().Errf("Suspend/Resume failed %s", err)
+			return
+		}
+		if suspend {
+			c.App().Flash().Infof("CronJob %s suspended", sel)
+		} else {
+			c.App().Flash().Infof("CronJob %s resumed", sel)
+		}
+		c.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+func (c *CronJob) lastRunLogsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	cj, err := cronJobFor(c.App())
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	path, err := cj.LastRun(sel)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if err := c.App().inject(NewLog(client.NewGVR("batch/v1/jobs"), path, "", false)); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (c *CronJob) trigger(evt *tcell.EventKey) *tcell.EventKey {
 	sel := c.GetTable().GetSelectedItem()
 	if sel == "" {
@@ -91,3 +148,19 @@ func (c *CronJob) trigger(evt *tcell.EventKey) *tcell.EventKey {
 
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func cronJobFor(app *App) (*dao.CronJob, error) {
+	res, err := dao.AccessorFor(app.factory, client.NewGVR("batch/v1beta1/cronjobs"))
+	if err != nil {
+		return nil, err
+	}
+	cj, ok := res.(*dao.CronJob)
+	if !ok {
+		return nil, fmt.Errorf("expecting a cronjob accessor but got %T", res)
+	}
+
+	return cj, nil
+}
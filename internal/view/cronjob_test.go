@@ -0,0 +1,35 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.RegisterMeta("batch/v1beta1/cronjobs", metav1.APIResource{
+		Name:       "cronjobs",
+		Kind:       "CronJob",
+		Categories: []string{"k9s"},
+	})
+}
+
+func TestCronJobMutations(t *testing.T) {
+	v := view.NewCronJob(client.NewGVR("batch/v1beta1/cronjobs"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.True(t, hasHint(v.Hints(), "Trigger"))
+	assert.True(t, hasHint(v.Hints(), "Suspend/Resume"))
+}
+
+func TestCronJobReadOnly(t *testing.T) {
+	v := view.NewCronJob(client.NewGVR("batch/v1beta1/cronjobs"))
+
+	assert.Nil(t, v.Init(makeReadOnlyCtx()))
+	assert.False(t, hasHint(v.Hints(), "Trigger"))
+	assert.False(t, hasHint(v.Hints(), "Suspend/Resume"))
+}
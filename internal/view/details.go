@@ -22,16 +22,18 @@ type Details struct {
 	app            *App
 	title, subject string
 	buff           string
+	colorizer      func(config.Yaml, string) string
 }
 
 // NewDetails returns a details viewer.
 func NewDetails(app *App, title, subject string) *Details {
 	d := Details{
-		TextView: tview.NewTextView(),
-		app:      app,
-		title:    title,
-		subject:  subject,
-		actions:  make(ui.KeyActions),
+		TextView:  tview.NewTextView(),
+		app:       app,
+		title:     title,
+		subject:   subject,
+		actions:   make(ui.KeyActions),
+		colorizer: colorizeYAML,
 	}
 
 	return &d
@@ -71,7 +73,7 @@ func (d *Details) StylesChanged(s *config.Styles) {
 // Update updates the view content.
 func (d *Details) Update(buff string) *Details {
 	d.buff = buff
-	d.SetText(colorizeYAML(d.app.Styles.Views().Yaml, buff))
+	d.SetText(d.colorizer(d.app.Styles.Views().Yaml, buff))
 	d.ScrollToBeginning()
 
 	return d
@@ -0,0 +1,100 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	diffAddColor = "green"
+	diffDelColor = "red"
+	noDiffMsg    = "No differences found."
+)
+
+// DiffFunc computes a unified diff. Full diffs include server managed fields.
+type DiffFunc func(full bool) (string, error)
+
+// Diff presents a colored unified diff of two resources.
+type Diff struct {
+	*Details
+
+	diffFn DiffFunc
+	full   bool
+}
+
+// NewDiff returns a new diff viewer.
+func NewDiff(app *App, subject string, f DiffFunc) *Diff {
+	return &Diff{
+		Details: NewDetails(app, "Diff", subject),
+		diffFn:  f,
+	}
+}
+
+// Init initializes the viewer.
+func (d *Diff) Init(ctx context.Context) error {
+	if err := d.Details.Init(ctx); err != nil {
+		return err
+	}
+	d.colorizer = colorizeDiff
+	d.Actions().Add(ui.KeyActions{
+		ui.KeyF: ui.NewKeyAction("Toggle Full", d.toggleFullCmd, true),
+	})
+
+	return d.refresh()
+}
+
+func (d *Diff) refresh() error {
+	diff, err := d.diffFn(d.full)
+	if err != nil {
+		return err
+	}
+	if diff == "" {
+		diff = noDiffMsg
+	}
+	d.Update(diff)
+
+	return nil
+}
+
+func (d *Diff) toggleFullCmd(evt *tcell.EventKey) *tcell.EventKey {
+	d.full = !d.full
+	if err := d.refresh(); err != nil {
+		d.app.Flash().Err(err)
+		return nil
+	}
+	if d.full {
+		d.app.Flash().Info("Showing full resources")
+	} else {
+		d.app.Flash().Info("Hiding server managed fields")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func colorizeDiff(style config.Yaml, raw string) string {
+	lines := strings.Split(tview.Escape(raw), "\n")
+	buff := make([]string, 0, len(lines))
+	for _, l := range lines {
+		switch {
+		case strings.HasPrefix(l, "+++"), strings.HasPrefix(l, "---"), strings.HasPrefix(l, "@@"):
+			buff = append(buff, fmt.Sprintf("[%s::b]%s[-::-]", style.KeyColor, l))
+		case strings.HasPrefix(l, "+"):
+			buff = append(buff, fmt.Sprintf("[%s::]%s[-::]", diffAddColor, l))
+		case strings.HasPrefix(l, "-"):
+			buff = append(buff, fmt.Sprintf("[%s::]%s[-::]", diffDelColor, l))
+		default:
+			buff = append(buff, fmt.Sprintf("[%s::]%s[-::]", style.ValueColor, l))
+		}
+	}
+
+	return strings.Join(buff, "\n")
+}
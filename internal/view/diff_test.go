@@ -0,0 +1,28 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorizeDiff(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+	}{
+		"header": {"--- default/p1", "[steelblue::b]--- default/p1[-::-]"},
+		"hunk":   {"@@ -1,2 +1,2 @@", "[steelblue::b]@@ -1,2 +1,2 @@[-::-]"},
+		"add":    {"+  name: p2", "[green::]+  name: p2[-::]"},
+		"del":    {"-  name: p1", "[red::]-  name: p1[-::]"},
+		"same":   {"   kind: Pod", "[papayawhip::]   kind: Pod[-::]"},
+	}
+
+	s := config.NewStyles()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, colorizeDiff(s.Views().Yaml, u.s))
+		})
+	}
+}
@@ -14,5 +14,14 @@ func TestDeploy(t *testing.T) {
 	assert.Nil(t, v.Init(makeCtx()))
 	assert.Equal(t, "Deployments", v.Name())
 	assert.Equal(t, 10, len(v.Hints()))
+	assert.True(t, hasHint(v.Hints(), "Scale"))
+	assert.True(t, hasHint(v.Hints(), "Restart"))
+}
+
+func TestDeployReadOnly(t *testing.T) {
+	v := view.NewDeploy(client.NewGVR("apps/v1/deployments"))
 
+	assert.Nil(t, v.Init(makeReadOnlyCtx()))
+	assert.False(t, hasHint(v.Hints(), "Scale"))
+	assert.False(t, hasHint(v.Hints(), "Restart"))
 }
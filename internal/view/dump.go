@@ -0,0 +1,76 @@
+package view
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Dump presents a read-only screen dump viewer.
+type Dump struct {
+	*Table
+
+	path string
+	dump *render.Dump
+}
+
+// NewDump returns a new screen dump viewer.
+func NewDump(path string, d *render.Dump) *Dump {
+	return &Dump{
+		Table: NewTable(client.NewGVR("dump")),
+		path:  path,
+		dump:  d,
+	}
+}
+
+// Init initializes the component.
+func (d *Dump) Init(ctx context.Context) error {
+	if err := d.Table.Init(ctx); err != nil {
+		return err
+	}
+	d.BaseTitle = filepath.Base(d.path)
+	d.SetModel(model.NewStaticTable(d.dump.TableData()))
+	d.SetColorerFn(render.DefaultColorer)
+	d.bindKeys()
+	d.Refresh()
+	d.Select(1, 0)
+
+	return nil
+}
+
+// Name returns the component name.
+func (d *Dump) Name() string { return "dump" }
+
+func (d *Dump) bindKeys() {
+	d.Actions().Delete(tcell.KeyCtrlS)
+	d.Actions().Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", d.resetCmd, false),
+		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", d.filterCmd, false),
+	})
+}
+
+func (d *Dump) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !d.SearchBuff().IsActive() {
+		return evt
+	}
+	d.SearchBuff().SetActive(false)
+	d.Refresh()
+
+	return nil
+}
+
+func (d *Dump) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !d.SearchBuff().InCmdMode() {
+		d.SearchBuff().Reset()
+		return d.app.PrevCmd(evt)
+	}
+	d.SearchBuff().Reset()
+	d.Refresh()
+
+	return nil
+}
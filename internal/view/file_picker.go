@@ -0,0 +1,151 @@
+package view
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const parentDir = ".."
+
+// FilePicker presents a manifests file picker.
+type FilePicker struct {
+	*Table
+
+	dir string
+}
+
+// NewFilePicker returns a new file picker rooted at a given directory.
+func NewFilePicker(dir string) *FilePicker {
+	return &FilePicker{
+		Table: NewTable(client.NewGVR("files")),
+		dir:   dir,
+	}
+}
+
+// Init initializes the component.
+func (f *FilePicker) Init(ctx context.Context) error {
+	if err := f.Table.Init(ctx); err != nil {
+		return err
+	}
+	f.SetColorerFn(render.File{}.ColorerFunc())
+	f.bindKeys()
+
+	return f.load(f.dir)
+}
+
+// Name returns the component name.
+func (f *FilePicker) Name() string { return "files" }
+
+func (f *FilePicker) bindKeys() {
+	f.Actions().Delete(tcell.KeyCtrlS, ui.KeySpace, tcell.KeyCtrlSpace)
+	f.Actions().Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", f.resetCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Open", f.openCmd, true),
+		ui.KeyA:         ui.NewKeyAction("Apply Dir", f.applyDirCmd, true),
+	})
+}
+
+func (f *FilePicker) load(dir string) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	var (
+		re   render.File
+		data = render.TableData{Header: re.Header(render.AllNamespaces), Namespace: render.AllNamespaces}
+	)
+	if parent, err := os.Stat(filepath.Join(dir, parentDir)); err == nil && filepath.Dir(dir) != dir {
+		data.RowEvents = append(data.RowEvents, f.rowFor(re, dir, renamed{FileInfo: parent, name: parentDir}))
+	}
+	for _, fi := range ff {
+		if !fi.IsDir() && !dao.IsManifest(fi.Name()) {
+			continue
+		}
+		data.RowEvents = append(data.RowEvents, f.rowFor(re, dir, fi))
+	}
+
+	f.dir, f.BaseTitle = dir, dir
+	f.SetModel(model.NewStaticTable(data))
+	f.Refresh()
+	f.Select(1, 0)
+
+	return nil
+}
+
+func (f *FilePicker) rowFor(re render.File, dir string, fi os.FileInfo) render.RowEvent {
+	var row render.Row
+	_ = re.Render(render.FileRes{File: fi, Dir: dir}, render.AllNamespaces, &row)
+
+	return render.NewRowEvent(render.EventUnchanged, row)
+}
+
+func (f *FilePicker) openCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if f.SearchBuff().IsActive() {
+		f.SearchBuff().SetActive(false)
+		f.Refresh()
+		return nil
+	}
+	path := f.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		f.app.Flash().Err(err)
+		return nil
+	}
+	if fi.IsDir() {
+		if err := f.load(path); err != nil {
+			f.app.Flash().Err(err)
+		}
+		return nil
+	}
+	if err := f.app.inject(NewApply(path)); err != nil {
+		f.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (f *FilePicker) applyDirCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if err := f.app.inject(NewApply(f.dir)); err != nil {
+		f.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (f *FilePicker) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !f.SearchBuff().InCmdMode() {
+		f.SearchBuff().Reset()
+		return f.app.PrevCmd(evt)
+	}
+	f.SearchBuff().Reset()
+	f.Refresh()
+
+	return nil
+}
+
+// Renamed overrides a file info name.
+type renamed struct {
+	os.FileInfo
+
+	name string
+}
+
+// Name returns the file name.
+func (r renamed) Name() string { return r.name }
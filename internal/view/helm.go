@@ -0,0 +1,227 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// Helm represents a Helm releases viewer.
+type Helm struct {
+	ResourceViewer
+}
+
+// NewHelm returns a new viewer.
+func NewHelm(gvr client.GVR) ResourceViewer {
+	h := Helm{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	h.GetTable().SetColorerFn(render.Helm{}.ColorerFunc())
+	h.SetBindKeysFn(h.bindKeys)
+	h.GetTable().SetEnterFn(h.showHistory)
+
+	return &h
+}
+
+func (h *Helm) bindKeys(aa ui.KeyActions) {
+	bindHelmKeys(aa, h.App(), h.GetTable(), func(string) int { return 0 })
+	if _, ok := aa[tcell.KeyCtrlD]; ok {
+		aa[tcell.KeyCtrlD] = ui.NewKeyAction("Uninstall", h.App().guardMutation(h.uninstallCmd), true)
+	}
+}
+
+func (h *Helm) uninstallCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := h.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Uninstall release %s?", path)
+	dialog.ShowConfirm(h.App().Content.Pages, "Confirm Uninstall", msg, func() {
+		hm, err := helmFor(h.App())
+		if err != nil {
+			h.App().Flash().Err(err)
+			return
+		}
+		h.App().Flash().Infof("Uninstalling %s...", path)
+		go func() {
+			kept, err := hm.Uninstall(path)
+			h.App().QueueUpdateDraw(func() {
+				switch {
+				case err != nil:
+					h.App().Flash().Err(err)
+				case len(kept) > 0:
+					h.App().Flash().Warnf("Uninstalled %s. Kept %s (resource policy)", path, strings.Join(kept, ","))
+				default:
+					h.App().Flash().Infof("Uninstalled %s", path)
+				}
+				h.Refresh()
+			})
+		}()
+	}, func() {})
+
+	return nil
+}
+
+func (h *Helm) showHistory(app *App, _, _, path string) {
+	v := NewHelmHistory(client.NewGVR("helmhistory"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// HelmHistory represents a Helm release revisions viewer.
+type HelmHistory struct {
+	ResourceViewer
+}
+
+// NewHelmHistory returns a new viewer.
+func NewHelmHistory(gvr client.GVR) ResourceViewer {
+	h := HelmHistory{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	h.GetTable().SetColorerFn(render.HelmHistory{}.ColorerFunc())
+	h.GetTable().SetSortCol(0, 0, false)
+	h.SetBindKeysFn(h.bindKeys)
+	h.GetTable().SetEnterFn(h.showManifest)
+
+	return &h
+}
+
+func (h *HelmHistory) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	bindHelmKeys(aa, h.App(), h.GetTable(), revision)
+	h.App().addMutations(aa, ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Rollback", h.rollbackCmd, true),
+	})
+}
+
+func (h *HelmHistory) showManifest(app *App, _, _, rev string) {
+	showHelm(app, h.GetTable().Path, revision(rev), "Manifest")
+}
+
+func (h *HelmHistory) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := h.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	path, rev := h.GetTable().Path, revision(sel)
+	msg := fmt.Sprintf("Rollback %s to revision %d?", path, rev)
+	dialog.ShowConfirm(h.App().Content.Pages, "Confirm Rollback", msg, func() {
+		hm, err := helmFor(h.App())
+		if err != nil {
+			h.App().Flash().Err(err)
+			return
+		}
+		h.App().Flash().Infof("Rolling back %s to revision %d...", path, rev)
+		go func() {
+			v, err := hm.Rollback(path, rev)
+			h.App().QueueUpdateDraw(func() {
+				if err != nil {
+					h.App().Flash().Err(err)
+					return
+				}
+				h.App().Flash().Infof("Rolled back %s to revision %d (revision %d)", path, rev, v)
+				h.Refresh()
+			})
+		}()
+	}, func() {})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func bindHelmKeys(aa ui.KeyActions, app *App, t *Table, revFn func(string) int) {
+	show := func(kind string) ui.ActionHandler {
+		return func(evt *tcell.EventKey) *tcell.EventKey {
+			sel := t.GetSelectedItem()
+			if sel == "" {
+				return evt
+			}
+			path := sel
+			if t.Path != "" {
+				path = t.Path
+			}
+			showHelm(app, path, revFn(sel), kind)
+			return nil
+		}
+	}
+
+	aa.Add(ui.KeyActions{
+		ui.KeyV:      ui.NewKeyAction("Values", show("Values"), true),
+		ui.KeyShiftV: ui.NewKeyAction("User Values", show("User Values"), true),
+		ui.KeyM:      ui.NewKeyAction("Manifest", show("Manifest"), true),
+		ui.KeyN:      ui.NewKeyAction("Notes", show("Notes"), true),
+	})
+}
+
+func showHelm(app *App, path string, rev int, kind string) {
+	h, err := helmFor(app)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	r, err := h.Revision(path, rev)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	var text string
+	switch kind {
+	case "Values":
+		text, err = r.ValuesYAML(true)
+	case "User Values":
+		text, err = r.ValuesYAML(false)
+	case "Manifest":
+		text = r.Manifest
+	case "Notes":
+		text = r.Notes
+	}
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if text == "" {
+		app.Flash().Infof("No %s found for %s", kind, path)
+		return
+	}
+
+	details := NewDetails(app, kind, fmt.Sprintf("%s@%d", path, r.Version)).Update(text)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func revision(s string) int {
+	rev, _ := strconv.Atoi(s)
+	return rev
+}
+
+func helmFor(app *App) (*dao.Helm, error) {
+	res, err := dao.AccessorFor(app.factory, client.NewGVR("helm"))
+	if err != nil {
+		return nil, err
+	}
+	h, ok := res.(*dao.Helm)
+	if !ok {
+		return nil, fmt.Errorf("expecting a helm accessor but got %T", res)
+	}
+
+	return h, nil
+}
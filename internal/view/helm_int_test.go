@@ -0,0 +1,48 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestHelmUninstallKey(t *testing.T) {
+	dao.RegisterMeta("helm", metav1.APIResource{
+		Name:       "helm",
+		Kind:       "Helm",
+		Verbs:      []string{"delete"},
+		Categories: []string{"k9s"},
+	})
+	uu := map[string]struct {
+		aa ui.KeyActions
+		e  string
+	}{
+		"delete": {
+			aa: ui.KeyActions{tcell.KeyCtrlD: ui.NewKeyAction("Delete", nil, true)},
+			e:  "Uninstall",
+		},
+		"readOnly": {
+			aa: ui.KeyActions{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			h := NewHelm(client.NewGVR("helm")).(*Helm)
+			assert.Nil(t, h.Init(makeContext()))
+			h.bindKeys(u.aa)
+
+			a, ok := u.aa[tcell.KeyCtrlD]
+			assert.Equal(t, u.e != "", ok)
+			if ok {
+				assert.Equal(t, u.e, a.Description)
+			}
+		})
+	}
+}
@@ -21,8 +21,8 @@ func TestHelp(t *testing.T) {
 	v := view.NewHelp()
 
 	assert.Nil(t, v.Init(ctx))
-	assert.Equal(t, 17, v.GetRowCount())
+	assert.Equal(t, 21, v.GetRowCount())
 	assert.Equal(t, 8, v.GetColumnCount())
-	assert.Equal(t, "<ctrl-k>", strings.TrimSpace(v.GetCell(1, 0).Text))
-	assert.Equal(t, "Kill", strings.TrimSpace(v.GetCell(1, 1).Text))
+	assert.Equal(t, "<shift-d>", strings.TrimSpace(v.GetCell(1, 0).Text))
+	assert.Equal(t, "Copy From", strings.TrimSpace(v.GetCell(1, 1).Text))
 }
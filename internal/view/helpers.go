@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -15,6 +16,8 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/printers"
 )
@@ -189,3 +192,39 @@ func fqn(ns, n string) string {
 	}
 	return ns + "/" + n
 }
+
+func getUnstructured(app *App, gvr client.GVR, path string) (*unstructured.Unstructured, error) {
+	o, err := app.factory.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u, nil
+}
+
+// GotoGVR shows a resource view for a gvr, filtered on resource names.
+func gotoGVR(app *App, gvr client.GVR, ns string, names ...string) error {
+	v, ok := customViewers[gvr]
+	if !ok {
+		v = MetaViewer{viewerFn: NewBrowser}
+	}
+	comp := app.command.componentFor(gvr.String(), &v)
+	qq := make([]string, 0, len(names))
+	for _, n := range names {
+		if n != "" {
+			qq = append(qq, regexp.QuoteMeta(n))
+		}
+	}
+	if len(qq) > 0 {
+		comp.GetTable().SearchBuff().Set("(^| )(" + strings.Join(qq, "|") + ")( |$)")
+	}
+	if ns != "" && !app.switchNS(ns) {
+		return fmt.Errorf("namespace switch failed for ns %q", ns)
+	}
+
+	return app.command.exec(gvr.String(), comp, false)
+}
@@ -0,0 +1,164 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// HPADetail presents a HPA replicas, metrics, conditions and scaling events.
+type HPADetail struct {
+	ResourceViewer
+}
+
+// NewHPADetail returns a new viewer.
+func NewHPADetail(gvr client.GVR) ResourceViewer {
+	h := HPADetail{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	h.GetTable().SetColorerFn(render.HPADetail{}.ColorerFunc())
+	h.GetTable().SetSortCol(0, 0, true)
+	h.GetTable().SetEnterFn(h.enter)
+	h.SetBindKeysFn(h.bindKeys)
+
+	return &h
+}
+
+func (h *HPADetail) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyT:      ui.NewKeyAction("Goto Target", h.gotoTargetCmd, true),
+		ui.KeyShiftH: ui.NewKeyAction("Replicas History", h.historyCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Section", h.GetTable().SortColCmd(0, true), false),
+	})
+	h.App().addMutations(aa, ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Edit Replicas", h.replicasCmd, true),
+	})
+}
+
+func (h *HPADetail) enter(app *App, _, _, path string) {
+	section, _ := splitHPADetailID(path)
+	switch section {
+	case render.HPASectionTarget:
+		h.gotoTarget()
+	case render.HPASectionReplicas:
+		if app.canMutate() {
+			h.editReplicas()
+		}
+	}
+}
+
+func (h *HPADetail) gotoTargetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	h.gotoTarget()
+	return nil
+}
+
+func (h *HPADetail) gotoTarget() {
+	hpa, err := dao.FetchHPAInsight(h.App().factory, h.GetTable().Path)
+	if err != nil {
+		h.App().Flash().Err(err)
+		return
+	}
+	gvr, err := hpa.TargetGVR()
+	if err != nil {
+		h.App().Flash().Err(err)
+		return
+	}
+	if err := gotoGVR(h.App(), gvr, hpa.Namespace, hpa.TargetName); err != nil {
+		h.App().Flash().Err(err)
+	}
+}
+
+func (h *HPADetail) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if err := h.App().inject(NewMetricsChart(h.App(), client.HPASeries, h.GetTable().Path)); err != nil {
+		h.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (h *HPADetail) replicasCmd(evt *tcell.EventKey) *tcell.EventKey {
+	h.editReplicas()
+	return nil
+}
+
+func (h *HPADetail) editReplicas() {
+	path := h.GetTable().Path
+	hpa, err := dao.FetchHPAInsight(h.App().factory, path)
+	if err != nil {
+		h.App().Flash().Err(err)
+		return
+	}
+	ff := []dialog.Field{
+		{Label: "Min", Value: strconv.Itoa(int(hpa.Min))},
+		{Label: "Max", Value: strconv.Itoa(int(hpa.Max))},
+	}
+	dialog.ShowPrompt(h.App().Content.Pages, "Replicas "+path, ff, func(vals []string) {
+		min, err := strconv.Atoi(vals[0])
+		if err != nil {
+			h.App().Flash().Errf("Invalid min replicas %q", vals[0])
+			return
+		}
+		max, err := strconv.Atoi(vals[1])
+		if err != nil {
+			h.App().Flash().Errf("Invalid max replicas %q", vals[1])
+			return
+		}
+		acc, err := hpaFor(h.App())
+		if err != nil {
+			h.App().Flash().Err(err)
+			return
+		}
+		if err := acc.SetReplicas(path, int32(min), int32(max)); err != nil {
+			h.App().Flash().Err(err)
+			return
+		}
+		h.App().Flash().Infof("HPA %s replicas set to %d-%d", path, min, max)
+		h.Refresh()
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showHPADetail(app *App, _, _, path string) {
+	v := NewHPADetail(client.NewGVR("hpadetails"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyHistory, app.history)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func hpaFor(app *App) (*dao.HorizontalPodAutoscaler, error) {
+	res, err := dao.AccessorFor(app.factory, client.NewGVR("autoscaling/v1/horizontalpodautoscalers"))
+	if err != nil {
+		return nil, err
+	}
+	hpa, ok := res.(*dao.HorizontalPodAutoscaler)
+	if !ok {
+		return nil, fmt.Errorf("expecting a hpa accessor but got %T", res)
+	}
+
+	return hpa, nil
+}
+
+func splitHPADetailID(id string) (string, string) {
+	tokens := strings.SplitN(id, render.HPAIDSep, 2)
+	if len(tokens) != 2 {
+		return "", id
+	}
+
+	return tokens[0], tokens[1]
+}
@@ -0,0 +1,33 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.RegisterMeta("hpadetails", metav1.APIResource{
+		Name:       "hpadetails",
+		Kind:       "HPADetails",
+		Categories: []string{"k9s"},
+	})
+}
+
+func TestHPADetailMutations(t *testing.T) {
+	v := view.NewHPADetail(client.NewGVR("hpadetails"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.True(t, hasHint(v.Hints(), "Edit Replicas"))
+}
+
+func TestHPADetailReadOnly(t *testing.T) {
+	v := view.NewHPADetail(client.NewGVR("hpadetails"))
+
+	assert.Nil(t, v.Init(makeReadOnlyCtx()))
+	assert.False(t, hasHint(v.Hints(), "Edit Replicas"))
+}
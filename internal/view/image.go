@@ -0,0 +1,97 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Image presents a container images inventory viewer.
+type Image struct {
+	ResourceViewer
+}
+
+// NewImage returns a new viewer.
+func NewImage(gvr client.GVR) ResourceViewer {
+	i := Image{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	i.GetTable().SetColorerFn(render.Image{}.ColorerFunc())
+	i.GetTable().SetEnterFn(i.showPods)
+	i.SetContextFn(i.imageContext)
+	i.SetBindKeysFn(i.bindKeys)
+
+	return &i
+}
+
+func (i *Image) imageContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyImageScan, i.App().Config.K9s.ImageScan)
+}
+
+func (i *Image) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	if i.App().Config.K9s.ImageScan.IsEnabled() {
+		aa.Add(ui.KeyActions{ui.KeyS: ui.NewKeyAction("Rescan", i.scanCmd, true)})
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftP: ui.NewKeyAction("Sort Pods", i.GetTable().SortColCmd(4, false), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Critical", i.GetTable().SortColCmd(7, false), false),
+		ui.KeyShiftH: ui.NewKeyAction("Sort High", i.GetTable().SortColCmd(8, false), false),
+	})
+}
+
+func (i *Image) showPods(app *App, _, _, key string) {
+	img, err := i.image(key)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	nn := make([]string, 0, len(img.Pods))
+	for _, p := range img.Pods {
+		_, n := client.Namespaced(p)
+		nn = append(nn, n)
+	}
+	ns := render.NamespaceAll
+	if len(img.Namespaces) == 1 {
+		ns = img.Namespaces[0]
+	}
+	if err := gotoGVR(app, client.NewGVR("v1/pods"), ns, nn...); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (i *Image) scanCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := i.GetTable().GetSelectedItem()
+	if key == "" {
+		return evt
+	}
+	img, err := i.image(key)
+	if err != nil {
+		i.App().Flash().Err(err)
+		return nil
+	}
+	dao.ImageScans.Scan(i.App().Config.K9s.ImageScan, img, true)
+	i.App().Flash().Infof("Scanning image %s...", img.Ref())
+
+	return nil
+}
+
+func (i *Image) image(key string) (dao.Image, error) {
+	ii, err := dao.Images(i.App().factory, i.GetTable().GetModel().GetNamespace())
+	if err != nil {
+		return dao.Image{}, err
+	}
+	for _, img := range ii {
+		if img.Key() == key {
+			return img, nil
+		}
+	}
+
+	return dao.Image{}, fmt.Errorf("no pods found running image %s", key)
+}
@@ -0,0 +1,39 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Informer represents an informers diagnostics viewer.
+type Informer struct {
+	ResourceViewer
+}
+
+// NewInformer returns a new viewer.
+func NewInformer(gvr client.GVR) ResourceViewer {
+	i := Informer{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	i.SetBindKeysFn(i.bindKeys)
+	i.GetTable().SetEnterFn(i.gotoResource)
+	i.GetTable().SetColorerFn(render.Informer{}.ColorerFunc())
+
+	return &i
+}
+
+func (i *Informer) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftM: ui.NewKeyAction("Sort Mode", i.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Refs", i.GetTable().SortColCmd(3, false), false),
+		ui.KeyShiftI: ui.NewKeyAction("Sort Items", i.GetTable().SortColCmd(4, false), false),
+	})
+}
+
+func (i *Informer) gotoResource(app *App, _, _, _ string) {
+	gvr := client.NewGVR(i.GetTable().GetSelectedCell(0))
+	if err := app.gotoResource(gvr.ToR(), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
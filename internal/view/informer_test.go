@@ -0,0 +1,28 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.RegisterMeta("informers", metav1.APIResource{
+		Name:         "informers",
+		SingularName: "informer",
+		Kind:         "Informers",
+		Categories:   []string{"k9s"},
+	})
+}
+
+func TestInformerNew(t *testing.T) {
+	i := view.NewInformer(client.NewGVR("informers"))
+
+	assert.Nil(t, i.Init(makeCtx()))
+	assert.Equal(t, "Informers", i.Name())
+	assert.Equal(t, 6, len(i.Hints()))
+}
@@ -1,8 +1,14 @@
 package view
 
 import (
+	"fmt"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -17,12 +23,19 @@ type Job struct {
 // NewJob returns a new viewer.
 func NewJob(gvr client.GVR) ResourceViewer {
 	j := Job{ResourceViewer: NewLogsExtender(NewBrowser(gvr), nil)}
+	j.SetBindKeysFn(j.bindKeys)
 	j.GetTable().SetEnterFn(j.showPods)
 	j.GetTable().SetColorerFn(render.Job{}.ColorerFunc())
 
 	return &j
 }
 
+func (j *Job) bindKeys(aa ui.KeyActions) {
+	j.App().addMutations(aa, ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Rerun", j.rerunCmd, true),
+	})
+}
+
 func (*Job) showPods(app *App, _, gvr, path string) {
 	o, err := app.factory.Get(gvr, path, true, labels.Everything())
 	if err != nil {
@@ -39,3 +52,33 @@ func (*Job) showPods(app *App, _, gvr, path string) {
 
 	showPodsFromSelector(app, path, job.Spec.Selector)
 }
+
+func (j *Job) rerunCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := j.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	res, err := dao.AccessorFor(j.App().factory, client.NewGVR(j.GVR()))
+	if err != nil {
+		j.App().Flash().Err(err)
+		return nil
+	}
+	job, ok := res.(*dao.Job)
+	if !ok {
+		j.App().Flash().Err(fmt.Errorf("expecting a job accessor but got %T", res))
+		return nil
+	}
+	msg := fmt.Sprintf("Rerun job %s?", sel)
+	dialog.ShowConfirm(j.App().Content.Pages, "Confirm Rerun", msg, func() {
+		path, err := job.Rerun(sel)
+		if err != nil {
+			j.App().Flash().Errf("Rerun failed %s", err)
+			return
+		}
+		j.App().Flash().Infof("Job %s rerun as %s", sel, path)
+		j.Refresh()
+	}, func() {})
+
+	return nil
+}
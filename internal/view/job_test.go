@@ -0,0 +1,33 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.RegisterMeta("batch/v1/jobs", metav1.APIResource{
+		Name:       "jobs",
+		Kind:       "Job",
+		Categories: []string{"k9s"},
+	})
+}
+
+func TestJobMutations(t *testing.T) {
+	v := view.NewJob(client.NewGVR("batch/v1/jobs"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.True(t, hasHint(v.Hints(), "Rerun"))
+}
+
+func TestJobReadOnly(t *testing.T) {
+	v := view.NewJob(client.NewGVR("batch/v1/jobs"))
+
+	assert.Nil(t, v.Init(makeReadOnlyCtx()))
+	assert.False(t, hasHint(v.Hints(), "Rerun"))
+}
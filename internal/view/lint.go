@@ -0,0 +1,56 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Lint presents the cluster lint findings viewer.
+type Lint struct {
+	ResourceViewer
+}
+
+// NewLint returns a new viewer.
+func NewLint(gvr client.GVR) ResourceViewer {
+	l := Lint{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	l.GetTable().SetColorerFn(render.Lint{}.ColorerFunc())
+	l.GetTable().SetSortCol(l.GetTable().NameColIndex()+1, 0, true)
+	l.GetTable().SetEnterFn(l.gotoResource)
+	l.SetContextFn(l.lintContext)
+	l.SetBindKeysFn(l.bindKeys)
+
+	return &l
+}
+
+func (l *Lint) lintContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyLint, l.App().Config.K9s.Lint)
+}
+
+func (l *Lint) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Score", l.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Rule", l.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Resource", l.GetTable().SortColCmd(3, true), false),
+	})
+}
+
+func (l *Lint) gotoResource(app *App, _, _, path string) {
+	tokens := strings.SplitN(path, render.LintIDSep, 3)
+	if len(tokens) < 2 {
+		app.Flash().Errf("Invalid finding %q", path)
+		return
+	}
+	ns, n := client.Namespaced(tokens[1])
+	if err := gotoGVR(app, client.NewGVR(tokens[0]), ns, n); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,290 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// MultiContext presents a resource viewer aggregated across contexts.
+type MultiContext struct {
+	*Table
+
+	namespace string
+	contexts  []string
+	factories []*watch.Factory
+	meta      metav1.APIResource
+	model     *model.MultiTable
+	cancelFn  context.CancelFunc
+	active    bool
+	ready     bool
+}
+
+// NewMultiContext returns a new multi contexts viewer. The app active
+// namespace is used when no namespace is given.
+func NewMultiContext(gvr client.GVR, ns string, contexts []string) *MultiContext {
+	return &MultiContext{
+		Table:     NewTable(gvr),
+		namespace: ns,
+		contexts:  contexts,
+	}
+}
+
+// Init initializes the component.
+func (m *MultiContext) Init(ctx context.Context) error {
+	var err error
+	if m.meta, err = dao.MetaFor(m.gvr); err != nil {
+		return err
+	}
+	if err = m.Table.Init(ctx); err != nil {
+		return err
+	}
+	m.BaseTitle = m.meta.Kind + model.ContextSep + strings.Join(m.contexts, ",")
+
+	ns := m.viewNamespace()
+	m.setModel(ns, nil, nil)
+	m.SetColorerFn(contextColorer(m.GVR()))
+	m.bindKeys()
+
+	m.app.Flash().Infof("Connecting to %d context(s)...", len(m.contexts))
+	go m.connect(ns)
+
+	return nil
+}
+
+// Connect establishes the contexts connections off the UI thread.
+func (m *MultiContext) connect(ns string) {
+	ff, errs := m.app.pool.FactoriesFor(m.contexts, ns)
+	m.app.QueueUpdateDraw(func() {
+		if len(errs) > 0 {
+			m.app.Flash().Warnf("Unable to connect to %d context(s)", len(errs))
+		} else {
+			m.app.Flash().Clear()
+		}
+		m.setModel(ns, ff, errs)
+		m.ready = true
+		if m.active {
+			m.watch()
+		}
+	})
+}
+
+func (m *MultiContext) setModel(ns string, ff map[string]*watch.Factory, errs map[string]error) {
+	dd := make(map[string]dao.Factory, len(ff))
+	m.factories = m.factories[:0]
+	for c, f := range ff {
+		dd[c], m.factories = f, append(m.factories, f)
+	}
+	m.model = model.NewMultiTable(m.GVR(), dd)
+	m.model.SetNamespace(ns)
+	for c, err := range errs {
+		m.model.SetFailed(c, err)
+	}
+	m.model.SetRefreshRate(time.Duration(m.app.Config.K9s.GetRefreshRate()) * time.Second)
+	m.model.AddListener(m)
+	m.SetModel(m.model)
+}
+
+// Name returns the component name.
+func (m *MultiContext) Name() string { return m.BaseTitle }
+
+// Start runs the component.
+func (m *MultiContext) Start() {
+	m.Stop()
+
+	m.Table.Start()
+	m.active = true
+	if m.ready {
+		m.watch()
+	}
+}
+
+func (m *MultiContext) watch() {
+	for _, f := range m.factories {
+		f.Acquire(m.model.GetNamespace(), m.GVR())
+	}
+	ctx := context.WithValue(context.Background(), internal.KeyGVR, m.GVR())
+	ctx = context.WithValue(ctx, internal.KeyNamespace, m.model.GetNamespace())
+	ctx = context.WithValue(ctx, internal.KeyLabels, "")
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx, m.cancelFn = context.WithCancel(ctx)
+	m.model.Watch(ctx)
+}
+
+// Stop terminates the component.
+func (m *MultiContext) Stop() {
+	if !m.active {
+		return
+	}
+	m.active = false
+	m.Table.Stop()
+	if m.cancelFn == nil {
+		return
+	}
+	for _, f := range m.factories {
+		f.Release(m.model.GetNamespace(), m.GVR())
+	}
+	m.cancelFn()
+	m.cancelFn = nil
+}
+
+// TableLoadFailed notifies view something went south.
+func (m *MultiContext) TableLoadFailed(err error) {
+	m.app.QueueUpdateDraw(func() {
+		m.app.Flash().Err(err)
+	})
+}
+
+// TableDataChanged notifies view new data is available.
+func (m *MultiContext) TableDataChanged(data render.TableData) {
+	m.app.QueueUpdateDraw(func() {
+		m.Update(data)
+	})
+}
+
+// ViewNamespace returns the namespace to list resources from.
+func (m *MultiContext) viewNamespace() string {
+	ns := m.namespace
+	if ns == "" {
+		ns = m.app.Config.ActiveNamespace()
+	}
+	switch {
+	case !m.meta.Namespaced:
+		return render.ClusterScope
+	case ns == render.NamespaceAll, ns == render.ClusterScope:
+		return render.AllNamespaces
+	default:
+		return ns
+	}
+}
+
+func (m *MultiContext) bindKeys() {
+	nameCol := 0
+	if m.model.GetNamespace() == render.AllNamespaces {
+		nameCol = 1
+	}
+	m.Actions().Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	m.Actions().Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", m.resetCmd, false),
+		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", m.filterCmd, false),
+		ui.KeyD:         ui.NewKeyAction("Describe", m.describeCmd, true),
+		ui.KeyY:         ui.NewKeyAction("YAML", m.yamlCmd, true),
+		ui.KeyShiftC:    ui.NewKeyAction("Sort Context", m.SortColCmd(-2, true), false),
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", m.SortColCmd(nameCol, true), false),
+	})
+}
+
+func (m *MultiContext) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !m.SearchBuff().IsActive() {
+		return evt
+	}
+	m.SearchBuff().SetActive(false)
+	m.Refresh()
+
+	return nil
+}
+
+func (m *MultiContext) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !m.SearchBuff().InCmdMode() {
+		m.SearchBuff().Reset()
+		return m.app.PrevCmd(evt)
+	}
+	m.SearchBuff().Reset()
+	m.Refresh()
+
+	return nil
+}
+
+func (m *MultiContext) selectedFactory() (dao.Factory, string, bool) {
+	c, path := model.ContextPath(m.GetSelectedItem())
+	if path == "" {
+		return nil, "", false
+	}
+	f, ok := m.model.FactoryFor(c)
+	if !ok {
+		m.app.Flash().Errf("No connection for context %q", c)
+		return nil, "", false
+	}
+
+	return f, path, true
+}
+
+func (m *MultiContext) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	f, path, ok := m.selectedFactory()
+	if !ok {
+		return evt
+	}
+
+	ns, n := client.Namespaced(path)
+	yaml, err := dao.Describe(f.Client(), m.gvr, ns, n)
+	if err != nil {
+		m.app.Flash().Errf("Describe command failed: %s", err)
+		return nil
+	}
+	details := NewDetails(m.app, "Describe", m.GetSelectedItem()).Update(yaml)
+	if err := m.app.inject(details); err != nil {
+		m.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (m *MultiContext) yamlCmd(evt *tcell.EventKey) *tcell.EventKey {
+	f, path, ok := m.selectedFactory()
+	if !ok {
+		return evt
+	}
+
+	o, err := f.Get(m.GVR(), path, true, labels.Everything())
+	if err != nil {
+		m.app.Flash().Errf("Unable to get resource %q -- %s", m.gvr, err)
+		return nil
+	}
+	raw, err := toYAML(o)
+	if err != nil {
+		m.app.Flash().Errf("Unable to marshal resource %s", err)
+		return nil
+	}
+	details := NewDetails(m.app, "YAML", m.GetSelectedItem()).Update(raw)
+	if err := m.app.inject(details); err != nil {
+		m.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ContextColorer colors rows using the resource colorer minus the context column.
+func contextColorer(gvr string) render.ColorerFunc {
+	colorer := render.DefaultColorer
+	if meta, ok := model.Registry[gvr]; ok && meta.Renderer != nil {
+		colorer = meta.Renderer.ColorerFunc()
+	}
+
+	return func(ns string, re render.RowEvent) tcell.Color {
+		// Failed contexts rows have no resource path.
+		if _, path := model.ContextPath(re.Row.ID); path == "" {
+			return render.ErrColor
+		}
+		if len(re.Row.Fields) > 0 {
+			re.Row.Fields = re.Row.Fields[1:]
+		}
+		if len(re.Deltas) > 0 {
+			re.Deltas = re.Deltas[1:]
+		}
+		return colorer(ns, re)
+	}
+}
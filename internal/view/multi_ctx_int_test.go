@@ -0,0 +1,34 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiContextViewNamespace(t *testing.T) {
+	uu := map[string]struct {
+		ns         string
+		namespaced bool
+		e          string
+	}{
+		"active":  {namespaced: true, e: "default"},
+		"ns":      {ns: "fred", namespaced: true, e: "fred"},
+		"all":     {ns: render.NamespaceAll, namespaced: true, e: render.AllNamespaces},
+		"cluster": {ns: "fred", e: render.ClusterScope},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := makeApp()
+			m := NewMultiContext(client.NewGVR("v1/pods"), u.ns, []string{"c1", "c2"})
+			m.app, m.meta.Namespaced = a, u.namespaced
+
+			assert.Equal(t, u.e, m.viewNamespace())
+			assert.Equal(t, "default", a.Config.ActiveNamespace())
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	chartTitle  = "Chart"
+	chartHeight = 8
+)
+
+var sparks = []rune{' ', '▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// MetricsChart represents a metrics history chart viewer.
+type MetricsChart struct {
+	*tview.TextView
+
+	app       *App
+	actions   ui.KeyActions
+	kind, fqn string
+	cancelFn  context.CancelFunc
+}
+
+// NewMetricsChart returns a new chart viewer.
+func NewMetricsChart(app *App, kind, fqn string) *MetricsChart {
+	return &MetricsChart{
+		TextView: tview.NewTextView(),
+		app:      app,
+		kind:     kind,
+		fqn:      fqn,
+		actions:  make(ui.KeyActions),
+	}
+}
+
+// Init initializes the viewer.
+func (c *MetricsChart) Init(_ context.Context) error {
+	c.SetBorder(true)
+	c.SetBorderPadding(0, 0, 1, 1)
+	c.SetDynamicColors(true)
+	c.SetWrap(false)
+	c.SetTitleColor(tcell.ColorAqua)
+	c.SetBackgroundColor(c.app.Styles.BgColor())
+	c.SetTextColor(c.app.Styles.FgColor())
+	c.SetBorderFocusColor(config.AsColor(c.app.Styles.Frame().Border.FocusColor))
+	c.SetInputCapture(c.keyboard)
+	c.actions.Set(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", c.app.PrevCmd, false),
+	})
+	title := fmt.Sprintf(detailsTitleFmt, chartTitle, c.kind+":"+c.fqn)
+	c.SetTitle(ui.SkinTitle(title, c.app.Styles.Frame()))
+
+	return nil
+}
+
+// Name returns the component name.
+func (c *MetricsChart) Name() string { return chartTitle }
+
+// Start starts the chart updater.
+func (c *MetricsChart) Start() {
+	c.Stop()
+
+	var ctx context.Context
+	ctx, c.cancelFn = context.WithCancel(context.Background())
+	c.refresh()
+	go c.updater(ctx)
+}
+
+// Stop terminates the chart updater.
+func (c *MetricsChart) Stop() {
+	if c.cancelFn == nil {
+		return
+	}
+	c.cancelFn()
+	c.cancelFn = nil
+}
+
+// Hints returns menu hints.
+func (c *MetricsChart) Hints() model.MenuHints {
+	return c.actions.Hints()
+}
+
+func (c *MetricsChart) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := c.actions[evt.Key()]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (c *MetricsChart) updater(ctx context.Context) {
+	rate := time.Duration(c.app.Config.K9s.GetRefreshRate()) * time.Second
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rate):
+			c.app.QueueUpdateDraw(c.refresh)
+		}
+	}
+}
+
+func (c *MetricsChart) refresh() {
+	if c.app.history == nil {
+		c.SetText("[red::]Metrics history is not available")
+		return
+	}
+	ss := c.app.history.Samples(c.kind, c.fqn)
+	if len(ss) == 0 {
+		c.SetText("[orange::]No metrics samples yet...")
+		return
+	}
+
+	_, _, width, _ := c.GetInnerRect()
+	if width <= 0 {
+		width = 80
+	}
+	var buff strings.Builder
+	since := ss[len(ss)-1].Time.Sub(ss[0].Time).Round(time.Second)
+	fmt.Fprintf(&buff, "[white::]Last %v (%d samples, retention %v)\n\n", since, len(ss), c.app.history.Retention())
+	st, _ := c.app.history.Stats(c.kind, c.fqn)
+	if c.kind == client.HPASeries {
+		rr := make([]float64, len(ss))
+		for i, s := range ss {
+			rr[i] = float64(s.Replicas)
+		}
+		writeChart(&buff, "REPLICAS", "aqua", rr, st.Replicas, width)
+		c.SetText(buff.String())
+		return
+	}
+
+	cpu, mem := make([]float64, len(ss)), make([]float64, len(ss))
+	for i, s := range ss {
+		cpu[i], mem[i] = float64(s.CPU), s.MEM
+	}
+	writeChart(&buff, "CPU(m)", "aqua", cpu, st.CPU, width)
+	buff.WriteString("\n")
+	writeChart(&buff, "MEM(Mi)", "lawngreen", mem, st.MEM, width)
+	c.SetText(buff.String())
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func writeChart(buff *strings.Builder, title, color string, vv []float64, st client.Stats, width int) {
+	fmt.Fprintf(buff, "[orange::b]%s[white::-] min:%.0f avg:%.0f max:%.0f p95:%.0f\n", title, st.Min, st.Avg, st.Max, st.P95)
+	for _, l := range sparkChart(vv, width, chartHeight) {
+		fmt.Fprintf(buff, "[%s::]%s\n", color, l)
+	}
+}
+
+// SparkChart renders the most recent values as a bar chart of the given size.
+func sparkChart(vv []float64, width, height int) []string {
+	if len(vv) > width {
+		vv = vv[len(vv)-width:]
+	}
+	var max float64
+	for _, v := range vv {
+		max = math.Max(max, v)
+	}
+
+	levels := len(sparks) - 1
+	rows := make([]string, height)
+	for r := 0; r < height; r++ {
+		line := make([]rune, len(vv))
+		floor := (height - r - 1) * levels
+		for i, v := range vv {
+			var l int
+			if max > 0 {
+				l = int(math.Round(v / max * float64(height*levels)))
+			}
+			switch {
+			case l >= floor+levels:
+				line[i] = sparks[levels]
+			case l > floor:
+				line[i] = sparks[l-floor]
+			default:
+				line[i] = sparks[0]
+			}
+		}
+		rows[r] = string(line)
+	}
+
+	return rows
+}
@@ -0,0 +1,47 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkChart(t *testing.T) {
+	uu := map[string]struct {
+		vv     []float64
+		width  int
+		height int
+		e      []string
+	}{
+		"empty": {
+			width:  5,
+			height: 2,
+			e:      []string{"", ""},
+		},
+		"zeros": {
+			vv:     []float64{0, 0},
+			width:  5,
+			height: 1,
+			e:      []string{"  "},
+		},
+		"scaled": {
+			vv:     []float64{0, 4, 8},
+			width:  5,
+			height: 2,
+			e:      []string{"  █", " ██"},
+		},
+		"truncated": {
+			vv:     []float64{8, 1, 8},
+			width:  2,
+			height: 1,
+			e:      []string{"▁█"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, sparkChart(u.vv, u.width, u.height))
+		})
+	}
+}
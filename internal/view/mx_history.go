@@ -0,0 +1,53 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// MetricsHistory represents a metrics history viewer.
+type MetricsHistory struct {
+	ResourceViewer
+}
+
+// NewMetricsHistory returns a new viewer.
+func NewMetricsHistory(gvr client.GVR) ResourceViewer {
+	m := MetricsHistory{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	m.SetBindKeysFn(m.bindKeys)
+	m.GetTable().SetEnterFn(m.showChart)
+	m.GetTable().SetColorerFn(render.MetricsHistory{}.ColorerFunc())
+	m.SetContextFn(m.historyContext)
+
+	return &m
+}
+
+func (m *MetricsHistory) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", m.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", m.GetTable().SortColCmd(4, false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", m.GetTable().SortColCmd(8, false), false),
+	})
+}
+
+func (m *MetricsHistory) historyContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyHistory, m.App().history)
+}
+
+func (m *MetricsHistory) showChart(app *App, _, _, path string) {
+	showChart(app, m.GetTable().GetSelectedCell(1), path)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showChart(app *App, kind, fqn string) {
+	if err := app.inject(NewMetricsChart(app, kind, fqn)); err != nil {
+		app.Flash().Err(err)
+	}
+}
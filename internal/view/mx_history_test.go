@@ -0,0 +1,28 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.RegisterMeta("metricshistory", metav1.APIResource{
+		Name:         "metricshistory",
+		SingularName: "metricshistory",
+		Kind:         "MetricsHistory",
+		Categories:   []string{"k9s"},
+	})
+}
+
+func TestMetricsHistoryNew(t *testing.T) {
+	m := view.NewMetricsHistory(client.NewGVR("metricshistory"))
+
+	assert.Nil(t, m.Init(makeCtx()))
+	assert.Equal(t, "MetricsHistory", m.Name())
+	assert.Equal(t, 6, len(m.Hints()))
+}
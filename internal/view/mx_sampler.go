@@ -0,0 +1,130 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+// MetricsSampler records metrics history while a metrics view is active.
+type MetricsSampler struct {
+	app      *App
+	cancelFn context.CancelFunc
+}
+
+// NewMetricsSampler returns a new sampler.
+func NewMetricsSampler(app *App) *MetricsSampler {
+	return &MetricsSampler{app: app}
+}
+
+// StackPushed indicates a new item was added.
+func (s *MetricsSampler) StackPushed(c model.Component) {
+	s.update(c)
+}
+
+// StackPopped indicates an item was deleted.
+func (s *MetricsSampler) StackPopped(_, c model.Component) {
+	s.update(c)
+}
+
+// StackTop indicates the top of the stack.
+func (s *MetricsSampler) StackTop(c model.Component) {
+	s.update(c)
+}
+
+// Stop terminates sampling.
+func (s *MetricsSampler) Stop() {
+	if s.cancelFn == nil {
+		return
+	}
+	s.cancelFn()
+	s.cancelFn = nil
+}
+
+// IsActive returns true if metrics are being sampled.
+func (s *MetricsSampler) IsActive() bool {
+	return s.cancelFn != nil
+}
+
+func (s *MetricsSampler) update(c model.Component) {
+	s.Stop()
+	_, ok := s.namespace(c)
+	if _, hpa := s.hpaPath(c); !ok && !hpa {
+		return
+	}
+
+	var ctx context.Context
+	ctx, s.cancelFn = context.WithCancel(context.Background())
+	go s.run(ctx, c)
+}
+
+func (s *MetricsSampler) run(ctx context.Context, c model.Component) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug().Msg("Metrics sampler canceled!")
+			return
+		case <-time.After(time.Duration(s.app.Config.K9s.GetRefreshRate()) * time.Second):
+			// Namespace is resolved on each tick as views can switch namespaces in place.
+			if ns, ok := s.namespace(c); ok {
+				s.app.sampleMetrics(ns)
+			}
+			if path, ok := s.hpaPath(c); ok {
+				s.app.sampleHPA(path)
+			}
+		}
+	}
+}
+
+// Namespace returns the namespace to sample for a given component or false if
+// the component does not track metrics.
+func (s *MetricsSampler) namespace(c model.Component) (string, bool) {
+	switch v := c.(type) {
+	case *MetricsChart:
+		switch v.kind {
+		case client.PodSeries:
+			ns, _ := client.Namespaced(v.fqn)
+			return ns, true
+		case client.NodeSeries:
+			return s.activeNamespace(), true
+		}
+	case ResourceViewer:
+		switch v.GVR() {
+		case "v1/pods":
+			return v.GetTable().GetModel().GetNamespace(), true
+		case "containers":
+			ns, _ := client.Namespaced(v.GetTable().Path)
+			return ns, true
+		case "v1/nodes", "metricshistory":
+			return s.activeNamespace(), true
+		}
+	}
+
+	return "", false
+}
+
+// HPAPath returns the HPA to sample replicas for or false if the component
+// does not track a HPA.
+func (s *MetricsSampler) hpaPath(c model.Component) (string, bool) {
+	switch v := c.(type) {
+	case *MetricsChart:
+		return v.fqn, v.kind == client.HPASeries
+	case ResourceViewer:
+		return v.GetTable().Path, v.GVR() == "hpadetails"
+	}
+
+	return "", false
+}
+
+func (s *MetricsSampler) activeNamespace() string {
+	ns := s.app.Config.ActiveNamespace()
+	if ns == render.NamespaceAll {
+		return render.AllNamespaces
+	}
+
+	return ns
+}
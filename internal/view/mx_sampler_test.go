@@ -0,0 +1,106 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsSamplerNamespace(t *testing.T) {
+	a := makeApp()
+	uu := map[string]struct {
+		c  model.Component
+		ns string
+		e  bool
+	}{
+		"podChart": {
+			c:  NewMetricsChart(a, client.PodSeries, "fred/p1"),
+			ns: "fred",
+			e:  true,
+		},
+		"nodeChart": {
+			c:  NewMetricsChart(a, client.NodeSeries, "n1"),
+			ns: "default",
+			e:  true,
+		},
+		"hpaChart": {
+			c: NewMetricsChart(a, client.HPASeries, "fred/h1"),
+		},
+		"history": {
+			c:  NewMetricsHistory(client.NewGVR("metricshistory")),
+			ns: "default",
+			e:  true,
+		},
+		"aliases": {
+			c: NewAlias(client.NewGVR("aliases")),
+		},
+	}
+
+	s := NewMetricsSampler(a)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ns, ok := s.namespace(u.c)
+			assert.Equal(t, u.e, ok)
+			assert.Equal(t, u.ns, ns)
+		})
+	}
+}
+
+func TestMetricsSamplerUpdate(t *testing.T) {
+	a := makeApp()
+	s := NewMetricsSampler(a)
+
+	s.StackPushed(NewMetricsChart(a, client.PodSeries, "fred/p1"))
+	assert.True(t, s.IsActive())
+	s.StackPopped(nil, NewAlias(client.NewGVR("aliases")))
+	assert.False(t, s.IsActive())
+	s.StackTop(NewMetricsChart(a, client.NodeSeries, "n1"))
+	assert.True(t, s.IsActive())
+	s.Stop()
+	assert.False(t, s.IsActive())
+}
+
+func TestMetricsSamplerHPAPath(t *testing.T) {
+	a := makeApp()
+	uu := map[string]struct {
+		c    model.Component
+		path string
+		e    bool
+	}{
+		"hpaChart": {
+			c:    NewMetricsChart(a, client.HPASeries, "fred/h1"),
+			path: "fred/h1",
+			e:    true,
+		},
+		"podChart": {
+			c: NewMetricsChart(a, client.PodSeries, "fred/p1"),
+		},
+		"aliases": {
+			c: NewAlias(client.NewGVR("aliases")),
+		},
+	}
+
+	s := NewMetricsSampler(a)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			path, ok := s.hpaPath(u.c)
+			assert.Equal(t, u.e, ok)
+			if ok {
+				assert.Equal(t, u.path, path)
+			}
+		})
+	}
+}
+
+func TestMetricsSamplerUpdateHPA(t *testing.T) {
+	a := makeApp()
+	s := NewMetricsSampler(a)
+
+	s.StackPushed(NewMetricsChart(a, client.HPASeries, "fred/h1"))
+	assert.True(t, s.IsActive())
+	s.Stop()
+}
@@ -2,9 +2,12 @@ package view
 
 import (
 	"context"
+	"errors"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
@@ -22,7 +25,8 @@ func NewNode(gvr client.GVR) ResourceViewer {
 		ResourceViewer: NewBrowser(gvr),
 	}
 	n.SetBindKeysFn(n.bindKeys)
-	n.GetTable().SetEnterFn(n.showPods)
+	n.GetTable().SetEnterFn(n.showDetails)
+	n.GetTable().SetColorerFn(render.Node{}.ColorerFunc())
 	n.SetContextFn(n.nodeContext)
 
 	return &n
@@ -30,12 +34,17 @@ func NewNode(gvr client.GVR) ResourceViewer {
 
 func (n *Node) bindKeys(aa ui.KeyActions) {
 	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	if !n.App().Config.K9s.NodeShell.Disabled {
+		n.App().addMutations(aa, ui.KeyActions{ui.KeyS: ui.NewKeyAction("Shell", n.shellCmd, true)})
+	}
 	aa.Add(ui.KeyActions{
 		ui.KeyY:      ui.NewKeyAction("YAML", n.viewCmd, true),
+		ui.KeyP:      ui.NewKeyAction("Pods", n.podsCmd, true),
 		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(7, false), false),
 		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(8, false), false),
 		ui.KeyShiftX: ui.NewKeyAction("Sort CPU%", n.GetTable().SortColCmd(9, false), false),
 		ui.KeyShiftZ: ui.NewKeyAction("Sort MEM%", n.GetTable().SortColCmd(10, false), false),
+		ui.KeyShiftH: ui.NewKeyAction("Metrics History", n.historyCmd, true),
 	})
 }
 
@@ -49,8 +58,28 @@ func (n *Node) nodeContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, internal.KeyMetrics, nmx)
 }
 
-func (n *Node) showPods(app *App, ns, res, sel string) {
-	showPods(app, n.GetTable().GetSelectedItem(), "", "spec.nodeName="+sel)
+func (n *Node) showDetails(app *App, _, _, sel string) {
+	showNodeDetail(app, sel)
+}
+
+func (n *Node) podsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := n.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	showPods(n.App(), sel, "", "spec.nodeName="+sel)
+
+	return nil
+}
+
+func (n *Node) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := n.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	showChart(n.App(), client.NodeSeries, sel)
+
+	return nil
 }
 
 func (n *Node) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -80,3 +109,51 @@ func (n *Node) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	return nil
 }
+
+func (n *Node) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := n.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	app, cfg := n.App(), n.App().Config.K9s.NodeShell
+	no, err := nodeFor(app)
+	if err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
+	app.Flash().Infof("Launching shell pod on node %s...", sel)
+	go func() {
+		po, err := no.LaunchShell(sel, cfg)
+		if err != nil {
+			app.QueueUpdateDraw(func() {
+				app.Flash().Errf("Node shell failed %s", err)
+			})
+			return
+		}
+		app.QueueUpdateDraw(func() {
+			nodeShellIn(app, po.Namespace, po.Name)
+			if err := no.DeleteShell(po); err != nil {
+				app.Flash().Errf("Unable to delete shell pod %s/%s: %s", po.Namespace, po.Name, err)
+				return
+			}
+			app.Flash().Infof("Shell pod %s/%s deleted", po.Namespace, po.Name)
+		})
+	}()
+
+	return nil
+}
+
+func nodeShellIn(a *App, ns, po string) {
+	args := make([]string, 0, 20)
+	args = append(args, "exec", "-it", "--context", a.Config.K9s.CurrentContext, "-n", ns, po)
+	if kcfg := a.Conn().Config().Flags().KubeConfig; kcfg != nil && *kcfg != "" {
+		args = append(args, "--kubeconfig", *kcfg)
+	}
+	args = append(args, "--")
+	args = append(args, dao.NodeShellCmd...)
+	log.Debug().Msgf("Node shell args %v", args)
+	if !runK(true, a, args...) {
+		a.Flash().Err(errors.New("Node shell exec failed"))
+	}
+}
@@ -0,0 +1,178 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+)
+
+// NodeDetail presents a node conditions, taints, labels and pod allocations.
+type NodeDetail struct {
+	ResourceViewer
+}
+
+// NewNodeDetail returns a new viewer.
+func NewNodeDetail(gvr client.GVR) ResourceViewer {
+	n := NodeDetail{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.GetTable().SetColorerFn(render.NodeDetail{}.ColorerFunc())
+	n.GetTable().SetSortCol(0, 0, true)
+	n.GetTable().SetEnterFn(n.gotoPod)
+	n.SetBindKeysFn(n.bindKeys)
+
+	return &n
+}
+
+func (n *NodeDetail) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Section", n.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(5, false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(8, false), false),
+	})
+	n.App().addMutations(aa, ui.KeyActions{
+		ui.KeyT:        ui.NewKeyAction("Add Taint", n.taintCmd, true),
+		ui.KeyL:        ui.NewKeyAction("Add Label", n.labelCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Remove", n.removeCmd, true),
+	})
+}
+
+func (n *NodeDetail) gotoPod(app *App, _, _, path string) {
+	section, name := splitNodeDetailID(path)
+	if section != render.NodeSectionPod {
+		return
+	}
+	ns, po := client.Namespaced(name)
+	if err := gotoGVR(app, client.NewGVR("v1/pods"), ns, po); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (n *NodeDetail) taintCmd(evt *tcell.EventKey) *tcell.EventKey {
+	node := n.GetTable().Path
+	ff := []dialog.Field{{Label: "Taint", Value: "key=value:" + string(v1.TaintEffectNoSchedule)}}
+	dialog.ShowPrompt(n.App().Content.Pages, "Taint "+node, ff, func(vals []string) {
+		t, err := dao.ParseTaint(vals[0])
+		if err != nil {
+			n.App().Flash().Err(err)
+			return
+		}
+		acc, err := nodeFor(n.App())
+		if err != nil {
+			n.App().Flash().Err(err)
+			return
+		}
+		if err := acc.Taint(node, t); err != nil {
+			n.App().Flash().Err(err)
+			return
+		}
+		n.App().Flash().Infof("Node %s tainted with %s", node, t.ToString())
+		n.Refresh()
+	})
+
+	return nil
+}
+
+func (n *NodeDetail) labelCmd(evt *tcell.EventKey) *tcell.EventKey {
+	node := n.GetTable().Path
+	ff := []dialog.Field{{Label: "Key"}, {Label: "Value"}}
+	dialog.ShowPrompt(n.App().Content.Pages, "Label "+node, ff, func(vals []string) {
+		acc, err := nodeFor(n.App())
+		if err != nil {
+			n.App().Flash().Err(err)
+			return
+		}
+		if err := acc.Label(node, vals[0], vals[1]); err != nil {
+			n.App().Flash().Err(err)
+			return
+		}
+		n.App().Flash().Infof("Node %s labeled %s=%s", node, vals[0], vals[1])
+		n.Refresh()
+	})
+
+	return nil
+}
+
+func (n *NodeDetail) removeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := n.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	section, name := splitNodeDetailID(sel)
+	if section != render.NodeSectionTaint && section != render.NodeSectionLabel {
+		n.App().Flash().Warn("Only taints and labels can be removed")
+		return nil
+	}
+
+	node := n.GetTable().Path
+	msg := fmt.Sprintf("Remove %s %s from node %s?", strings.ToLower(section), name, node)
+	dialog.ShowConfirm(n.App().Content.Pages, "Confirm Remove", msg, func() {
+		acc, err := nodeFor(n.App())
+		if err != nil {
+			n.App().Flash().Err(err)
+			return
+		}
+		if section == render.NodeSectionTaint {
+			key, effect := name, ""
+			if i := strings.LastIndex(name, ":"); i != -1 {
+				key, effect = name[:i], name[i+1:]
+			}
+			err = acc.Untaint(node, key, v1.TaintEffect(effect))
+		} else {
+			err = acc.Unlabel(node, name)
+		}
+		if err != nil {
+			n.App().Flash().Err(err)
+			return
+		}
+		n.App().Flash().Infof("Removed %s %s from node %s", strings.ToLower(section), name, node)
+		n.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showNodeDetail(app *App, path string) {
+	v := NewNodeDetail(client.NewGVR("nodedetails"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func nodeFor(app *App) (*dao.Node, error) {
+	res, err := dao.AccessorFor(app.factory, client.NewGVR("v1/nodes"))
+	if err != nil {
+		return nil, err
+	}
+	no, ok := res.(*dao.Node)
+	if !ok {
+		return nil, fmt.Errorf("expecting a node accessor but got %T", res)
+	}
+
+	return no, nil
+}
+
+func splitNodeDetailID(id string) (string, string) {
+	tokens := strings.SplitN(id, render.NodeIDSep, 2)
+	if len(tokens) != 2 {
+		return "", id
+	}
+
+	return tokens[0], tokens[1]
+}
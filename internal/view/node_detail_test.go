@@ -0,0 +1,37 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.RegisterMeta("nodedetails", metav1.APIResource{
+		Name:       "nodedetails",
+		Kind:       "NodeDetails",
+		Categories: []string{"k9s"},
+	})
+}
+
+func TestNodeDetailMutations(t *testing.T) {
+	v := view.NewNodeDetail(client.NewGVR("nodedetails"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.True(t, hasHint(v.Hints(), "Add Taint"))
+	assert.True(t, hasHint(v.Hints(), "Add Label"))
+	assert.True(t, hasHint(v.Hints(), "Remove"))
+}
+
+func TestNodeDetailReadOnly(t *testing.T) {
+	v := view.NewNodeDetail(client.NewGVR("nodedetails"))
+
+	assert.Nil(t, v.Init(makeReadOnlyCtx()))
+	assert.False(t, hasHint(v.Hints(), "Add Taint"))
+	assert.False(t, hasHint(v.Hints(), "Add Label"))
+	assert.False(t, hasHint(v.Hints(), "Remove"))
+}
@@ -0,0 +1,33 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.RegisterMeta("v1/nodes", metav1.APIResource{
+		Name:       "nodes",
+		Kind:       "Node",
+		Categories: []string{"k9s"},
+	})
+}
+
+func TestNodeMutations(t *testing.T) {
+	v := view.NewNode(client.NewGVR("v1/nodes"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.True(t, hasHint(v.Hints(), "Shell"))
+}
+
+func TestNodeReadOnly(t *testing.T) {
+	v := view.NewNode(client.NewGVR("v1/nodes"))
+
+	assert.Nil(t, v.Init(makeReadOnlyCtx()))
+	assert.False(t, hasHint(v.Hints(), "Shell"))
+}
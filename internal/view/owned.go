@@ -0,0 +1,149 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// Owned presents all resources owned by a given resource.
+type Owned struct {
+	*Table
+
+	gvr  client.GVR
+	path string
+	deps map[string]dao.Dependent
+}
+
+// NewOwned returns a new owned resources viewer.
+func NewOwned(gvr client.GVR, path string) *Owned {
+	return &Owned{
+		Table: NewTable(client.NewGVR("owned")),
+		gvr:   gvr,
+		path:  path,
+		deps:  make(map[string]dao.Dependent),
+	}
+}
+
+// Init initializes the component.
+func (o *Owned) Init(ctx context.Context) error {
+	if err := o.Table.Init(ctx); err != nil {
+		return err
+	}
+	o.SetColorerFn(render.Owned{}.ColorerFunc())
+	o.bindKeys()
+	o.BaseTitle = o.gvr.ToR() + " " + o.path
+	o.update(nil)
+	o.scan()
+
+	return nil
+}
+
+// Name returns the component name.
+func (o *Owned) Name() string { return "owned" }
+
+func (o *Owned) bindKeys() {
+	o.Actions().Delete(tcell.KeyCtrlS, ui.KeySpace, tcell.KeyCtrlSpace)
+	o.Actions().Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", o.resetCmd, false),
+		tcell.KeyEnter:  ui.NewKeyAction("Goto", o.gotoCmd, true),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Rescan", o.rescanCmd, false),
+		ui.KeyD:         ui.NewKeyAction("Describe", o.describeCmd, true),
+		ui.KeyShiftK:    ui.NewKeyAction("Sort Kind", o.SortColCmd(0, true), false),
+		ui.KeyShiftN:    ui.NewKeyAction("Sort Name", o.SortColCmd(3, true), false),
+	})
+}
+
+func (o *Owned) scan() {
+	o.app.Flash().Infof("Scanning resources owned by %s...", o.path)
+	go func() {
+		owner, err := getUnstructured(o.app, o.gvr, o.path)
+		var deps []dao.Dependent
+		if err == nil {
+			deps, err = dao.Owned(o.app.factory, owner)
+		}
+		o.app.QueueUpdateDraw(func() {
+			if err != nil {
+				o.app.Flash().Err(err)
+				return
+			}
+			o.update(deps)
+			o.app.Flash().Infof("Found %d resource(s) owned by %s", len(deps), o.path)
+		})
+	}()
+}
+
+func (o *Owned) update(deps []dao.Dependent) {
+	var (
+		re   render.Owned
+		data = render.TableData{Header: re.Header(render.AllNamespaces), Namespace: render.AllNamespaces}
+	)
+	o.deps = make(map[string]dao.Dependent, len(deps))
+	for _, d := range deps {
+		var row render.Row
+		if err := re.Render(render.OwnedRes{Object: d.Object, Controller: d.Controller}, render.AllNamespaces, &row); err != nil {
+			log.Error().Err(err).Msg("Owned render failed")
+			continue
+		}
+		o.deps[row.ID] = d
+		data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, row))
+	}
+	o.SetModel(model.NewStaticTable(data))
+	o.Refresh()
+	o.Select(1, 0)
+}
+
+func (o *Owned) selected() (dao.Dependent, bool) {
+	d, ok := o.deps[o.GetSelectedItem()]
+	return d, ok
+}
+
+func (o *Owned) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if o.SearchBuff().IsActive() {
+		o.SearchBuff().SetActive(false)
+		o.Refresh()
+		return nil
+	}
+	d, ok := o.selected()
+	if !ok {
+		return evt
+	}
+	if err := gotoGVR(o.app, d.GVR, d.Object.GetNamespace(), d.Object.GetName()); err != nil {
+		o.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (o *Owned) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	d, ok := o.selected()
+	if !ok {
+		return evt
+	}
+	path := client.FQN(d.Object.GetNamespace(), d.Object.GetName())
+	describeResource(o.app, d.Object.GetNamespace(), d.GVR.String(), path)
+
+	return nil
+}
+
+func (o *Owned) rescanCmd(evt *tcell.EventKey) *tcell.EventKey {
+	o.scan()
+	return nil
+}
+
+func (o *Owned) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !o.SearchBuff().InCmdMode() {
+		o.SearchBuff().Reset()
+		return o.app.PrevCmd(evt)
+	}
+	o.SearchBuff().Reset()
+	o.Refresh()
+
+	return nil
+}
@@ -38,18 +38,26 @@ func NewPod(gvr client.GVR) ResourceViewer {
 }
 
 func (p *Pod) bindKeys(aa ui.KeyActions) {
-	aa.Add(ui.KeyActions{
+	p.App().addMutations(aa, ui.KeyActions{
 		tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
-		ui.KeyS:        ui.NewKeyAction("Shell", p.shellCmd, true),
-		ui.KeyShiftR:   ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(1, true), false),
-		ui.KeyShiftS:   ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(2, true), false),
-		ui.KeyShiftT:   ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd(3, false), false),
-		ui.KeyShiftC:   ui.NewKeyAction("Sort CPU", p.GetTable().SortColCmd(4, false), false),
-		ui.KeyShiftM:   ui.NewKeyAction("Sort MEM", p.GetTable().SortColCmd(5, false), false),
-		ui.KeyShiftX:   ui.NewKeyAction("Sort CPU%", p.GetTable().SortColCmd(6, false), false),
-		ui.KeyShiftZ:   ui.NewKeyAction("Sort MEM%", p.GetTable().SortColCmd(7, false), false),
-		ui.KeyShiftI:   ui.NewKeyAction("Sort IP", p.GetTable().SortColCmd(8, true), false),
-		ui.KeyShiftO:   ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd(9, true), false),
+	})
+	aa.Add(ui.KeyActions{
+		ui.KeyS:      ui.NewKeyAction("Shell", p.shellCmd, true),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd(3, false), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", p.GetTable().SortColCmd(4, false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", p.GetTable().SortColCmd(5, false), false),
+		ui.KeyShiftX: ui.NewKeyAction("Sort CPU%", p.GetTable().SortColCmd(10, false), false),
+		ui.KeyShiftZ: ui.NewKeyAction("Sort MEM%", p.GetTable().SortColCmd(11, false), false),
+		ui.KeyShiftI: ui.NewKeyAction("Sort IP", p.GetTable().SortColCmd(14, true), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd(15, true), false),
+		ui.KeyShiftH: ui.NewKeyAction("Metrics History", p.historyCmd, true),
+		ui.KeyN:      ui.NewKeyAction("Network Reach", p.reachCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Copy From", p.copyCmd(copyFrom), true),
+	})
+	p.App().addMutations(aa, ui.KeyActions{
+		ui.KeyShiftU: ui.NewKeyAction("Copy To", p.copyCmd(copyTo), true),
 	})
 }
 
@@ -74,6 +82,8 @@ func (p *Pod) podContext(ctx context.Context) context.Context {
 	if err != nil {
 		log.Warn().Err(err).Msgf("No pods metrics")
 	}
+	ctx = context.WithValue(ctx, internal.KeyHistory, p.App().history)
+
 	return context.WithValue(ctx, internal.KeyMetrics, nmx)
 }
 
@@ -113,6 +123,26 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := p.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	showChart(p.App(), client.PodSeries, sel)
+
+	return nil
+}
+
+func (p *Pod) reachCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := p.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	showReach(p.App(), sel)
+
+	return nil
+}
+
 func (p *Pod) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sel := p.GetTable().GetSelectedItem()
 	if sel == "" {
@@ -146,6 +176,42 @@ func (p *Pod) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return evt
 }
 
+func (p *Pod) copyCmd(cp func(*App, string, string)) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		sel := p.GetTable().GetSelectedItem()
+		if sel == "" {
+			return evt
+		}
+
+		row := p.GetTable().GetSelectedRowIndex()
+		status := ui.TrimCell(p.GetTable().SelectTable, row, p.GetTable().NameColIndex()+2)
+		if status != render.Running {
+			p.App().Flash().Errf("%s is not in a running state", sel)
+			return nil
+		}
+		cc, err := fetchContainers(p.App().factory, sel, false)
+		if err != nil {
+			p.App().Flash().Errf("Unable to retrieve containers %s", err)
+			return nil
+		}
+		if len(cc) == 1 {
+			cp(p.App(), sel, cc[0])
+			return nil
+		}
+		picker := NewPicker()
+		picker.populate(cc)
+		picker.SetSelectedFunc(func(i int, t, d string, r rune) {
+			p.App().Content.Pop()
+			cp(p.App(), sel, t)
+		})
+		if err := p.App().inject(picker); err != nil {
+			p.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
+
 func (p *Pod) shellIn(path, co string) {
 	p.Stop()
 	shellIn(p.App(), path, co)
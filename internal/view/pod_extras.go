@@ -0,0 +1,38 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// PodExtras represents a pod additional metrics viewer.
+type PodExtras struct {
+	ResourceViewer
+}
+
+// NewPodExtras returns a new viewer.
+func NewPodExtras(gvr client.GVR) ResourceViewer {
+	p := PodExtras{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.SetBindKeysFn(p.bindKeys)
+	p.GetTable().SetEnterFn(p.describePod)
+	p.GetTable().SetColorerFn(render.PodExtras{}.ColorerFunc())
+
+	return &p
+}
+
+func (p *PodExtras) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", p.GetTable().SortColCmd(1, false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", p.GetTable().SortColCmd(2, false), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Restarts", p.GetTable().SortColCmd(3, false), false),
+		ui.KeyShiftX: ui.NewKeyAction("Sort RX", p.GetTable().SortColCmd(4, false), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort TX", p.GetTable().SortColCmd(5, false), false),
+	})
+}
+
+func (p *PodExtras) describePod(app *App, _, _, path string) {
+	describeResource(app, "", "v1/pods", path)
+}
@@ -0,0 +1,29 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.RegisterMeta("podextras", metav1.APIResource{
+		Name:         "podextras",
+		SingularName: "podextras",
+		Namespaced:   true,
+		Kind:         "PodExtras",
+		Categories:   []string{"k9s"},
+	})
+}
+
+func TestPodExtrasNew(t *testing.T) {
+	p := view.NewPodExtras(client.NewGVR("podextras"))
+
+	assert.Nil(t, p.Init(makeCtx()))
+	assert.Equal(t, "PodExtras", p.Name())
+	assert.Equal(t, 8, len(p.Hints()))
+}
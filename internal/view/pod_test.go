@@ -7,6 +7,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/view"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,7 +17,16 @@ func TestPodNew(t *testing.T) {
 
 	assert.Nil(t, po.Init(makeCtx()))
 	assert.Equal(t, "Pods", po.Name())
-	assert.Equal(t, 16, len(po.Hints()))
+	assert.Equal(t, 20, len(po.Hints()))
+}
+
+func TestPodReadOnly(t *testing.T) {
+	po := view.NewPod(client.NewGVR("v1/pods"))
+
+	assert.Nil(t, po.Init(makeReadOnlyCtx()))
+	assert.False(t, hasHint(po.Hints(), "Kill"))
+	assert.False(t, hasHint(po.Hints(), "Copy To"))
+	assert.True(t, hasHint(po.Hints(), "Copy From"))
 }
 
 // Helpers...
@@ -25,3 +35,19 @@ func makeCtx() context.Context {
 	cfg := config.NewConfig(ks{})
 	return context.WithValue(context.Background(), internal.KeyApp, view.NewApp(cfg))
 }
+
+func makeReadOnlyCtx() context.Context {
+	cfg := config.NewConfig(ks{})
+	cfg.K9s.OverrideReadOnly(true)
+	return context.WithValue(context.Background(), internal.KeyApp, view.NewApp(cfg))
+}
+
+func hasHint(hh model.MenuHints, desc string) bool {
+	for _, h := range hh {
+		if h.Description == desc {
+			return true
+		}
+	}
+
+	return false
+}
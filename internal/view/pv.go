@@ -0,0 +1,88 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PersistentVolume represents a PV viewer.
+type PersistentVolume struct {
+	ResourceViewer
+}
+
+// NewPersistentVolume returns a new viewer.
+func NewPersistentVolume(gvr client.GVR) ResourceViewer {
+	p := PersistentVolume{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.SetBindKeysFn(p.bindKeys)
+	p.GetTable().SetEnterFn(p.showClaim)
+	p.GetTable().SetColorerFn(render.PersistentVolume{}.ColorerFunc())
+
+	return &p
+}
+
+func (p *PersistentVolume) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyS:      ui.NewKeyAction("StorageClass", p.classCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(4, true), false),
+	})
+}
+
+func (p *PersistentVolume) showClaim(app *App, _, _, path string) {
+	pv, err := p.volume(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if pv.Spec.ClaimRef == nil {
+		app.Flash().Warnf("Volume %s is not claimed", path)
+		return
+	}
+	ref := pv.Spec.ClaimRef
+	if err := gotoGVR(app, client.NewGVR("v1/persistentvolumeclaims"), ref.Namespace, ref.Name); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (p *PersistentVolume) classCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	pv, err := p.volume(path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	class, ok := pv.Annotations[v1.BetaStorageClassAnnotation]
+	if !ok {
+		class = pv.Spec.StorageClassName
+	}
+	if class == "" {
+		p.App().Flash().Warnf("Volume %s has no storage class", path)
+		return nil
+	}
+	if err := gotoGVR(p.App(), client.NewGVR("storage.k8s.io/v1/storageclasses"), "", class); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *PersistentVolume) volume(path string) (*v1.PersistentVolume, error) {
+	u, err := getUnstructured(p.App(), client.NewGVR(p.GVR()), path)
+	if err != nil {
+		return nil, err
+	}
+	var pv v1.PersistentVolume
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pv); err != nil {
+		return nil, err
+	}
+
+	return &pv, nil
+}
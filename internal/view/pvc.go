@@ -0,0 +1,140 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PersistentVolumeClaim represents a PVC viewer.
+type PersistentVolumeClaim struct {
+	ResourceViewer
+}
+
+// NewPersistentVolumeClaim returns a new viewer.
+func NewPersistentVolumeClaim(gvr client.GVR) ResourceViewer {
+	p := PersistentVolumeClaim{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.SetBindKeysFn(p.bindKeys)
+	p.GetTable().SetEnterFn(p.showPods)
+	p.GetTable().SetColorerFn(render.PersistentVolumeClaim{}.ColorerFunc())
+
+	return &p
+}
+
+func (p *PersistentVolumeClaim) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyV:      ui.NewKeyAction("Volume", p.volumeCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd(7, true), false),
+	})
+	p.App().addMutations(aa, ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Resize", p.resizeCmd, true),
+	})
+}
+
+func (p *PersistentVolumeClaim) showPods(app *App, _, _, path string) {
+	ns, _ := client.Namespaced(path)
+	cc, err := dao.ClaimConsumers(app.factory, ns)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	pp := cc[path]
+	if len(pp) == 0 {
+		app.Flash().Infof("No active pods mount claim %s", path)
+		return
+	}
+	nn := make([]string, 0, len(pp))
+	for _, po := range pp {
+		nn = append(nn, po.Name)
+	}
+	if err := gotoGVR(app, client.NewGVR("v1/pods"), ns, nn...); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (p *PersistentVolumeClaim) volumeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	pvc, err := p.claim(path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	if pvc.Spec.VolumeName == "" {
+		p.App().Flash().Warnf("Claim %s is not bound", path)
+		return nil
+	}
+	if err := gotoGVR(p.App(), client.NewGVR("v1/persistentvolumes"), "", pvc.Spec.VolumeName); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *PersistentVolumeClaim) resizeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	res, err := dao.AccessorFor(p.App().factory, client.NewGVR(p.GVR()))
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	acc, ok := res.(*dao.PersistentVolumeClaim)
+	if !ok {
+		p.App().Flash().Errf("expecting a claim accessor but got %T", res)
+		return nil
+	}
+	ok, err = acc.CanExpand(path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	if !ok {
+		p.App().Flash().Warnf("Storage class of %s does not allow volume expansion", path)
+		return nil
+	}
+	pvc, err := p.claim(path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+
+	curr := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+	ff := []dialog.Field{{Label: "Size", Value: curr.String()}}
+	dialog.ShowPrompt(p.App().Content.Pages, "Resize "+path, ff, func(vals []string) {
+		if err := acc.Resize(path, vals[0]); err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		p.App().Flash().Infof("Claim %s resize to %s requested", path, vals[0])
+	})
+
+	return nil
+}
+
+func (p *PersistentVolumeClaim) claim(path string) (*v1.PersistentVolumeClaim, error) {
+	u, err := getUnstructured(p.App(), client.NewGVR(p.GVR()), path)
+	if err != nil {
+		return nil, err
+	}
+	var pvc v1.PersistentVolumeClaim
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pvc); err != nil {
+		return nil, fmt.Errorf("claim %s: %v", path, err)
+	}
+
+	return &pvc, nil
+}
@@ -0,0 +1,33 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.RegisterMeta("v1/persistentvolumeclaims", metav1.APIResource{
+		Name:       "persistentvolumeclaims",
+		Kind:       "PersistentVolumeClaim",
+		Categories: []string{"k9s"},
+	})
+}
+
+func TestPersistentVolumeClaimMutations(t *testing.T) {
+	v := view.NewPersistentVolumeClaim(client.NewGVR("v1/persistentvolumeclaims"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.True(t, hasHint(v.Hints(), "Resize"))
+}
+
+func TestPersistentVolumeClaimReadOnly(t *testing.T) {
+	v := view.NewPersistentVolumeClaim(client.NewGVR("v1/persistentvolumeclaims"))
+
+	assert.Nil(t, v.Init(makeReadOnlyCtx()))
+	assert.False(t, hasHint(v.Hints(), "Resize"))
+}
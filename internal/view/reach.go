@@ -0,0 +1,62 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Reach presents the pods a given pod can reach or be reached from.
+type Reach struct {
+	ResourceViewer
+}
+
+// NewReach returns a new viewer.
+func NewReach(gvr client.GVR) ResourceViewer {
+	r := Reach{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.GetTable().SetColorerFn(render.Reach{}.ColorerFunc())
+	r.GetTable().SetSortCol(0, 0, false)
+	r.GetTable().SetEnterFn(r.gotoPeer)
+	r.SetBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+func (r *Reach) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftD: ui.NewKeyAction("Sort Direction", r.GetTable().SortColCmd(0, false), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Verdict", r.GetTable().SortColCmd(4, true), false),
+	})
+}
+
+func (r *Reach) gotoPeer(app *App, _, _, path string) {
+	tokens := strings.SplitN(path, ":", 2)
+	if len(tokens) != 2 {
+		return
+	}
+	ns, n := client.Namespaced(tokens[1])
+	if err := gotoGVR(app, client.NewGVR("v1/pods"), ns, n); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showReach(app *App, path string) {
+	v := NewReach(client.NewGVR("reach"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -13,6 +13,7 @@ func loadCustomViewers() MetaViewers {
 	appsRes(m)
 	rbacRes(m)
 	batchRes(m)
+	autoscalingRes(m)
 	extRes(m)
 
 	return m
@@ -37,6 +38,18 @@ func coreRes(vv MetaViewers) {
 	vv[client.NewGVR("v1/secrets")] = MetaViewer{
 		viewerFn: NewSecret,
 	}
+	vv[client.NewGVR("v1/configmaps")] = MetaViewer{
+		viewerFn: NewConfigMap,
+	}
+	vv[client.NewGVR("v1/persistentvolumeclaims")] = MetaViewer{
+		viewerFn: NewPersistentVolumeClaim,
+	}
+	vv[client.NewGVR("v1/persistentvolumes")] = MetaViewer{
+		viewerFn: NewPersistentVolume,
+	}
+	vv[client.NewGVR("storage.k8s.io/v1/storageclasses")] = MetaViewer{
+		viewerFn: NewStorageClass,
+	}
 }
 
 func miscRes(vv MetaViewers) {
@@ -46,18 +59,57 @@ func miscRes(vv MetaViewers) {
 	vv[client.NewGVR("containers")] = MetaViewer{
 		viewerFn: NewContainer,
 	}
+	vv[client.NewGVR("configmapkeys")] = MetaViewer{
+		viewerFn: NewConfigMapKey,
+	}
 	vv[client.NewGVR("portforwards")] = MetaViewer{
 		viewerFn: NewPortForward,
 	}
 	vv[client.NewGVR("screendumps")] = MetaViewer{
 		viewerFn: NewScreenDump,
 	}
+	vv[client.NewGVR("templates")] = MetaViewer{
+		viewerFn: NewTemplate,
+	}
+	vv[client.NewGVR("helm")] = MetaViewer{
+		viewerFn: NewHelm,
+	}
+	vv[client.NewGVR("helmhistory")] = MetaViewer{
+		viewerFn: NewHelmHistory,
+	}
+	vv[client.NewGVR("timeline")] = MetaViewer{
+		viewerFn: NewTimeline,
+	}
+	vv[client.NewGVR("lint")] = MetaViewer{
+		viewerFn: NewLint,
+	}
+	vv[client.NewGVR("reach")] = MetaViewer{
+		viewerFn: NewReach,
+	}
+	vv[client.NewGVR("images")] = MetaViewer{
+		viewerFn: NewImage,
+	}
+	vv[client.NewGVR("nodedetails")] = MetaViewer{
+		viewerFn: NewNodeDetail,
+	}
+	vv[client.NewGVR("hpadetails")] = MetaViewer{
+		viewerFn: NewHPADetail,
+	}
 	vv[client.NewGVR("benchmarks")] = MetaViewer{
 		viewerFn: NewBenchmark,
 	}
 	vv[client.NewGVR("aliases")] = MetaViewer{
 		viewerFn: NewAlias,
 	}
+	vv[client.NewGVR("metricshistory")] = MetaViewer{
+		viewerFn: NewMetricsHistory,
+	}
+	vv[client.NewGVR("podextras")] = MetaViewer{
+		viewerFn: NewPodExtras,
+	}
+	vv[client.NewGVR("informers")] = MetaViewer{
+		viewerFn: NewInformer,
+	}
 }
 
 func appsRes(vv MetaViewers) {
@@ -111,6 +163,18 @@ func batchRes(vv MetaViewers) {
 	}
 }
 
+func autoscalingRes(vv MetaViewers) {
+	vv[client.NewGVR("autoscaling/v1/horizontalpodautoscalers")] = MetaViewer{
+		enterFn: showHPADetail,
+	}
+	vv[client.NewGVR("autoscaling/v2beta1/horizontalpodautoscalers")] = MetaViewer{
+		enterFn: showHPADetail,
+	}
+	vv[client.NewGVR("autoscaling/v2beta2/horizontalpodautoscalers")] = MetaViewer{
+		enterFn: showHPADetail,
+	}
+}
+
 func extRes(vv MetaViewers) {
 	vv[client.NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions")] = MetaViewer{
 		enterFn: showCRD,
@@ -1,6 +1,7 @@
 package view
 
 import (
+	"context"
 	"errors"
 
 	"github.com/derailed/k9s/internal/client"
@@ -17,15 +18,22 @@ type RestartExtender struct {
 
 // NewRestartExtender returns a new extender.
 func NewRestartExtender(v ResourceViewer) ResourceViewer {
-	r := RestartExtender{ResourceViewer: v}
-	r.bindKeys(v.Actions())
+	return &RestartExtender{ResourceViewer: v}
+}
+
+// Init initializes the view.
+func (r *RestartExtender) Init(ctx context.Context) error {
+	if err := r.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	r.bindKeys(r.Actions())
 
-	return &r
+	return nil
 }
 
 // BindKeys creates additional menu actions.
 func (r *RestartExtender) bindKeys(aa ui.KeyActions) {
-	aa.Add(ui.KeyActions{
+	r.App().addMutations(aa, ui.KeyActions{
 		tcell.KeyCtrlT: ui.NewKeyAction("Restart", r.restartCmd, true),
 	})
 }
@@ -0,0 +1,33 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.RegisterMeta("apps/v1/replicasets", metav1.APIResource{
+		Name:       "replicasets",
+		Kind:       "ReplicaSet",
+		Categories: []string{"k9s"},
+	})
+}
+
+func TestReplicaSetMutations(t *testing.T) {
+	v := view.NewReplicaSet(client.NewGVR("apps/v1/replicasets"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.True(t, hasHint(v.Hints(), "Rollback"))
+}
+
+func TestReplicaSetReadOnly(t *testing.T) {
+	v := view.NewReplicaSet(client.NewGVR("apps/v1/replicasets"))
+
+	assert.Nil(t, v.Init(makeReadOnlyCtx()))
+	assert.False(t, hasHint(v.Hints(), "Rollback"))
+}
@@ -0,0 +1,60 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// StorageClass represents a storage class viewer.
+type StorageClass struct {
+	ResourceViewer
+}
+
+// NewStorageClass returns a new viewer.
+func NewStorageClass(gvr client.GVR) ResourceViewer {
+	s := StorageClass{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.GetTable().SetEnterFn(s.showVolumes)
+	s.GetTable().SetColorerFn(render.StorageClass{}.ColorerFunc())
+
+	return &s
+}
+
+func (s *StorageClass) showVolumes(app *App, _, _, path string) {
+	_, class := client.Namespaced(path)
+	oo, err := app.factory.List("v1/persistentvolumes", "-", true, labels.Everything())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	var nn []string
+	for _, o := range oo {
+		u, ok := o.(runtime.Unstructured)
+		if !ok {
+			continue
+		}
+		var pv v1.PersistentVolume
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &pv); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		c, ok := pv.Annotations[v1.BetaStorageClassAnnotation]
+		if !ok {
+			c = pv.Spec.StorageClassName
+		}
+		if c == class {
+			nn = append(nn, pv.Name)
+		}
+	}
+	if len(nn) == 0 {
+		app.Flash().Infof("No volumes provisioned by storage class %s", class)
+		return
+	}
+	if err := gotoGVR(app, client.NewGVR("v1/persistentvolumes"), "", nn...); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -1,6 +1,7 @@
 package view
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -20,14 +21,21 @@ type ScaleExtender struct {
 
 // NewScaleExtender returns a new extender.
 func NewScaleExtender(r ResourceViewer) ResourceViewer {
-	s := ScaleExtender{ResourceViewer: r}
+	return &ScaleExtender{ResourceViewer: r}
+}
+
+// Init initializes the view.
+func (s *ScaleExtender) Init(ctx context.Context) error {
+	if err := s.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
 	s.bindKeys(s.Actions())
 
-	return &s
+	return nil
 }
 
 func (s *ScaleExtender) bindKeys(aa ui.KeyActions) {
-	aa.Add(ui.KeyActions{
+	s.App().addMutations(aa, ui.KeyActions{
 		ui.KeyS: ui.NewKeyAction("Scale", s.scaleCmd, true),
 	})
 }
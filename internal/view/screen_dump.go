@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
@@ -29,11 +30,18 @@ func NewScreenDump(gvr client.GVR) ResourceViewer {
 	s.GetTable().SetSortCol(s.GetTable().NameColIndex(), 0, true)
 	s.GetTable().SelectRow(1, true)
 	s.GetTable().SetEnterFn(s.edit)
+	s.SetBindKeysFn(s.bindKeys)
 	s.SetContextFn(s.dirContext)
 
 	return &s
 }
 
+func (s *ScreenDump) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyV: ui.NewKeyAction("View", s.viewCmd, true),
+	})
+}
+
 func (s *ScreenDump) dirContext(ctx context.Context) context.Context {
 	dir := filepath.Join(config.K9sDumpDir, s.App().Config.K9s.CurrentCluster)
 	return context.WithValue(ctx, internal.KeyDir, dir)
@@ -48,3 +56,21 @@ func (s *ScreenDump) edit(app *App, ns, resource, path string) {
 		app.Flash().Err(errors.New("Failed to launch editor"))
 	}
 }
+
+func (s *ScreenDump) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	d, err := render.LoadDump(path)
+	if err != nil {
+		s.App().Flash().Errf("Unable to load screen dump %s", err)
+		return nil
+	}
+	if err := s.App().inject(NewDump(path, d)); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	snapshotTitle           = "snapshot"
+	defaultSnapshotInterval = time.Minute
+	minSnapshotInterval     = 5 * time.Second
+)
+
+// Snapshotter periodically captures resource views into screen dumps.
+type Snapshotter struct {
+	app      *App
+	gvrs     []client.GVR
+	interval time.Duration
+	cancelFn context.CancelFunc
+	count    int
+	mx       sync.RWMutex
+}
+
+// NewSnapshotter returns a new snapshotter.
+func NewSnapshotter(app *App) *Snapshotter {
+	return &Snapshotter{app: app}
+}
+
+// IsActive returns true if snapshots are being captured.
+func (s *Snapshotter) IsActive() bool {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	return s.cancelFn != nil
+}
+
+// Status returns a human readable snapshot status.
+func (s *Snapshotter) Status() string {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	if s.cancelFn == nil {
+		return "No snapshots in progress"
+	}
+	gg := make([]string, 0, len(s.gvrs))
+	for _, gvr := range s.gvrs {
+		gg = append(gg, gvr.ToR())
+	}
+
+	return fmt.Sprintf("Snapshotting %s every %v (%d captured)", strings.Join(gg, ","), s.interval, s.count)
+}
+
+// Start captures the given resources at the given interval.
+func (s *Snapshotter) Start(gvrs []client.GVR, interval time.Duration) {
+	s.Stop()
+
+	if interval < minSnapshotInterval {
+		interval = minSnapshotInterval
+	}
+	s.mx.Lock()
+	var ctx context.Context
+	ctx, s.cancelFn = context.WithCancel(context.Background())
+	s.gvrs, s.interval, s.count = gvrs, interval, 0
+	s.mx.Unlock()
+
+	go s.run(ctx)
+}
+
+// Stop terminates the snapshots.
+func (s *Snapshotter) Stop() {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if s.cancelFn == nil {
+		return
+	}
+	s.cancelFn()
+	s.cancelFn = nil
+}
+
+// Capture takes a snapshot of the given resources now.
+func (s *Snapshotter) Capture(gvrs ...client.GVR) ([]string, error) {
+	ff := make([]string, 0, len(gvrs))
+	for _, gvr := range gvrs {
+		path, err := s.capture(gvr)
+		if err != nil {
+			return ff, err
+		}
+		ff = append(ff, path)
+	}
+
+	return ff, nil
+}
+
+func (s *Snapshotter) run(ctx context.Context) {
+	defer log.Debug().Msgf("Snapshotter canceled")
+	for {
+		s.snap()
+		s.mx.RLock()
+		interval := s.interval
+		s.mx.RUnlock()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (s *Snapshotter) snap() {
+	s.mx.RLock()
+	gvrs := s.gvrs
+	s.mx.RUnlock()
+
+	if _, err := s.Capture(gvrs...); err != nil {
+		log.Error().Err(err).Msgf("Snapshot failed")
+		return
+	}
+	s.mx.Lock()
+	s.count++
+	s.mx.Unlock()
+}
+
+func (s *Snapshotter) capture(gvr client.GVR) (string, error) {
+	meta, err := dao.MetaFor(gvr)
+	if err != nil {
+		return "", err
+	}
+
+	ns := s.app.Config.ActiveNamespace()
+	if ns == render.NamespaceAll {
+		ns = render.AllNamespaces
+	}
+	if !meta.Namespaced {
+		ns = render.ClusterScope
+	}
+
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, s.app.factory)
+	ctx = context.WithValue(ctx, internal.KeyGVR, gvr.String())
+	ctx = context.WithValue(ctx, internal.KeyNamespace, ns)
+	ctx = context.WithValue(ctx, internal.KeyLabels, "")
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = s.metricsContext(ctx, gvr, ns)
+
+	t := model.NewTable(gvr.String())
+	t.SetNamespace(ns)
+	var failed error
+	t.AddListener(snapshotListener{onError: func(err error) { failed = err }})
+	t.Refresh(ctx)
+	if failed != nil {
+		return "", failed
+	}
+
+	format := s.app.Config.K9s.ScreenDumpFormat
+	if !render.IsDumpFormat(format) {
+		format = render.CSVDump
+	}
+
+	return saveTable(s.app.Config.K9s.CurrentCluster, snapshotTitle+"-"+meta.Name, "", format, newDump(s.app.factory, gvr, t.Peek(), format))
+}
+
+func (s *Snapshotter) metricsContext(ctx context.Context, gvr client.GVR, ns string) context.Context {
+	if !s.app.factory.Client().HasMetrics() {
+		return ctx
+	}
+
+	mx := client.NewMetricsServer(s.app.factory.Client())
+	switch gvr.String() {
+	case "v1/pods":
+		pmx, err := mx.FetchPodsMetrics(ns)
+		if err != nil {
+			log.Warn().Err(err).Msgf("No pods metrics")
+			return ctx
+		}
+		return context.WithValue(ctx, internal.KeyMetrics, pmx)
+	case "v1/nodes":
+		nmx, err := mx.FetchNodesMetrics()
+		if err != nil {
+			log.Warn().Err(err).Msgf("No node metrics")
+			return ctx
+		}
+		return context.WithValue(ctx, internal.KeyMetrics, nmx)
+	default:
+		return ctx
+	}
+}
+
+type snapshotListener struct {
+	onError func(error)
+}
+
+func (snapshotListener) TableDataChanged(render.TableData) {}
+
+func (l snapshotListener) TableLoadFailed(err error) {
+	l.onError(err)
+}
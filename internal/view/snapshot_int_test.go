@@ -0,0 +1,21 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotterRestart(t *testing.T) {
+	s := NewSnapshotter(makeApp())
+
+	s.Start(nil, time.Second)
+	assert.True(t, s.IsActive())
+	s.Start(nil, time.Minute)
+	assert.True(t, s.IsActive())
+	assert.Contains(t, s.Status(), "every 1m0s")
+
+	s.Stop()
+	assert.False(t, s.IsActive())
+}
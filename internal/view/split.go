@@ -0,0 +1,106 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+// Pane represents a split view pane.
+type Pane interface {
+	model.Component
+
+	// Actions returns the pane key actions.
+	Actions() ui.KeyActions
+}
+
+// Split presents viewers side by side.
+type Split struct {
+	*tview.Flex
+
+	app   *App
+	panes []Pane
+	focus int
+}
+
+// NewSplit returns a new split viewer.
+func NewSplit(panes ...Pane) *Split {
+	return &Split{
+		Flex:  tview.NewFlex().SetDirection(tview.FlexColumn),
+		panes: panes,
+	}
+}
+
+// Init initializes the component.
+func (s *Split) Init(ctx context.Context) error {
+	var err error
+	if s.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+	for i, p := range s.panes {
+		if err := p.Init(ctx); err != nil {
+			return err
+		}
+		p.Actions().Add(ui.KeyActions{
+			tcell.KeyTab: ui.NewKeyAction("Switch Pane", s.switchCmd, true),
+		})
+		s.AddItem(p, 0, 1, i == 0)
+	}
+
+	return nil
+}
+
+// Name returns the component name.
+func (s *Split) Name() string {
+	nn := make([]string, 0, len(s.panes))
+	for _, p := range s.panes {
+		nn = append(nn, p.Name())
+	}
+
+	return strings.Join(nn, "|")
+}
+
+// Start runs the component.
+func (s *Split) Start() {
+	for _, p := range s.panes {
+		p.Start()
+	}
+}
+
+// Stop terminates the component.
+func (s *Split) Stop() {
+	for _, p := range s.panes {
+		p.Stop()
+	}
+}
+
+// Hints returns the focused pane menu hints.
+func (s *Split) Hints() model.MenuHints {
+	if len(s.panes) == 0 {
+		return nil
+	}
+
+	return s.panes[s.focus].Hints()
+}
+
+// Focus delegates focus to the active pane.
+func (s *Split) Focus(delegate func(p tview.Primitive)) {
+	if len(s.panes) == 0 {
+		return
+	}
+	delegate(s.panes[s.focus])
+}
+
+func (s *Split) switchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if len(s.panes) < 2 {
+		return evt
+	}
+	s.focus = (s.focus + 1) % len(s.panes)
+	s.app.SetFocus(s.panes[s.focus])
+
+	return nil
+}
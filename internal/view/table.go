@@ -2,14 +2,19 @@ package view
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
@@ -104,7 +109,11 @@ func (t *Table) BufferActive(state bool, k ui.BufferKind) {
 }
 
 func (t *Table) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if path, err := saveTable(t.app.Config.K9s.CurrentCluster, t.BaseTitle, t.Path, t.GetFilteredData()); err != nil {
+	format := t.app.Config.K9s.ScreenDumpFormat
+	if !render.IsDumpFormat(format) {
+		format = render.CSVDump
+	}
+	if path, err := saveTable(t.app.Config.K9s.CurrentCluster, t.BaseTitle, t.Path, format, t.dump(format)); err != nil {
 		t.app.Flash().Err(err)
 	} else {
 		t.app.Flash().Infof("File %s saved successfully!", path)
@@ -113,6 +122,11 @@ func (t *Table) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// Dump returns a screen dump of the table.
+func (t *Table) dump(format string) *render.Dump {
+	return newDump(t.app.factory, t.gvr, t.GetFilteredData(), format)
+}
+
 func (t *Table) bindKeys() {
 	t.Actions().Add(ui.KeyActions{
 		ui.KeySpace:         ui.NewSharedKeyAction("Mark", t.markCmd, false),
@@ -154,6 +168,39 @@ func (t *Table) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (t *Table) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := t.GetSelectedItems()
+	if len(sels) != 2 {
+		t.app.Flash().Warn("Mark exactly two resources to diff")
+		return nil
+	}
+	sort.Strings(sels)
+
+	ss := make([]dao.DiffSide, 0, len(sels))
+	for _, path := range sels {
+		o, err := t.app.factory.Get(t.GVR(), path, true, labels.Everything())
+		if err != nil {
+			t.app.Flash().Errf("Unable to get resource %q -- %s", path, err)
+			return nil
+		}
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			t.app.Flash().Errf("Expecting unstructured but got %T", o)
+			return nil
+		}
+		ss = append(ss, dao.DiffSide{Name: path, Object: u.Object})
+	}
+
+	diff := NewDiff(t.app, strings.Join(sels, " <> "), func(full bool) (string, error) {
+		return dao.Diff(ss[0], ss[1], full)
+	})
+	if err := t.app.inject(diff); err != nil {
+		t.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (t *Table) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := t.GetSelectedItem()
 	if path == "" {
@@ -219,3 +266,28 @@ func (t *Table) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	return nil
 }
+
+// NewDump returns a screen dump of some table data. JSON and YAML dumps also
+// carry the full resources when available.
+func newDump(f dao.Factory, gvr client.GVR, data render.TableData, format string) *render.Dump {
+	d := render.NewDump(gvr.String(), data)
+	if format != render.JSONDump && format != render.YAMLDump {
+		return d
+	}
+	meta, err := dao.MetaFor(gvr)
+	if err != nil || dao.IsK9sMeta(meta) {
+		return d
+	}
+	for _, re := range data.RowEvents {
+		o, err := f.Get(gvr.String(), re.Row.ID, false, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Screen dump skipping %s", re.Row.ID)
+			continue
+		}
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			d.Objects = append(d.Objects, u.Object)
+		}
+	}
+
+	return d
+}
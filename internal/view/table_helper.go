@@ -1,7 +1,6 @@
 package view
 
 import (
-	"encoding/csv"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -18,7 +17,7 @@ func trimCellRelative(t *Table, row, col int) string {
 	return ui.TrimCell(t.SelectTable, row, t.NameColIndex()+col)
 }
 
-func computeFilename(cluster, ns, title, path string) (string, error) {
+func computeFilename(cluster, ns, title, path, ext string) (string, error) {
 	now := time.Now().UnixNano()
 
 	dir := filepath.Join(config.K9sDumpDir, cluster)
@@ -33,21 +32,21 @@ func computeFilename(cluster, ns, title, path string) (string, error) {
 
 	var fName string
 	if ns == render.ClusterScope {
-		fName = fmt.Sprintf(ui.NoNSFmat, name, now)
+		fName = fmt.Sprintf(ui.NoNSFmat, name, now, ext)
 	} else {
-		fName = fmt.Sprintf(ui.FullFmat, name, ns, now)
+		fName = fmt.Sprintf(ui.FullFmat, name, ns, now, ext)
 	}
 
 	return strings.ToLower(filepath.Join(dir, fName)), nil
 }
 
-func saveTable(cluster, title, path string, data render.TableData) (string, error) {
-	ns := data.Namespace
+func saveTable(cluster, title, path, format string, d *render.Dump) (string, error) {
+	ns := d.Namespace
 	if ns == render.ClusterScope {
 		ns = render.NamespaceAll
 	}
 
-	fPath, err := computeFilename(cluster, ns, title, path)
+	fPath, err := computeFilename(cluster, ns, title, path, format)
 	if err != nil {
 		return "", err
 	}
@@ -64,18 +63,7 @@ func saveTable(cluster, title, path string, data render.TableData) (string, erro
 		}
 	}()
 
-	w := csv.NewWriter(out)
-	if err := w.Write(data.Header.Columns()); err != nil {
-		return "", err
-	}
-
-	for _, re := range data.RowEvents {
-		if err := w.Write(re.Row.Fields); err != nil {
-			return "", err
-		}
-	}
-	w.Flush()
-	if err := w.Error(); err != nil {
+	if err := d.Write(out, format); err != nil {
 		return "", err
 	}
 
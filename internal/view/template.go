@@ -0,0 +1,178 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// Template presents a resource creation templates viewer.
+type Template struct {
+	ResourceViewer
+}
+
+// NewTemplate returns a new viewer.
+func NewTemplate(gvr client.GVR) ResourceViewer {
+	t := Template{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	t.GetTable().SetColorerFn(render.Template{}.ColorerFunc())
+	t.GetTable().SetSortCol(t.GetTable().NameColIndex(), 0, true)
+	t.GetTable().SelectRow(1, true)
+	t.GetTable().SetEnterFn(t.create)
+	t.SetBindKeysFn(t.bindKeys)
+	t.SetContextFn(t.dirContext)
+
+	return &t
+}
+
+func (t *Template) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyE: ui.NewKeyAction("Edit", t.editCmd, true),
+	})
+	// Enter creates resources from the selected template. It still applies
+	// filters in read-only mode.
+	if enter, ok := aa[tcell.KeyEnter]; ok && t.App().IsReadOnly() {
+		enter.Visible = false
+		aa[tcell.KeyEnter] = enter
+	}
+}
+
+func (t *Template) dirContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyDir, config.K9sTemplatesDir)
+}
+
+func (t *Template) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := t.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	t.Stop()
+	defer t.Start()
+	if !edit(true, t.App(), path) {
+		t.App().Flash().Err(errors.New("Failed to launch editor"))
+	}
+
+	return nil
+}
+
+func (t *Template) create(app *App, _, _, path string) {
+	if !app.canMutate() {
+		return
+	}
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	vars, err := dao.TemplateVars(raw)
+	if err != nil {
+		app.Flash().Errf("Invalid template %s", err)
+		return
+	}
+
+	ff := make([]dialog.Field, 0, len(vars))
+	for _, v := range vars {
+		ff = append(ff, dialog.Field{Label: v, Value: templateDefault(app, v)})
+	}
+	title := "Create " + strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	dialog.ShowPrompt(app.Content.Pages, title, ff, func(vals []string) {
+		vv := make(map[string]string, len(vars))
+		for i, v := range vars {
+			vv[v] = vals[i]
+		}
+		res, err := dao.RenderTemplate(raw, vv)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		t.Stop()
+		defer t.Start()
+		editAndCreate(app, res)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func templateDefault(app *App, v string) string {
+	if v != "Namespace" {
+		return ""
+	}
+	ns := app.Config.ActiveNamespace()
+	if ns == render.AllNamespaces || ns == render.NamespaceAll {
+		return "default"
+	}
+
+	return ns
+}
+
+// EditAndCreate opens a manifest in the editor and creates its resources
+// once the editor exits.
+func editAndCreate(app *App, raw []byte) {
+	if !app.canMutate() {
+		return
+	}
+	f, err := ioutil.TempFile("", "k9s-create-*.yaml")
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	defer func() {
+		if err := os.Remove(f.Name()); err != nil {
+			log.Error().Err(err).Msgf("Unable to remove %s", f.Name())
+		}
+	}()
+	_, err = f.Write(raw)
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	if !edit(true, app, f.Name()) {
+		app.Flash().Err(errors.New("Failed to launch editor"))
+		return
+	}
+	if bb, err := ioutil.ReadFile(f.Name()); err == nil && bytes.Equal(bb, raw) {
+		app.Flash().Info("Creation canceled, manifest unchanged")
+		return
+	}
+	mm, err := dao.LoadManifests(f.Name())
+	if err != nil {
+		app.Flash().Errf("Creation canceled %s", err)
+		return
+	}
+	a, err := dao.NewApplier(app.Conn())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	ids := make([]string, 0, len(mm))
+	for _, m := range mm {
+		if _, err := a.Create(m); err != nil {
+			app.Flash().Errf("Create %s failed %s", m.ID(), err)
+			return
+		}
+		ids = append(ids, m.ID())
+	}
+	app.Flash().Info(fmt.Sprintf("Created %s", strings.Join(ids, ", ")))
+}
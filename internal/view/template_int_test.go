@@ -0,0 +1,42 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTemplateReadOnly(t *testing.T) {
+	dao.RegisterMeta("templates", metav1.APIResource{
+		Name:       "templates",
+		Kind:       "Templates",
+		Categories: []string{"k9s"},
+	})
+	uu := map[string]struct {
+		ro, e bool
+	}{
+		"writable": {e: true},
+		"readOnly": {ro: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx := makeContext()
+			app := ctx.Value(internal.KeyApp).(*App)
+			app.Config.K9s.OverrideReadOnly(u.ro)
+			v := NewTemplate(client.NewGVR("templates")).(*Template)
+			assert.Nil(t, v.Init(ctx))
+
+			aa := ui.KeyActions{tcell.KeyEnter: ui.NewKeyAction("View", nil, true)}
+			v.bindKeys(aa)
+			assert.Equal(t, u.e, aa[tcell.KeyEnter].Visible)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Timeline presents the events involving a resource and its dependents.
+type Timeline struct {
+	ResourceViewer
+}
+
+// NewTimeline returns a new viewer.
+func NewTimeline(gvr client.GVR) ResourceViewer {
+	t := Timeline{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	t.GetTable().SetColorerFn(render.Timeline{}.ColorerFunc())
+	t.GetTable().SetSortCol(0, 0, true)
+	t.GetTable().SetEnterFn(t.describeEvent)
+	t.SetBindKeysFn(t.bindKeys)
+
+	return &t
+}
+
+func (t *Timeline) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT: ui.NewKeyAction("Sort Type", t.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Reason", t.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Object", t.GetTable().SortColCmd(3, true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Count", t.GetTable().SortColCmd(4, false), false),
+	})
+}
+
+func (t *Timeline) describeEvent(app *App, ns, _, path string) {
+	describeResource(app, ns, "v1/events", path)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showTimeline(app *App, gvr client.GVR, path string) {
+	v := NewTimeline(client.NewGVR("timeline"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyTargetGVR, gvr.String())
+	})
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -2,71 +2,163 @@ package watch
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
-	di "k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
 	defaultResync = 10 * time.Minute
 	allNamespaces = ""
 	clusterScope  = "-"
+
+	// WatchMode indicates a resource is tracked by an informer.
+	WatchMode = "watch"
+	// ListMode indicates a resource is listed on demand.
+	ListMode = "list"
+
+	defaultIdleTimeout = 5 * time.Minute
+	defaultMaxGVRs     = 50
+	defaultPageSize    = 500
+	maxReapInterval    = 30 * time.Second
 )
 
 // ReadVerbs lists out RO verbs.
 var ReadVerbs = []string{"get", "list", "watch"}
 
+type (
+	// Options tracks informers lifecycle options.
+	Options struct {
+		// IdleTimeout represents how long an unused informer lingers.
+		IdleTimeout time.Duration
+		// MaxGVRs caps the number of resources watched concurrently.
+		MaxGVRs int
+		// ListPageSize represents the page size used when listing resources.
+		ListPageSize int64
+		// ListThreshold lists instead of watching larger collections. 0 disables.
+		ListThreshold int64
+	}
+
+	// InformerStats tracks informer diagnostics.
+	InformerStats struct {
+		Namespace string
+		GVR       string
+		Mode      string
+		Refs      int
+		Items     int
+		Synced    bool
+		Age       time.Duration
+		Idle      time.Duration
+	}
+)
+
+// DefaultOptions returns the stock informers options.
+func DefaultOptions() Options {
+	return Options{
+		IdleTimeout:  defaultIdleTimeout,
+		MaxGVRs:      defaultMaxGVRs,
+		ListPageSize: defaultPageSize,
+	}
+}
+
 // Factory tracks various resource informers.
 type Factory struct {
-	factories  map[string]di.DynamicSharedInformerFactory
+	informers  map[string]*informer
+	listers    map[string]*informer
+	refs       map[string]int
 	client     client.Connection
 	stopChan   chan struct{}
 	forwarders Forwarders
+	opts       Options
+	mx         sync.RWMutex
 }
 
 // NewFactory returns a new informers factory.
 func NewFactory(client client.Connection) *Factory {
 	return &Factory{
 		client:     client,
-		factories:  make(map[string]di.DynamicSharedInformerFactory),
+		informers:  make(map[string]*informer),
+		listers:    make(map[string]*informer),
+		refs:       make(map[string]int),
 		forwarders: NewForwarders(),
+		opts:       DefaultOptions(),
 	}
 }
 
+// SetOptions updates the informers lifecycle options.
+func (f *Factory) SetOptions(o Options) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.opts = o
+}
+
 // Start initializes the informers until caller cancels the context.
 func (f *Factory) Start(ns string) {
 	log.Debug().Msgf("Factory START with ns `%q", ns)
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
 	f.stopChan = make(chan struct{})
-	for ns, fac := range f.factories {
-		log.Debug().Msgf("Starting factory in ns %q", ns)
-		fac.Start(f.stopChan)
-	}
+	go f.reaper(f.stopChan)
 }
 
 // Terminate terminates all watchers and forwards.
 func (f *Factory) Terminate() {
+	f.mx.Lock()
 	if f.stopChan != nil {
 		close(f.stopChan)
 		f.stopChan = nil
 	}
-	for k := range f.factories {
-		delete(f.factories, k)
+	for k, inf := range f.informers {
+		inf.stop()
+		delete(f.informers, k)
+	}
+	for k := range f.listers {
+		delete(f.listers, k)
 	}
+	f.refs = make(map[string]int)
+	f.mx.Unlock()
 	f.forwarders.DeleteAll()
 }
 
+// Acquire signals a resource is being viewed in a given namespace. Informers
+// for viewed resources are never reaped.
+func (f *Factory) Acquire(ns, gvr string) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.refs[informerKey(ns, gvr)]++
+}
+
+// Release signals a resource is no longer being viewed in a given namespace.
+func (f *Factory) Release(ns, gvr string) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	key := informerKey(ns, gvr)
+	if f.refs[key] <= 1 {
+		delete(f.refs, key)
+		return
+	}
+	f.refs[key]--
+}
+
 // List returns a resource collection.
 func (f *Factory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
 	defer func(t time.Time) {
 		log.Debug().Msgf("LIST elapsed %v", time.Since(t))
 	}(time.Now())
 
-	Dump(f)
 	log.Debug().Msgf("List %q:%q", ns, gvr)
 	inf, err := f.CanForResource(ns, gvr, []string{"list", "watch"})
 	if err != nil {
@@ -75,9 +167,12 @@ func (f *Factory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtim
 	if ns == clusterScope {
 		ns = allNamespaces
 	}
+	if inf == nil {
+		return f.listPaged(gvr, ns, sel)
+	}
 
 	if wait {
-		f.waitForCacheSync(ns)
+		waitForCacheSync(inf)
 	}
 	return inf.Lister().ByNamespace(ns).List(sel)
 }
@@ -96,35 +191,29 @@ func (f *Factory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime
 	if ns == clusterScope {
 		ns = allNamespaces
 	}
+	if inf == nil {
+		return f.dialFor(gvr, ns).Get(n, metav1.GetOptions{})
+	}
 
 	if wait {
-		f.waitForCacheSync(ns)
+		waitForCacheSync(inf)
 	}
 	return inf.Lister().ByNamespace(ns).Get(n)
 }
 
-func (f *Factory) waitForCacheSync(ns string) {
-	if fac, ok := f.factories[ns]; ok {
-		// Hang for a sec for the cache to refresh if still not done bail out!
-		const dur = 1 * time.Second
-		c := make(chan struct{})
-		go func(c chan struct{}) {
-			<-time.After(dur)
-			log.Debug().Msgf("Wait for sync timed out!")
-			close(c)
-		}(c)
-		fac.WaitForCacheSync(c)
-		log.Debug().Msgf("Sync completed for ns %q", ns)
+// WaitForCacheSync waits for all informers to update their cache.
+func (f *Factory) WaitForCacheSync() {
+	f.mx.RLock()
+	ii := make([]*informer, 0, len(f.informers))
+	for _, inf := range f.informers {
+		ii = append(ii, inf)
 	}
-}
+	stop := f.stopChan
+	f.mx.RUnlock()
 
-// WaitForCacheSync waits for all factories to update their cache.
-func (f *Factory) WaitForCacheSync() {
-	for ns, fac := range f.factories {
-		m := fac.WaitForCacheSync(f.stopChan)
-		for k, v := range m {
-			log.Debug().Msgf("CACHE `%q Loaded %t:%s", ns, v, k)
-		}
+	for _, inf := range ii {
+		ok := cache.WaitForCacheSync(stop, inf.inf.Informer().HasSynced)
+		log.Debug().Msgf("CACHE `%q Loaded %t:%s", inf.ns, ok, inf.gvr)
 	}
 }
 
@@ -133,27 +222,10 @@ func (f *Factory) Client() client.Connection {
 	return f.client
 }
 
-// FactoryFor returns a factory for a given namespace.
-func (f *Factory) FactoryFor(ns string) di.DynamicSharedInformerFactory {
-	return f.factories[ns]
-}
-
-// SetActiveNS sets the active namespace.
-// BOZO!! Check ns access for resource??
-func (f *Factory) SetActiveNS(ns string) {
-	if !f.isClusterWide() {
-		f.ensureFactory(ns)
-	}
-}
-
-func (f *Factory) isClusterWide() bool {
-	_, ok := f.factories[allNamespaces]
-	return ok
-}
-
 // CanForResource return an informer is user has access.
+// The informer is nil when the resource is listed instead of watched.
 func (f *Factory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
-	// If user can access resource cluster wide, prefer cluster wide factory.
+	// If user can access resource cluster wide, prefer cluster wide informer.
 	if ns != allNamespaces {
 		auth, err := f.Client().CanI(allNamespaces, gvr, verbs)
 		if auth && err == nil {
@@ -171,37 +243,117 @@ func (f *Factory) CanForResource(ns, gvr string, verbs []string) (informers.Gene
 	return f.ForResource(ns, gvr), nil
 }
 
-// ForResource returns an informer for a given resource.
+// ForResource returns an informer for a given resource or nil if the
+// resource must be listed instead.
 func (f *Factory) ForResource(ns, gvr string) informers.GenericInformer {
-	fact := f.ensureFactory(ns)
-	inf := fact.ForResource(toGVR(gvr))
-	if inf == nil {
-		log.Error().Err(fmt.Errorf("MEOW! No informer for %q:%q", ns, gvr))
-		return inf
+	if ns == clusterScope {
+		ns = allNamespaces
+	}
+	key, now := informerKey(ns, gvr), time.Now()
+
+	f.mx.Lock()
+	if inf, ok := f.informers[key]; ok {
+		inf.lastUsed = now
+		f.mx.Unlock()
+		return inf.inf
+	}
+	if l, ok := f.listers[key]; ok {
+		l.lastUsed = now
+		f.mx.Unlock()
+		return nil
 	}
-	log.Debug().Msgf("FOR_RESOURCE %q:%q", ns, gvr)
-	fact.Start(f.stopChan)
+	f.mx.Unlock()
 
-	return inf
+	huge := f.isHuge(gvr, ns)
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if inf, ok := f.informers[key]; ok {
+		inf.lastUsed = now
+		return inf.inf
+	}
+	if huge {
+		log.Debug().Msgf("LIST_MODE %q:%q exceeds %d items", ns, gvr, f.opts.ListThreshold)
+		f.listers[key] = newLister(ns, gvr)
+		return nil
+	}
+	if !f.hasRoom(gvr) {
+		log.Warn().Msgf("Informers cap (%d) reached. Listing %q:%q", f.opts.MaxGVRs, ns, gvr)
+		l := newLister(ns, gvr)
+		l.capped = true
+		f.listers[key] = l
+		return nil
+	}
+
+	log.Debug().Msgf("INFORMER_NEW %q:%q", ns, gvr)
+	inf := newInformer(f.client.DynDialOrDie(), ns, gvr)
+	f.informers[key] = inf
+	inf.start()
+
+	return inf.inf
 }
 
-func (f *Factory) ensureFactory(ns string) di.DynamicSharedInformerFactory {
-	if ns == clusterScope {
-		ns = allNamespaces
+// IsWatched checks if an informer already tracks a resource in a given
+// namespace.
+func (f *Factory) IsWatched(ns, gvr string) bool {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	for _, k := range []string{informerKey(allNamespaces, gvr), informerKey(ns, gvr)} {
+		if _, ok := f.informers[k]; ok {
+			return true
+		}
 	}
-	if fac, ok := f.factories[ns]; ok {
-		return fac
+
+	return false
+}
+
+// Subscribe registers a callback fired whenever a watched resource changes.
+// It returns false when the resource is listed rather than watched.
+func (f *Factory) Subscribe(ns, gvr string, fn EventFunc) (func(), bool) {
+	inf, err := f.CanForResource(ns, gvr, ReadVerbs)
+	if err != nil || inf == nil {
+		return nil, false
 	}
 
-	log.Debug().Msgf("FACTORY_NEW for ns %q", ns)
-	f.factories[ns] = di.NewFilteredDynamicSharedInformerFactory(
-		f.client.DynDialOrDie(),
-		defaultResync,
-		ns,
-		nil,
-	)
+	f.mx.RLock()
+	var i *informer
+	for _, k := range []string{informerKey(allNamespaces, gvr), informerKey(ns, gvr)} {
+		if v, ok := f.informers[k]; ok && v.inf == inf {
+			i = v
+			break
+		}
+	}
+	f.mx.RUnlock()
+	if i == nil {
+		return nil, false
+	}
+	id := i.addListener(fn)
 
-	return f.factories[ns]
+	return func() { i.removeListener(id) }, true
+}
+
+// Stats returns all tracked informers diagnostics.
+func (f *Factory) Stats() []InformerStats {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	now := time.Now()
+	ss := make([]InformerStats, 0, len(f.informers)+len(f.listers))
+	for _, inf := range f.informers {
+		ss = append(ss, inf.stats(now, WatchMode, f.refs[inf.gvr]))
+	}
+	for _, l := range f.listers {
+		ss = append(ss, l.stats(now, ListMode, f.refs[l.gvr]))
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		if ss[i].GVR == ss[j].GVR {
+			return ss[i].Namespace < ss[j].Namespace
+		}
+		return ss[i].GVR < ss[j].GVR
+	})
+
+	return ss
 }
 
 // AddForwarder registers a new portforward for a given container.
@@ -227,3 +379,180 @@ func (f *Factory) ForwarderFor(path string) (Forwarder, bool) {
 	fwd, ok := f.forwarders[path]
 	return fwd, ok
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (f *Factory) reaper(stop <-chan struct{}) {
+	for {
+		f.mx.RLock()
+		rate := f.opts.IdleTimeout / 2
+		f.mx.RUnlock()
+		if rate > maxReapInterval {
+			rate = maxReapInterval
+		}
+		if rate < time.Second {
+			rate = time.Second
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(rate):
+			f.reap(time.Now())
+		}
+	}
+}
+
+// Reap stops informers no one viewed since the idle timeout and promotes
+// resources listed for lack of informers room back to informers.
+func (f *Factory) reap(now time.Time) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	for k, inf := range f.informers {
+		if f.refs[k] > 0 || now.Sub(inf.lastUsed) < f.opts.IdleTimeout {
+			continue
+		}
+		log.Debug().Msgf("INFORMER_REAP %q:%q", inf.ns, inf.gvr)
+		inf.stop()
+		delete(f.informers, k)
+	}
+	for k, l := range f.listers {
+		if f.refs[k] > 0 || now.Sub(l.lastUsed) < f.opts.IdleTimeout {
+			continue
+		}
+		delete(f.listers, k)
+	}
+	f.promote()
+}
+
+// Promote watches capped listed resources again once informers room frees up.
+func (f *Factory) promote() {
+	for k, l := range f.listers {
+		if !l.capped || !f.canWatch(l.gvr) {
+			continue
+		}
+		log.Debug().Msgf("INFORMER_PROMOTE %q:%q", l.ns, l.gvr)
+		delete(f.listers, k)
+		inf := newInformer(f.client.DynDialOrDie(), l.ns, l.gvr)
+		inf.lastUsed = l.lastUsed
+		f.informers[k] = inf
+		inf.start()
+	}
+}
+
+// CanWatch checks if an informer can be started without evicting others.
+func (f *Factory) canWatch(gvr string) bool {
+	if f.opts.MaxGVRs <= 0 {
+		return true
+	}
+	gvrs := make(map[string]struct{}, len(f.informers))
+	for _, inf := range f.informers {
+		gvrs[inf.gvr] = struct{}{}
+	}
+	_, ok := gvrs[gvr]
+
+	return ok || len(gvrs) < f.opts.MaxGVRs
+}
+
+// HasRoom checks if a new informer can be started for a given resource,
+// evicting the least recently used idle informer if need be.
+func (f *Factory) hasRoom(gvr string) bool {
+	if f.canWatch(gvr) {
+		return true
+	}
+
+	var victim string
+	for k, inf := range f.informers {
+		if f.refs[k] > 0 {
+			continue
+		}
+		if victim == "" || inf.lastUsed.Before(f.informers[victim].lastUsed) {
+			victim = k
+		}
+	}
+	if victim == "" {
+		return false
+	}
+	log.Debug().Msgf("INFORMER_EVICT %q", victim)
+	f.informers[victim].stop()
+	delete(f.informers, victim)
+
+	return f.hasRoom(gvr)
+}
+
+func (f *Factory) isHuge(gvr, ns string) bool {
+	f.mx.RLock()
+	threshold := f.opts.ListThreshold
+	f.mx.RUnlock()
+	if threshold <= 0 {
+		return false
+	}
+
+	l, err := f.dialFor(gvr, ns).List(metav1.ListOptions{Limit: 1})
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to size %q:%q", ns, gvr)
+		return false
+	}
+	count := int64(len(l.Items))
+	if rem := l.GetRemainingItemCount(); rem != nil {
+		count += *rem
+	}
+
+	return count > threshold
+}
+
+func (f *Factory) listPaged(gvr, ns string, sel labels.Selector) ([]runtime.Object, error) {
+	f.mx.RLock()
+	opts := metav1.ListOptions{LabelSelector: sel.String(), Limit: f.opts.ListPageSize}
+	f.mx.RUnlock()
+
+	dial := f.dialFor(gvr, ns)
+	var oo []runtime.Object
+	for {
+		l, err := dial.List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range l.Items {
+			oo = append(oo, &l.Items[i])
+		}
+		if opts.Continue = l.GetContinue(); opts.Continue == "" {
+			break
+		}
+	}
+
+	f.mx.Lock()
+	for _, k := range []string{informerKey(ns, gvr), informerKey(allNamespaces, gvr)} {
+		if l, ok := f.listers[k]; ok {
+			l.items = len(oo)
+			break
+		}
+	}
+	f.mx.Unlock()
+
+	return oo, nil
+}
+
+func (f *Factory) dialFor(gvr, ns string) dynamic.ResourceInterface {
+	dial := f.client.DynDialOrDie().Resource(toGVR(gvr))
+	if ns == allNamespaces || ns == clusterScope {
+		return dial
+	}
+
+	return dial.Namespace(ns)
+}
+
+func waitForCacheSync(inf informers.GenericInformer) {
+	// Hang for a sec for the cache to refresh if still not done bail out!
+	const dur = 1 * time.Second
+	c := make(chan struct{})
+	go func(c chan struct{}) {
+		<-time.After(dur)
+		close(c)
+	}(c)
+	if !cache.WaitForCacheSync(c, inf.Informer().HasSynced) {
+		log.Debug().Msgf("Wait for sync timed out!")
+	}
+}
@@ -0,0 +1,130 @@
+package watch
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestFactoryReap(t *testing.T) {
+	f := NewFactory(nil)
+	f.SetOptions(Options{IdleTimeout: time.Minute, MaxGVRs: 10})
+	now := time.Now()
+	f.informers[informerKey("", "v1/pods")] = makeInformer("", "v1/pods", now.Add(-2*time.Minute))
+	f.informers[informerKey("", "v1/services")] = makeInformer("", "v1/services", now.Add(-2*time.Minute))
+	f.informers[informerKey("", "v1/secrets")] = makeInformer("", "v1/secrets", now)
+	f.listers[informerKey("", "v1/events")] = makeInformer("", "v1/events", now.Add(-2*time.Minute))
+	f.Acquire("", "v1/pods")
+
+	f.reap(now)
+	assert.Equal(t, 2, len(f.informers))
+	assert.Equal(t, 0, len(f.listers))
+	_, ok := f.informers[informerKey("", "v1/pods")]
+	assert.True(t, ok)
+
+	f.Release("", "v1/pods")
+	f.reap(now)
+	assert.Equal(t, 1, len(f.informers))
+}
+
+func TestFactoryRefsNamespaced(t *testing.T) {
+	f := NewFactory(nil)
+	f.SetOptions(Options{IdleTimeout: time.Minute, MaxGVRs: 10})
+	now := time.Now()
+	f.informers[informerKey("fred", "v1/pods")] = makeInformer("fred", "v1/pods", now.Add(-2*time.Minute))
+	f.informers[informerKey("blee", "v1/pods")] = makeInformer("blee", "v1/pods", now.Add(-2*time.Minute))
+	f.Acquire("fred", "v1/pods")
+	f.Acquire("blee", "v1/pods")
+	f.Release("blee", "v1/pods")
+
+	f.reap(now)
+	assert.Equal(t, 1, len(f.informers))
+	_, ok := f.informers[informerKey("fred", "v1/pods")]
+	assert.True(t, ok)
+
+	f.Terminate()
+	assert.Equal(t, 0, len(f.refs))
+}
+
+func TestFactoryPromote(t *testing.T) {
+	f := NewFactory(testConn{})
+	f.SetOptions(Options{IdleTimeout: time.Minute, MaxGVRs: 1})
+	defer f.Terminate()
+	now := time.Now()
+	f.informers[informerKey("", "v1/pods")] = makeInformer("", "v1/pods", now.Add(-2*time.Minute))
+	l := newLister("", "v1/services")
+	l.capped = true
+	f.listers[informerKey("", "v1/services")] = l
+	f.listers[informerKey("", "v1/secrets")] = newLister("", "v1/secrets")
+	f.Acquire("", "v1/services")
+	f.Acquire("", "v1/secrets")
+
+	f.reap(now)
+	_, ok := f.informers[informerKey("", "v1/services")]
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(f.listers))
+	_, ok = f.listers[informerKey("", "v1/secrets")]
+	assert.True(t, ok)
+}
+
+func TestFactoryTerminate(t *testing.T) {
+	f := NewFactory(nil)
+	f.Start("")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f.Terminate()
+		}()
+	}
+	wg.Wait()
+	assert.Nil(t, f.stopChan)
+}
+
+func TestFactoryHasRoom(t *testing.T) {
+	f := NewFactory(nil)
+	f.SetOptions(Options{IdleTimeout: time.Minute, MaxGVRs: 2})
+	now := time.Now()
+	f.informers[informerKey("", "v1/pods")] = makeInformer("", "v1/pods", now.Add(-time.Minute))
+	f.informers[informerKey("fred", "v1/pods")] = makeInformer("fred", "v1/pods", now)
+	f.informers[informerKey("", "v1/services")] = makeInformer("", "v1/services", now)
+
+	assert.True(t, f.hasRoom("v1/pods"))
+	assert.Equal(t, 3, len(f.informers))
+
+	f.Acquire("", "v1/pods")
+	f.Acquire("fred", "v1/pods")
+	assert.True(t, f.hasRoom("v1/secrets"))
+	assert.Equal(t, 2, len(f.informers))
+	_, ok := f.informers[informerKey("", "v1/services")]
+	assert.False(t, ok)
+
+	f.Acquire("", "v1/secrets")
+	f.informers[informerKey("", "v1/secrets")] = makeInformer("", "v1/secrets", now)
+	assert.False(t, f.hasRoom("v1/configmaps"))
+}
+
+// Helpers...
+
+type testConn struct {
+	client.Connection
+}
+
+func (testConn) DynDialOrDie() dynamic.Interface {
+	return fake.NewSimpleDynamicClient(runtime.NewScheme())
+}
+
+func makeInformer(ns, gvr string, lastUsed time.Time) *informer {
+	i := newLister(ns, gvr)
+	i.stopChan, i.lastUsed = make(chan struct{}), lastUsed
+
+	return i
+}
@@ -29,9 +29,9 @@ func namespaced(n string) (string, string) {
 
 // Dump for debug.
 func Dump(f *Factory) {
-	log.Debug().Msgf("----------- FACTORIES -------------")
-	for ns := range f.factories {
-		log.Debug().Msgf("  Factory for NS %q", ns)
+	log.Debug().Msgf("----------- INFORMERS -------------")
+	for _, st := range f.Stats() {
+		log.Debug().Msgf("  %s %q:%q (%d items)", st.Mode, st.Namespace, st.GVR, st.Items)
 	}
 	log.Debug().Msgf("-----------------------------------")
 }
@@ -39,7 +39,10 @@ func Dump(f *Factory) {
 // Debug for debug.
 func Debug(f *Factory, ns string, gvr string) {
 	log.Debug().Msgf("----------- DEBUG FACTORY (%s) -------------", gvr)
-	inf := f.factories[ns].ForResource(toGVR(gvr))
+	inf := f.ForResource(ns, gvr)
+	if inf == nil {
+		return
+	}
 	for i, k := range inf.Informer().GetStore().ListKeys() {
 		log.Debug().Msgf("%d -- %s", i, k)
 	}
@@ -0,0 +1,132 @@
+package watch
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/dynamic"
+	di "k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// EventFunc notifies a resource identified by its path changed.
+type EventFunc func(path string)
+
+// Informer tracks a resource informer lifecycle.
+type informer struct {
+	ns, gvr  string
+	inf      informers.GenericInformer
+	stopChan chan struct{}
+	started  time.Time
+	lastUsed time.Time
+	items    int
+	// Capped tracks resources listed because the informers cap was reached.
+	capped bool
+
+	listeners map[int]EventFunc
+	seq       int
+	mx        sync.RWMutex
+}
+
+func newInformer(dial dynamic.Interface, ns, gvr string) *informer {
+	now := time.Now()
+	i := informer{
+		ns:        ns,
+		gvr:       gvr,
+		inf:       di.NewFilteredDynamicInformer(dial, toGVR(gvr), ns, defaultResync, nil, nil),
+		stopChan:  make(chan struct{}),
+		started:   now,
+		lastUsed:  now,
+		listeners: make(map[int]EventFunc),
+	}
+	i.inf.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    i.notify,
+		UpdateFunc: func(_, o interface{}) { i.notify(o) },
+		DeleteFunc: i.notify,
+	})
+
+	return &i
+}
+
+// NewLister tracks a resource that is listed instead of watched.
+func newLister(ns, gvr string) *informer {
+	now := time.Now()
+	return &informer{
+		ns:       ns,
+		gvr:      gvr,
+		started:  now,
+		lastUsed: now,
+	}
+}
+
+// AddListener registers an event listener and returns its id.
+func (i *informer) addListener(fn EventFunc) int {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	i.seq++
+	i.listeners[i.seq] = fn
+
+	return i.seq
+}
+
+func (i *informer) removeListener(id int) {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	delete(i.listeners, id)
+}
+
+func (i *informer) notify(o interface{}) {
+	path, err := cache.DeletionHandlingMetaNamespaceKeyFunc(o)
+	if err != nil {
+		log.Error().Err(err).Msgf("No key for %q event", i.gvr)
+		return
+	}
+
+	i.mx.RLock()
+	defer i.mx.RUnlock()
+	for _, fn := range i.listeners {
+		fn(path)
+	}
+}
+
+func (i *informer) start() {
+	go i.inf.Informer().Run(i.stopChan)
+}
+
+func (i *informer) stop() {
+	if i.stopChan == nil {
+		return
+	}
+	close(i.stopChan)
+	i.stopChan = nil
+}
+
+func (i *informer) stats(now time.Time, mode string, refs int) InformerStats {
+	st := InformerStats{
+		Namespace: i.ns,
+		GVR:       i.gvr,
+		Mode:      mode,
+		Refs:      refs,
+		Items:     i.items,
+		Age:       now.Sub(i.started),
+		Idle:      now.Sub(i.lastUsed),
+	}
+	if i.inf != nil {
+		st.Items = len(i.inf.Informer().GetStore().ListKeys())
+		st.Synced = i.inf.Informer().HasSynced()
+	}
+
+	return st
+}
+
+func informerKey(ns, gvr string) string {
+	if ns == clusterScope {
+		ns = allNamespaces
+	}
+
+	return ns + ":" + gvr
+}
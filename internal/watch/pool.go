@@ -0,0 +1,112 @@
+package watch
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+// Pool tracks factories across multiple kubeconfig contexts.
+// Each context gets its own connection and factory.
+type Pool struct {
+	config    *client.Config
+	opts      Options
+	factories map[string]*Factory
+	mx        sync.Mutex
+}
+
+// NewPool returns a new factory pool.
+func NewPool(cfg *client.Config, opts Options) *Pool {
+	return &Pool{
+		config:    cfg,
+		opts:      opts,
+		factories: make(map[string]*Factory),
+	}
+}
+
+// FactoryFor returns a started factory for a given context. The context
+// connection is established outside the pool lock.
+func (p *Pool) FactoryFor(context, ns string) (*Factory, error) {
+	p.mx.Lock()
+	f, ok := p.factories[context]
+	p.mx.Unlock()
+	if ok {
+		return f, nil
+	}
+
+	if _, err := p.config.GetContext(context); err != nil {
+		return nil, err
+	}
+	log.Debug().Msgf("POOL new connection for context %q", context)
+	conn, err := client.InitConnection(p.config.ForContext(context))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	if f, ok := p.factories[context]; ok {
+		return f, nil
+	}
+	f = NewFactory(conn)
+	f.SetOptions(p.opts)
+	f.Start(ns)
+	p.factories[context] = f
+
+	return f, nil
+}
+
+// FactoriesFor connects to the given contexts concurrently. Contexts that
+// can't be reached are returned with their errors.
+func (p *Pool) FactoriesFor(contexts []string, ns string) (map[string]*Factory, map[string]error) {
+	var (
+		ff   = make(map[string]*Factory, len(contexts))
+		errs = make(map[string]error)
+		mx   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	for _, c := range contexts {
+		wg.Add(1)
+		go func(c string) {
+			defer wg.Done()
+			f, err := p.FactoryFor(c, ns)
+			mx.Lock()
+			defer mx.Unlock()
+			if err != nil {
+				errs[c] = err
+				return
+			}
+			ff[c] = f
+		}(c)
+	}
+	wg.Wait()
+
+	return ff, errs
+}
+
+// Contexts returns the pooled contexts.
+func (p *Pool) Contexts() []string {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	cc := make([]string, 0, len(p.factories))
+	for c := range p.factories {
+		cc = append(cc, c)
+	}
+	sort.Strings(cc)
+
+	return cc
+}
+
+// Terminate terminates all pooled factories.
+func (p *Pool) Terminate() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	for c, f := range p.factories {
+		f.Terminate()
+		delete(p.factories, c)
+	}
+}
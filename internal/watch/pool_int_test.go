@@ -0,0 +1,47 @@
+package watch
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const unreachableConfig = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    server: https://127.0.0.1:1
+  name: c1
+contexts:
+- context:
+    cluster: c1
+    user: u1
+  name: ctx1
+current-context: ctx1
+users:
+- name: u1
+  user:
+    token: fred
+`
+
+func TestPoolFactoriesFor(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-pool")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	cfg := filepath.Join(dir, "config")
+	assert.Nil(t, ioutil.WriteFile(cfg, []byte(unreachableConfig), 0600))
+
+	p := NewPool(client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &cfg}), DefaultOptions())
+	ff, errs := p.FactoriesFor([]string{"ctx1", "blee"}, "default")
+
+	assert.Empty(t, ff)
+	assert.Equal(t, 2, len(errs))
+	assert.NotNil(t, errs["ctx1"])
+	assert.NotNil(t, errs["blee"])
+	assert.Empty(t, p.Contexts())
+}